@@ -22,6 +22,8 @@ const (
 	Msg_AuthorizeCircuitBreaker_FullMethodName = "/cosmos.circuit.v1.Msg/AuthorizeCircuitBreaker"
 	Msg_TripCircuitBreaker_FullMethodName      = "/cosmos.circuit.v1.Msg/TripCircuitBreaker"
 	Msg_ResetCircuitBreaker_FullMethodName     = "/cosmos.circuit.v1.Msg/ResetCircuitBreaker"
+	Msg_AddFilterRule_FullMethodName           = "/cosmos.circuit.v1.Msg/AddFilterRule"
+	Msg_RemoveFilterRule_FullMethodName        = "/cosmos.circuit.v1.Msg/RemoveFilterRule"
 )
 
 // MsgClient is the client API for Msg service.
@@ -36,6 +38,12 @@ type MsgClient interface {
 	// ResetCircuitBreaker resumes processing of Msg's in the state machine that
 	// have been been paused using TripCircuitBreaker.
 	ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error)
+	// AddFilterRule pauses processing of the Msg's of a type URL whose fields
+	// match the rule's predicates.
+	AddFilterRule(ctx context.Context, in *MsgAddFilterRule, opts ...grpc.CallOption) (*MsgAddFilterRuleResponse, error)
+	// RemoveFilterRule resumes processing of the Msg's that have been paused
+	// using AddFilterRule.
+	RemoveFilterRule(ctx context.Context, in *MsgRemoveFilterRule, opts ...grpc.CallOption) (*MsgRemoveFilterRuleResponse, error)
 }
 
 type msgClient struct {
@@ -73,6 +81,24 @@ func (c *msgClient) ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuit
 	return out, nil
 }
 
+func (c *msgClient) AddFilterRule(ctx context.Context, in *MsgAddFilterRule, opts ...grpc.CallOption) (*MsgAddFilterRuleResponse, error) {
+	out := new(MsgAddFilterRuleResponse)
+	err := c.cc.Invoke(ctx, Msg_AddFilterRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFilterRule(ctx context.Context, in *MsgRemoveFilterRule, opts ...grpc.CallOption) (*MsgRemoveFilterRuleResponse, error) {
+	out := new(MsgRemoveFilterRuleResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveFilterRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -85,6 +111,12 @@ type MsgServer interface {
 	// ResetCircuitBreaker resumes processing of Msg's in the state machine that
 	// have been been paused using TripCircuitBreaker.
 	ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error)
+	// AddFilterRule pauses processing of the Msg's of a type URL whose fields
+	// match the rule's predicates.
+	AddFilterRule(context.Context, *MsgAddFilterRule) (*MsgAddFilterRuleResponse, error)
+	// RemoveFilterRule resumes processing of the Msg's that have been paused
+	// using AddFilterRule.
+	RemoveFilterRule(context.Context, *MsgRemoveFilterRule) (*MsgRemoveFilterRuleResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -101,6 +133,12 @@ func (UnimplementedMsgServer) TripCircuitBreaker(context.Context, *MsgTripCircui
 func (UnimplementedMsgServer) ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetCircuitBreaker not implemented")
 }
+func (UnimplementedMsgServer) AddFilterRule(context.Context, *MsgAddFilterRule) (*MsgAddFilterRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFilterRule not implemented")
+}
+func (UnimplementedMsgServer) RemoveFilterRule(context.Context, *MsgRemoveFilterRule) (*MsgRemoveFilterRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFilterRule not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -168,6 +206,42 @@ func _Msg_ResetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddFilterRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddFilterRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddFilterRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddFilterRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddFilterRule(ctx, req.(*MsgAddFilterRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFilterRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFilterRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFilterRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveFilterRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFilterRule(ctx, req.(*MsgRemoveFilterRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -187,6 +261,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetCircuitBreaker",
 			Handler:    _Msg_ResetCircuitBreaker_Handler,
 		},
+		{
+			MethodName: "AddFilterRule",
+			Handler:    _Msg_AddFilterRule_Handler,
+		},
+		{
+			MethodName: "RemoveFilterRule",
+			Handler:    _Msg_RemoveFilterRule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/circuit/v1/tx.proto",
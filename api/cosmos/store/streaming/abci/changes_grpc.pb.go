@@ -0,0 +1,140 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/store/streaming/abci/changes.proto
+
+package abci
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	StateChangeService_StreamChanges_FullMethodName = "/cosmos.store.streaming.abci.StateChangeService/StreamChanges"
+)
+
+// StateChangeServiceClient is the client API for StateChangeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StateChangeServiceClient interface {
+	// StreamChanges streams the change sets of the retained heights starting at from_height,
+	// then the change sets of the new heights as they are committed.
+	StreamChanges(ctx context.Context, in *StreamChangesRequest, opts ...grpc.CallOption) (StateChangeService_StreamChangesClient, error)
+}
+
+type stateChangeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStateChangeServiceClient(cc grpc.ClientConnInterface) StateChangeServiceClient {
+	return &stateChangeServiceClient{cc}
+}
+
+func (c *stateChangeServiceClient) StreamChanges(ctx context.Context, in *StreamChangesRequest, opts ...grpc.CallOption) (StateChangeService_StreamChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StateChangeService_ServiceDesc.Streams[0], StateChangeService_StreamChanges_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &stateChangeServiceStreamChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StateChangeService_StreamChangesClient interface {
+	Recv() (*StreamChangesResponse, error)
+	grpc.ClientStream
+}
+
+type stateChangeServiceStreamChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *stateChangeServiceStreamChangesClient) Recv() (*StreamChangesResponse, error) {
+	m := new(StreamChangesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StateChangeServiceServer is the server API for StateChangeService service.
+// All implementations must embed UnimplementedStateChangeServiceServer
+// for forward compatibility
+type StateChangeServiceServer interface {
+	// StreamChanges streams the change sets of the retained heights starting at from_height,
+	// then the change sets of the new heights as they are committed.
+	StreamChanges(*StreamChangesRequest, StateChangeService_StreamChangesServer) error
+	mustEmbedUnimplementedStateChangeServiceServer()
+}
+
+// UnimplementedStateChangeServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedStateChangeServiceServer struct {
+}
+
+func (UnimplementedStateChangeServiceServer) StreamChanges(*StreamChangesRequest, StateChangeService_StreamChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamChanges not implemented")
+}
+func (UnimplementedStateChangeServiceServer) mustEmbedUnimplementedStateChangeServiceServer() {}
+
+// UnsafeStateChangeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StateChangeServiceServer will
+// result in compilation errors.
+type UnsafeStateChangeServiceServer interface {
+	mustEmbedUnimplementedStateChangeServiceServer()
+}
+
+func RegisterStateChangeServiceServer(s grpc.ServiceRegistrar, srv StateChangeServiceServer) {
+	s.RegisterService(&StateChangeService_ServiceDesc, srv)
+}
+
+func _StateChangeService_StreamChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StateChangeServiceServer).StreamChanges(m, &stateChangeServiceStreamChangesServer{stream})
+}
+
+type StateChangeService_StreamChangesServer interface {
+	Send(*StreamChangesResponse) error
+	grpc.ServerStream
+}
+
+type stateChangeServiceStreamChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *stateChangeServiceStreamChangesServer) Send(m *StreamChangesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// StateChangeService_ServiceDesc is the grpc.ServiceDesc for StateChangeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StateChangeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.store.streaming.abci.StateChangeService",
+	HandlerType: (*StateChangeServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamChanges",
+			Handler:       _StateChangeService_StreamChanges_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cosmos/store/streaming/abci/changes.proto",
+}
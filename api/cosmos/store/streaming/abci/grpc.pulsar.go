@@ -1861,6 +1861,584 @@ func (x *fastReflection_ListenCommitResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
+var (
+	md_StreamRecord                protoreflect.MessageDescriptor
+	fd_StreamRecord_finalize_block protoreflect.FieldDescriptor
+	fd_StreamRecord_commit         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_store_streaming_abci_grpc_proto_init()
+	md_StreamRecord = File_cosmos_store_streaming_abci_grpc_proto.Messages().ByName("StreamRecord")
+	fd_StreamRecord_finalize_block = md_StreamRecord.Fields().ByName("finalize_block")
+	fd_StreamRecord_commit = md_StreamRecord.Fields().ByName("commit")
+}
+
+var _ protoreflect.Message = (*fastReflection_StreamRecord)(nil)
+
+type fastReflection_StreamRecord StreamRecord
+
+func (x *StreamRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StreamRecord)(x)
+}
+
+func (x *StreamRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_store_streaming_abci_grpc_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_StreamRecord_messageType fastReflection_StreamRecord_messageType
+var _ protoreflect.MessageType = fastReflection_StreamRecord_messageType{}
+
+type fastReflection_StreamRecord_messageType struct{}
+
+func (x fastReflection_StreamRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StreamRecord)(nil)
+}
+func (x fastReflection_StreamRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_StreamRecord)
+}
+func (x fastReflection_StreamRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StreamRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_StreamRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_StreamRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_StreamRecord) Type() protoreflect.MessageType {
+	return _fastReflection_StreamRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_StreamRecord) New() protoreflect.Message {
+	return new(fastReflection_StreamRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_StreamRecord) Interface() protoreflect.ProtoMessage {
+	return (*StreamRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_StreamRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Event != nil {
+		switch o := x.Event.(type) {
+		case *StreamRecord_FinalizeBlock:
+			v := o.FinalizeBlock
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_StreamRecord_finalize_block, value) {
+				return
+			}
+		case *StreamRecord_Commit:
+			v := o.Commit
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_StreamRecord_commit, value) {
+				return
+			}
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_StreamRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.store.streaming.abci.StreamRecord.finalize_block":
+		if x.Event == nil {
+			return false
+		} else if _, ok := x.Event.(*StreamRecord_FinalizeBlock); ok {
+			return true
+		} else {
+			return false
+		}
+	case "cosmos.store.streaming.abci.StreamRecord.commit":
+		if x.Event == nil {
+			return false
+		} else if _, ok := x.Event.(*StreamRecord_Commit); ok {
+			return true
+		} else {
+			return false
+		}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.streaming.abci.StreamRecord"))
+		}
+		panic(fmt.Errorf("message cosmos.store.streaming.abci.StreamRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StreamRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.store.streaming.abci.StreamRecord.finalize_block":
+		x.Event = nil
+	case "cosmos.store.streaming.abci.StreamRecord.commit":
+		x.Event = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.streaming.abci.StreamRecord"))
+		}
+		panic(fmt.Errorf("message cosmos.store.streaming.abci.StreamRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_StreamRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.store.streaming.abci.StreamRecord.finalize_block":
+		if x.Event == nil {
+			return protoreflect.ValueOfMessage((*ListenFinalizeBlockRequest)(nil).ProtoReflect())
+		} else if v, ok := x.Event.(*StreamRecord_FinalizeBlock); ok {
+			return protoreflect.ValueOfMessage(v.FinalizeBlock.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*ListenFinalizeBlockRequest)(nil).ProtoReflect())
+		}
+	case "cosmos.store.streaming.abci.StreamRecord.commit":
+		if x.Event == nil {
+			return protoreflect.ValueOfMessage((*ListenCommitRequest)(nil).ProtoReflect())
+		} else if v, ok := x.Event.(*StreamRecord_Commit); ok {
+			return protoreflect.ValueOfMessage(v.Commit.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*ListenCommitRequest)(nil).ProtoReflect())
+		}
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.streaming.abci.StreamRecord"))
+		}
+		panic(fmt.Errorf("message cosmos.store.streaming.abci.StreamRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StreamRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.store.streaming.abci.StreamRecord.finalize_block":
+		cv := value.Message().Interface().(*ListenFinalizeBlockRequest)
+		x.Event = &StreamRecord_FinalizeBlock{FinalizeBlock: cv}
+	case "cosmos.store.streaming.abci.StreamRecord.commit":
+		cv := value.Message().Interface().(*ListenCommitRequest)
+		x.Event = &StreamRecord_Commit{Commit: cv}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.streaming.abci.StreamRecord"))
+		}
+		panic(fmt.Errorf("message cosmos.store.streaming.abci.StreamRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StreamRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.store.streaming.abci.StreamRecord.finalize_block":
+		if x.Event == nil {
+			value := &ListenFinalizeBlockRequest{}
+			oneofValue := &StreamRecord_FinalizeBlock{FinalizeBlock: value}
+			x.Event = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Event.(type) {
+		case *StreamRecord_FinalizeBlock:
+			return protoreflect.ValueOfMessage(m.FinalizeBlock.ProtoReflect())
+		default:
+			value := &ListenFinalizeBlockRequest{}
+			oneofValue := &StreamRecord_FinalizeBlock{FinalizeBlock: value}
+			x.Event = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "cosmos.store.streaming.abci.StreamRecord.commit":
+		if x.Event == nil {
+			value := &ListenCommitRequest{}
+			oneofValue := &StreamRecord_Commit{Commit: value}
+			x.Event = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Event.(type) {
+		case *StreamRecord_Commit:
+			return protoreflect.ValueOfMessage(m.Commit.ProtoReflect())
+		default:
+			value := &ListenCommitRequest{}
+			oneofValue := &StreamRecord_Commit{Commit: value}
+			x.Event = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.streaming.abci.StreamRecord"))
+		}
+		panic(fmt.Errorf("message cosmos.store.streaming.abci.StreamRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_StreamRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.store.streaming.abci.StreamRecord.finalize_block":
+		value := &ListenFinalizeBlockRequest{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.store.streaming.abci.StreamRecord.commit":
+		value := &ListenCommitRequest{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.streaming.abci.StreamRecord"))
+		}
+		panic(fmt.Errorf("message cosmos.store.streaming.abci.StreamRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_StreamRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	case "cosmos.store.streaming.abci.StreamRecord.event":
+		if x.Event == nil {
+			return nil
+		}
+		switch x.Event.(type) {
+		case *StreamRecord_FinalizeBlock:
+			return x.Descriptor().Fields().ByName("finalize_block")
+		case *StreamRecord_Commit:
+			return x.Descriptor().Fields().ByName("commit")
+		}
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.streaming.abci.StreamRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_StreamRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StreamRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_StreamRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_StreamRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*StreamRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		switch x := x.Event.(type) {
+		case *StreamRecord_FinalizeBlock:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.FinalizeBlock)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *StreamRecord_Commit:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.Commit)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*StreamRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		switch x := x.Event.(type) {
+		case *StreamRecord_FinalizeBlock:
+			encoded, err := options.Marshal(x.FinalizeBlock)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		case *StreamRecord_Commit:
+			encoded, err := options.Marshal(x.Commit)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*StreamRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StreamRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StreamRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FinalizeBlock", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &ListenFinalizeBlockRequest{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Event = &StreamRecord_FinalizeBlock{v}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &ListenCommitRequest{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Event = &StreamRecord_Commit{v}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
@@ -2025,6 +2603,78 @@ func (*ListenCommitResponse) Descriptor() ([]byte, []int) {
 	return file_cosmos_store_streaming_abci_grpc_proto_rawDescGZIP(), []int{3}
 }
 
+// StreamRecord is a record of the ABCI events streamed by the in-process listeners of the
+// streaming package, e.g. a length-prefixed message of a streaming file.
+//
+// Since: cosmos-sdk 0.51
+type StreamRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*StreamRecord_FinalizeBlock
+	//	*StreamRecord_Commit
+	Event isStreamRecord_Event `protobuf_oneof:"event"`
+}
+
+func (x *StreamRecord) Reset() {
+	*x = StreamRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_store_streaming_abci_grpc_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamRecord) ProtoMessage() {}
+
+// Deprecated: Use StreamRecord.ProtoReflect.Descriptor instead.
+func (*StreamRecord) Descriptor() ([]byte, []int) {
+	return file_cosmos_store_streaming_abci_grpc_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StreamRecord) GetEvent() isStreamRecord_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *StreamRecord) GetFinalizeBlock() *ListenFinalizeBlockRequest {
+	if x, ok := x.GetEvent().(*StreamRecord_FinalizeBlock); ok {
+		return x.FinalizeBlock
+	}
+	return nil
+}
+
+func (x *StreamRecord) GetCommit() *ListenCommitRequest {
+	if x, ok := x.GetEvent().(*StreamRecord_Commit); ok {
+		return x.Commit
+	}
+	return nil
+}
+
+type isStreamRecord_Event interface {
+	isStreamRecord_Event()
+}
+
+type StreamRecord_FinalizeBlock struct {
+	FinalizeBlock *ListenFinalizeBlockRequest `protobuf:"bytes,1,opt,name=finalize_block,json=finalizeBlock,proto3,oneof"`
+}
+
+type StreamRecord_Commit struct {
+	Commit *ListenCommitRequest `protobuf:"bytes,2,opt,name=commit,proto3,oneof"`
+}
+
+func (*StreamRecord_FinalizeBlock) isStreamRecord_Event() {}
+
+func (*StreamRecord_Commit) isStreamRecord_Event() {}
+
 var File_cosmos_store_streaming_abci_grpc_proto protoreflect.FileDescriptor
 
 var file_cosmos_store_streaming_abci_grpc_proto_rawDesc = []byte{
@@ -2060,39 +2710,53 @@ var file_cosmos_store_streaming_abci_grpc_proto_rawDesc = []byte{
 	0x61, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x56, 0x50, 0x61, 0x69, 0x72, 0x52, 0x09,
 	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73,
 	0x74, 0x65, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x32, 0x95, 0x02, 0x0a, 0x13, 0x41, 0x42, 0x43, 0x49, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
-	0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x13, 0x4c, 0x69,
-	0x73, 0x74, 0x65, 0x6e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x12, 0x37, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x63, 0x6f, 0x73,
+	0x65, 0x22, 0xc5, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x12, 0x60, 0x0a, 0x0e, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
 	0x69, 0x6e, 0x67, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x46,
-	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x12, 0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x4a, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74,
 	0x6f, 0x72, 0x65, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x61, 0x62,
 	0x63, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e,
-	0x61, 0x62, 0x63, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xea, 0x01, 0x0a, 0x1f, 0x63, 0x6f,
-	0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x42, 0x09, 0x47,
-	0x72, 0x70, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2c, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x69, 0x6e, 0x67, 0x2f, 0x61, 0x62, 0x63, 0x69, 0xa2, 0x02, 0x04, 0x43, 0x53, 0x53, 0x41, 0xaa,
-	0x02, 0x1b, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x62, 0x63, 0x69, 0xca, 0x02, 0x1b,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5c, 0x41, 0x62, 0x63, 0x69, 0xe2, 0x02, 0x27, 0x43, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x69, 0x6e, 0x67, 0x5c, 0x41, 0x62, 0x63, 0x69, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1e, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
-	0x3a, 0x3a, 0x41, 0x62, 0x63, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x32, 0x95, 0x02, 0x0a, 0x13, 0x41, 0x42,
+	0x43, 0x49, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x88, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x46, 0x69, 0x6e, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x37, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
+	0x6e, 0x67, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x46, 0x69,
+	0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x38, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x61, 0x62, 0x63, 0x69,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x0c,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x30, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0xf8, 0x01, 0x0a, 0x1f, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
+	0x2e, 0x61, 0x62, 0x63, 0x69, 0x42, 0x09, 0x47, 0x72, 0x70, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x3a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x62, 0x63, 0x69,
+	0x3b, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x61, 0x62, 0x63, 0x69, 0xa2, 0x02,
+	0x04, 0x43, 0x53, 0x53, 0x41, 0xaa, 0x02, 0x1b, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x41,
+	0x62, 0x63, 0x69, 0xca, 0x02, 0x1b, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x5c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5c, 0x41, 0x62, 0x63,
+	0x69, 0xe2, 0x02, 0x27, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x5c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5c, 0x41, 0x62, 0x63, 0x69, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1e, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x41, 0x62, 0x63, 0x69, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2107,31 +2771,34 @@ func file_cosmos_store_streaming_abci_grpc_proto_rawDescGZIP() []byte {
 	return file_cosmos_store_streaming_abci_grpc_proto_rawDescData
 }
 
-var file_cosmos_store_streaming_abci_grpc_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cosmos_store_streaming_abci_grpc_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_cosmos_store_streaming_abci_grpc_proto_goTypes = []interface{}{
 	(*ListenFinalizeBlockRequest)(nil),  // 0: cosmos.store.streaming.abci.ListenFinalizeBlockRequest
 	(*ListenFinalizeBlockResponse)(nil), // 1: cosmos.store.streaming.abci.ListenFinalizeBlockResponse
 	(*ListenCommitRequest)(nil),         // 2: cosmos.store.streaming.abci.ListenCommitRequest
 	(*ListenCommitResponse)(nil),        // 3: cosmos.store.streaming.abci.ListenCommitResponse
-	(*abci.RequestFinalizeBlock)(nil),   // 4: tendermint.abci.RequestFinalizeBlock
-	(*abci.ResponseFinalizeBlock)(nil),  // 5: tendermint.abci.ResponseFinalizeBlock
-	(*abci.ResponseCommit)(nil),         // 6: tendermint.abci.ResponseCommit
-	(*v1beta1.StoreKVPair)(nil),         // 7: cosmos.store.v1beta1.StoreKVPair
+	(*StreamRecord)(nil),                // 4: cosmos.store.streaming.abci.StreamRecord
+	(*abci.RequestFinalizeBlock)(nil),   // 5: tendermint.abci.RequestFinalizeBlock
+	(*abci.ResponseFinalizeBlock)(nil),  // 6: tendermint.abci.ResponseFinalizeBlock
+	(*abci.ResponseCommit)(nil),         // 7: tendermint.abci.ResponseCommit
+	(*v1beta1.StoreKVPair)(nil),         // 8: cosmos.store.v1beta1.StoreKVPair
 }
 var file_cosmos_store_streaming_abci_grpc_proto_depIdxs = []int32{
-	4, // 0: cosmos.store.streaming.abci.ListenFinalizeBlockRequest.req:type_name -> tendermint.abci.RequestFinalizeBlock
-	5, // 1: cosmos.store.streaming.abci.ListenFinalizeBlockRequest.res:type_name -> tendermint.abci.ResponseFinalizeBlock
-	6, // 2: cosmos.store.streaming.abci.ListenCommitRequest.res:type_name -> tendermint.abci.ResponseCommit
-	7, // 3: cosmos.store.streaming.abci.ListenCommitRequest.change_set:type_name -> cosmos.store.v1beta1.StoreKVPair
-	0, // 4: cosmos.store.streaming.abci.ABCIListenerService.ListenFinalizeBlock:input_type -> cosmos.store.streaming.abci.ListenFinalizeBlockRequest
-	2, // 5: cosmos.store.streaming.abci.ABCIListenerService.ListenCommit:input_type -> cosmos.store.streaming.abci.ListenCommitRequest
-	1, // 6: cosmos.store.streaming.abci.ABCIListenerService.ListenFinalizeBlock:output_type -> cosmos.store.streaming.abci.ListenFinalizeBlockResponse
-	3, // 7: cosmos.store.streaming.abci.ABCIListenerService.ListenCommit:output_type -> cosmos.store.streaming.abci.ListenCommitResponse
-	6, // [6:8] is the sub-list for method output_type
-	4, // [4:6] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	5, // 0: cosmos.store.streaming.abci.ListenFinalizeBlockRequest.req:type_name -> tendermint.abci.RequestFinalizeBlock
+	6, // 1: cosmos.store.streaming.abci.ListenFinalizeBlockRequest.res:type_name -> tendermint.abci.ResponseFinalizeBlock
+	7, // 2: cosmos.store.streaming.abci.ListenCommitRequest.res:type_name -> tendermint.abci.ResponseCommit
+	8, // 3: cosmos.store.streaming.abci.ListenCommitRequest.change_set:type_name -> cosmos.store.v1beta1.StoreKVPair
+	0, // 4: cosmos.store.streaming.abci.StreamRecord.finalize_block:type_name -> cosmos.store.streaming.abci.ListenFinalizeBlockRequest
+	2, // 5: cosmos.store.streaming.abci.StreamRecord.commit:type_name -> cosmos.store.streaming.abci.ListenCommitRequest
+	0, // 6: cosmos.store.streaming.abci.ABCIListenerService.ListenFinalizeBlock:input_type -> cosmos.store.streaming.abci.ListenFinalizeBlockRequest
+	2, // 7: cosmos.store.streaming.abci.ABCIListenerService.ListenCommit:input_type -> cosmos.store.streaming.abci.ListenCommitRequest
+	1, // 8: cosmos.store.streaming.abci.ABCIListenerService.ListenFinalizeBlock:output_type -> cosmos.store.streaming.abci.ListenFinalizeBlockResponse
+	3, // 9: cosmos.store.streaming.abci.ABCIListenerService.ListenCommit:output_type -> cosmos.store.streaming.abci.ListenCommitResponse
+	8, // [8:10] is the sub-list for method output_type
+	6, // [6:8] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_store_streaming_abci_grpc_proto_init() }
@@ -2188,6 +2855,22 @@ func file_cosmos_store_streaming_abci_grpc_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_store_streaming_abci_grpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_cosmos_store_streaming_abci_grpc_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*StreamRecord_FinalizeBlock)(nil),
+		(*StreamRecord_Commit)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2195,7 +2878,7 @@ func file_cosmos_store_streaming_abci_grpc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_store_streaming_abci_grpc_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
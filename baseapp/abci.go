@@ -239,6 +239,11 @@ func (app *BaseApp) ListSnapshots(req *abci.RequestListSnapshots) (*abci.Respons
 	}
 
 	for _, snapshot := range snapshots {
+		// delta snapshots can't be restored through state sync, see snapshots.Manager.Restore
+		if snapshot.IsDelta() {
+			continue
+		}
+
 		abciSnapshot, err := snapshot.ToABCI()
 		if err != nil {
 			app.logger.Error("failed to convert ABCI snapshots", "err", err)
@@ -509,7 +514,11 @@ func (app *BaseApp) ProcessProposal(req *abci.RequestProcessProposal) (resp *abc
 	// again in a subsequent round. However, we only want to do this after we've
 	// processed the first block, as we want to avoid overwriting the finalizeState
 	// after state changes during InitChain.
-	if req.Height > app.initialHeight {
+	//
+	// When OE executes several proposals per height, the executions of the
+	// previous proposals are kept running, and each of them starts from its own
+	// FinalizeBlock state.
+	if req.Height > app.initialHeight && !app.optimisticExec.MultipleProposals() {
 		// abort any running OE
 		app.optimisticExec.Abort()
 		app.setState(execModeFinalize, header)
@@ -774,34 +783,9 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 	//
 	// NOTE: Not all raw transactions may adhere to the sdk.Tx interface, e.g.
 	// vote extensions, so skip those.
-	txResults := make([]*abci.ExecTxResult, 0, len(req.Txs))
-	for _, rawTx := range req.Txs {
-		var response *abci.ExecTxResult
-
-		if _, err := app.txDecoder(rawTx); err == nil {
-			response = app.deliverTx(rawTx)
-		} else {
-			// In the case where a transaction included in a block proposal is malformed,
-			// we still want to return a default response to comet. This is because comet
-			// expects a response for each transaction included in a block proposal.
-			response = sdkerrors.ResponseExecTxResultWithEvents(
-				sdkerrors.ErrTxDecode,
-				0,
-				0,
-				nil,
-				false,
-			)
-		}
-
-		// check after every tx if we should abort
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
-			// continue
-		}
-
-		txResults = append(txResults, response)
+	txResults, err := app.executeTxs(ctx, req.Txs)
+	if err != nil {
+		return nil, err
 	}
 
 	if app.finalizeBlockState.ms.TracingEnabled() {
@@ -842,15 +826,33 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 // skipped. This is to support compatibility with proposers injecting vote
 // extensions into the proposal, which should not themselves be executed in cases
 // where they adhere to the sdk.Tx interface.
-func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+//
+// The request and response are then streamed to the ABCI listeners.
+func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (res *abci.ResponseFinalizeBlock, err error) {
+	defer func() {
+		if res == nil || err != nil {
+			return
+		}
+		if err = app.listenFinalizeBlock(req, res); err != nil {
+			res = nil
+		}
+	}()
+
 	if app.optimisticExec.Initialized() {
 		// check if the hash we got is the same as the one we are executing
 		aborted := app.optimisticExec.AbortIfNeeded(req.Hash)
 		// Wait for the OE to finish, regardless of whether it was aborted or not
-		res, err := app.optimisticExec.WaitResult()
+		res, err = app.optimisticExec.WaitResult()
+
+		state := app.optimisticStates[string(req.Hash)]
+		app.optimisticStates = nil
+		app.optimisticExec.Reset()
 
 		// only return if we are not aborting
 		if !aborted {
+			// restore the state of the execution of the decided block, as other
+			// proposals may have been executed after it
+			app.finalizeBlockState = state
 			if res != nil {
 				res.AppHash = app.workingHash()
 			}
@@ -859,17 +861,32 @@ func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.Respons
 
 		// if it was aborted, we need to reset the state
 		app.finalizeBlockState = nil
-		app.optimisticExec.Reset()
 	}
 
 	// if no OE is running, just run the block (this is either a block replay or a OE that got aborted)
-	res, err := app.internalFinalizeBlock(context.Background(), req)
+	res, err = app.internalFinalizeBlock(context.Background(), req)
 	if res != nil {
 		res.AppHash = app.workingHash()
 	}
 	return res, err
 }
 
+// optimisticFinalizeBlock executes a block for optimistic execution. Every
+// execution starts from a new FinalizeBlock state, which is kept so that
+// FinalizeBlock can restore it if the block is decided, as the proposals of a
+// height can be executed one after the other.
+func (app *BaseApp) optimisticFinalizeBlock(ctx context.Context, req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+	app.finalizeBlockState = nil
+	res, err := app.internalFinalizeBlock(ctx, req)
+
+	if app.optimisticStates == nil {
+		app.optimisticStates = make(map[string]*state)
+	}
+	app.optimisticStates[string(req.Hash)] = app.finalizeBlockState
+
+	return res, err
+}
+
 // checkHalt checkes if height or time exceeds halt-height or halt-time respectively.
 func (app *BaseApp) checkHalt(height int64, time time.Time) error {
 	var halt bool
@@ -923,6 +940,9 @@ func (app *BaseApp) Commit() (*abci.ResponseCommit, error) {
 		for _, abciListener := range abciListeners {
 			if err := abciListener.ListenCommit(ctx, *resp, changeSet); err != nil {
 				app.logger.Error("Commit listening hook failed", "height", blockHeight, "err", err)
+				if app.streamingManager.StopNodeOnErr {
+					return nil, fmt.Errorf("commit listening hook failed: %w", err)
+				}
 			}
 		}
 	}
@@ -945,6 +965,22 @@ func (app *BaseApp) Commit() (*abci.ResponseCommit, error) {
 	return resp, nil
 }
 
+// listenFinalizeBlock streams the FinalizeBlock request and response to the
+// ABCI listeners. A listener error is only returned if the node must stop on
+// streaming errors, and is logged otherwise.
+func (app *BaseApp) listenFinalizeBlock(req *abci.RequestFinalizeBlock, res *abci.ResponseFinalizeBlock) error {
+	for _, abciListener := range app.streamingManager.ABCIListeners {
+		if err := abciListener.ListenFinalizeBlock(app.finalizeBlockState.ctx, *req, *res); err != nil {
+			app.logger.Error("FinalizeBlock listening hook failed", "height", req.Height, "err", err)
+			if app.streamingManager.StopNodeOnErr {
+				return fmt.Errorf("finalize block listening hook failed: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
 // workingHash gets the apphash that will be finalized in commit.
 // These writes will be persisted to the root multi-store (app.cms) and flushed to
 // disk in the Commit phase. This means when the ABCI client requests Commit(), the application
@@ -27,12 +27,14 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/oe"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
 	"github.com/cosmos/cosmos-sdk/baseapp/testutil/mock"
 	"github.com/cosmos/cosmos-sdk/testutil"
@@ -1723,6 +1725,58 @@ func TestABCI_PrepareProposal_MaxGas(t *testing.T) {
 	require.Len(t, res.Txs, 10, "invalid number of transactions returned")
 }
 
+func TestABCI_PrepareProposal_Lanes(t *testing.T) {
+	// txs with a counter lower than 10 go to the priority lane, which can use
+	// at most 30% of the block
+	isPriorityTx := func(tx sdk.Tx) bool {
+		return tx.GetMsgs()[0].(*baseapptestutil.MsgCounter).Counter < 10
+	}
+	pool := mempool.NewLaneMempool(
+		mempool.Lane{Name: "priority", Match: isPriorityTx, Mempool: mempool.NewSenderNonceMempool(), MaxBlockSpace: math.LegacyNewDecWithPrec(3, 1)},
+		mempool.Lane{Name: "default", Mempool: mempool.NewSenderNonceMempool()},
+	)
+	suite := NewBaseAppSuite(t, baseapp.SetMempool(pool))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), NoopCounterServerImpl{})
+
+	// set max block gas limit to 100
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxGas: 100},
+		},
+	})
+	require.NoError(t, err)
+
+	// insert 10 txs in each lane, each with a gas limit of 10
+	_, _, addr := testdata.KeyTestPubAddr()
+	for i := int64(0); i < 20; i++ {
+		msg := &baseapptestutil.MsgCounter{Counter: i, FailOnHandler: false, Signer: addr.String()}
+
+		builder := suite.txConfig.NewTxBuilder()
+		err = builder.SetMsgs(msg)
+		require.NoError(t, err)
+		builder.SetMemo("counter=" + strconv.FormatInt(i, 10) + "&failOnAnte=false")
+		builder.SetGasLimit(10)
+		setTxSignature(t, builder, uint64(i))
+
+		err := pool.Insert(sdk.Context{}, builder.GetTx())
+		require.NoError(t, err)
+	}
+
+	res, err := suite.baseApp.PrepareProposal(&abci.RequestPrepareProposal{
+		MaxTxBytes: 1_000_000, // large enough to ignore restriction
+		Height:     1,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 10, "invalid number of transactions returned")
+
+	// the priority lane is limited to 30 gas, the default lane fills the block
+	for i, txBz := range res.Txs {
+		tx, err := suite.txConfig.TxDecoder()(txBz)
+		require.NoError(t, err)
+		require.Equal(t, i < 3, isPriorityTx(tx), "unexpected lane for tx %d", i)
+	}
+}
+
 func TestABCI_PrepareProposal_Failures(t *testing.T) {
 	anteKey := []byte("ante-key")
 	pool := mempool.NewSenderNonceMempool()
@@ -2271,3 +2325,59 @@ func TestOptimisticExecution(t *testing.T) {
 
 	require.Equal(t, int64(50), suite.baseApp.LastBlockHeight())
 }
+
+func TestOptimisticExecutionMultipleProposals(t *testing.T) {
+	expected := newParallelBaseAppSuite(t)
+	suite := newParallelBaseAppSuite(t, baseapp.SetOptimisticExecution(oe.WithMaxProposals(3)))
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	newProposal := func(height int64, round int) [][]byte {
+		builder := suite.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgKeyValue{
+			Key:    []byte("key"),
+			Value:  []byte(fmt.Sprintf("%d.%d", height, round)),
+			Signer: addr.String(),
+		}))
+		builder.SetGasLimit(10000)
+		setTxSignature(t, builder, uint64(round))
+
+		txBytes, err := suite.txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		return [][]byte{txBytes}
+	}
+
+	for height := int64(1); height <= 10; height++ {
+		// the proposals of several rounds are executed optimistically, and the
+		// decided one is either one of them or another block
+		rounds := int(height % 4)
+		var txs [][]byte
+		for round := 0; round < rounds; round++ {
+			txs = newProposal(height, round)
+			res, err := suite.baseApp.ProcessProposal(&abci.RequestProcessProposal{
+				Txs:    txs,
+				Height: height,
+				Hash:   []byte(fmt.Sprintf("hash%d.%d", height, round)),
+			})
+			require.NoError(t, err)
+			require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+		}
+
+		decided := int(height % 3)
+		req := &abci.RequestFinalizeBlock{
+			Height: height,
+			Txs:    newProposal(height, decided),
+			Hash:   []byte(fmt.Sprintf("hash%d.%d", height, decided)),
+		}
+		expectedRes, err := expected.baseApp.FinalizeBlock(req)
+		require.NoError(t, err)
+		res, err := suite.baseApp.FinalizeBlock(req)
+		require.NoError(t, err)
+		require.Equal(t, expectedRes, res)
+
+		_, err = expected.baseApp.Commit()
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+		require.Equal(t, expected.baseApp.LastCommitID(), suite.baseApp.LastCommitID())
+	}
+}
@@ -152,11 +152,20 @@ type (
 	}
 )
 
+// NewDefaultProposalHandler returns a DefaultProposalHandler selecting the
+// transactions of the given mempool. When the mempool is a LaneMempool, the
+// transactions are selected with a lane aware TxSelector enforcing the block
+// space share of each lane.
 func NewDefaultProposalHandler(mp mempool.Mempool, txVerifier ProposalTxVerifier) *DefaultProposalHandler {
+	txSelector := NewDefaultTxSelector()
+	if laneMempool, ok := mp.(*mempool.LaneMempool); ok {
+		txSelector = NewLaneTxSelector(laneMempool)
+	}
+
 	return &DefaultProposalHandler{
 		mempool:    mp,
 		txVerifier: txVerifier,
-		txSelector: NewDefaultTxSelector(),
+		txSelector: txSelector,
 	}
 }
 
@@ -393,3 +402,94 @@ func (ts *defaultTxSelector) SelectTxForProposal(maxTxBytes, maxBlockGas uint64,
 	// check if we've reached capacity; if so, we cannot select any more transactions
 	return ts.totalTxBytes >= maxTxBytes || (maxBlockGas > 0 && (ts.totalTxGas >= maxBlockGas))
 }
+
+// laneTxSelector is a TxSelector that limits the bytes and gas used by the
+// transactions of each lane of a LaneMempool to the lane's share of the block.
+// A transaction exceeding the share of its lane is skipped without halting
+// the selection, so that the following lanes can still fill the block.
+type laneTxSelector struct {
+	defaultTxSelector
+
+	mempool      *mempool.LaneMempool
+	laneTxBytes  map[string]uint64
+	laneTxGas    map[string]uint64
+	laneMaxBytes map[string]uint64
+	laneMaxGas   map[string]uint64
+	maxTxBytes   uint64
+	maxBlockGas  uint64
+}
+
+// NewLaneTxSelector returns a TxSelector enforcing the block space share of
+// each lane of the given LaneMempool.
+func NewLaneTxSelector(mp *mempool.LaneMempool) TxSelector {
+	return &laneTxSelector{
+		mempool:     mp,
+		laneTxBytes: make(map[string]uint64),
+		laneTxGas:   make(map[string]uint64),
+	}
+}
+
+func (ts *laneTxSelector) Clear() {
+	ts.defaultTxSelector.Clear()
+	ts.laneTxBytes = make(map[string]uint64)
+	ts.laneTxGas = make(map[string]uint64)
+	ts.laneMaxBytes, ts.laneMaxGas = nil, nil
+}
+
+func (ts *laneTxSelector) SelectTxForProposal(maxTxBytes, maxBlockGas uint64, memTx sdk.Tx, txBz []byte) bool {
+	lane, ok := ts.mempool.LaneOf(memTx)
+	if !ok {
+		return ts.defaultTxSelector.SelectTxForProposal(maxTxBytes, maxBlockGas, memTx, txBz)
+	}
+
+	ts.updateLimits(maxTxBytes, maxBlockGas)
+
+	txSize := uint64(len(txBz))
+	var txGasLimit uint64
+	if gasTx, ok := memTx.(GasTx); ok {
+		txGasLimit = gasTx.GetGas()
+	}
+
+	if maxBytes, ok := ts.laneMaxBytes[lane.Name]; ok && ts.laneTxBytes[lane.Name]+txSize > maxBytes {
+		return ts.isFull(maxTxBytes, maxBlockGas)
+	}
+	if maxGas, ok := ts.laneMaxGas[lane.Name]; ok && ts.laneTxGas[lane.Name]+txGasLimit > maxGas {
+		return ts.isFull(maxTxBytes, maxBlockGas)
+	}
+
+	selected := len(ts.selectedTxs)
+	stop := ts.defaultTxSelector.SelectTxForProposal(maxTxBytes, maxBlockGas, memTx, txBz)
+	if len(ts.selectedTxs) > selected {
+		ts.laneTxBytes[lane.Name] += txSize
+		ts.laneTxGas[lane.Name] += txGasLimit
+	}
+
+	return stop
+}
+
+// updateLimits computes the maximum bytes and gas of each lane with a block
+// space share, once per proposal or when the block limits change.
+func (ts *laneTxSelector) updateLimits(maxTxBytes, maxBlockGas uint64) {
+	if ts.laneMaxBytes != nil && ts.maxTxBytes == maxTxBytes && ts.maxBlockGas == maxBlockGas {
+		return
+	}
+
+	ts.laneMaxBytes = make(map[string]uint64)
+	ts.laneMaxGas = make(map[string]uint64)
+	for _, lane := range ts.mempool.Lanes() {
+		if lane.MaxBlockSpace.IsNil() || lane.MaxBlockSpace.IsZero() {
+			continue
+		}
+
+		ts.laneMaxBytes[lane.Name] = lane.MaxBlockSpace.MulInt(math.NewIntFromUint64(maxTxBytes)).TruncateInt().Uint64()
+		if maxBlockGas > 0 {
+			ts.laneMaxGas[lane.Name] = lane.MaxBlockSpace.MulInt(math.NewIntFromUint64(maxBlockGas)).TruncateInt().Uint64()
+		}
+	}
+
+	ts.maxTxBytes, ts.maxBlockGas = maxTxBytes, maxBlockGas
+}
+
+func (ts *laneTxSelector) isFull(maxTxBytes, maxBlockGas uint64) bool {
+	return ts.totalTxBytes >= maxTxBytes || (maxBlockGas > 0 && ts.totalTxGas >= maxBlockGas)
+}
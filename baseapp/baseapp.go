@@ -3,9 +3,11 @@ package baseapp
 import (
 	"context"
 	"fmt"
+	"io"
 	"math"
 	"sort"
 	"strconv"
+	"sync"
 
 	"github.com/cockroachdb/errors"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -21,6 +23,7 @@ import (
 	"cosmossdk.io/store"
 	storemetrics "cosmossdk.io/store/metrics"
 	"cosmossdk.io/store/snapshots"
+	"cosmossdk.io/store/streaming"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp/oe"
@@ -172,14 +175,33 @@ type BaseApp struct {
 	// streamingManager for managing instances and configuration of ABCIListener services
 	streamingManager storetypes.StreamingManager
 
+	// streamingProducer is the message broker producer of the "broker" streaming sink
+	streamingProducer streaming.Producer
+
+	// changeIndex is the state change index served by the StateChangeService, if enabled
+	changeIndex *streaming.ChangeIndex
+
 	chainID string
 
 	cdc codec.Codec
 
+	// parallelTxWorkers is the number of goroutines executing the transactions
+	// of a block in parallel, the transactions are executed sequentially if it
+	// is lower than 2.
+	parallelTxWorkers int
+
+	// mempoolMtx serializes the removals of the transactions executed in
+	// parallel from the mempool.
+	mempoolMtx *sync.Mutex
+
 	// optimisticExec contains the context required for Optimistic Execution,
 	// including the goroutine handling.This is experimental and must be enabled
 	// by developers.
 	optimisticExec *oe.OptimisticExecution
+
+	// optimisticStates holds the FinalizeBlock states of the blocks executed
+	// optimistically for the current height, by block hash.
+	optimisticStates map[string]*state
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -199,6 +221,7 @@ func NewBaseApp(
 		txDecoder:        txDecoder,
 		fauxMerkleMode:   false,
 		queryGasLimit:    math.MaxUint64,
+		mempoolMtx:       &sync.Mutex{},
 	}
 
 	for _, option := range options {
@@ -721,7 +744,13 @@ func (app *BaseApp) beginBlock(req *abci.RequestFinalizeBlock) (sdk.BeginBlock,
 }
 
 func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
-	gInfo := sdk.GasInfo{}
+	gInfo, result, anteEvents, err := app.runTx(execModeFinalize, tx)
+	return app.execTxResult(gInfo, result, anteEvents, err)
+}
+
+// execTxResult returns the ExecTxResult of a transaction executed in
+// FinalizeBlock from the outputs of runTx, and records its telemetry.
+func (app *BaseApp) execTxResult(gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) *abci.ExecTxResult {
 	resultStr := "successful"
 
 	var resp *abci.ExecTxResult
@@ -733,7 +762,6 @@ func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
-	gInfo, result, anteEvents, err := app.runTx(execModeFinalize, tx)
 	if err != nil {
 		resultStr = "failed"
 		resp = sdkerrors.ResponseExecTxResultWithEvents(
@@ -791,12 +819,17 @@ func (app *BaseApp) endBlock(ctx context.Context) (sdk.EndBlock, error) {
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes)
+}
+
+// runTxWithContext is runTx processing the transaction with the given context
+// instead of the context of the execution mode state.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode execMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -901,7 +934,9 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 			return gInfo, nil, anteEvents, err
 		}
 	} else if mode == execModeFinalize {
+		app.mempoolMtx.Lock()
 		err = app.mempool.Remove(tx)
+		app.mempoolMtx.Unlock()
 		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
 			return gInfo, nil, anteEvents,
 				fmt.Errorf("failed to remove tx from mempool: %w", err)
@@ -1104,6 +1139,16 @@ func (app *BaseApp) TxEncode(tx sdk.Tx) ([]byte, error) {
 func (app *BaseApp) Close() error {
 	var errs []error
 
+	// Close the ABCI listeners holding resources, e.g. the in-process streaming
+	// sink listeners flushing their buffered records.
+	for _, abciListener := range app.streamingManager.ABCIListeners {
+		if closer, ok := abciListener.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
 	// Close app.db (opened by cosmos-sdk/server/start.go call to openDB)
 	if app.db != nil {
 		app.logger.Info("Closing application.db")
@@ -54,6 +54,7 @@ type (
 		blockTxs           int
 		snapshotInterval   uint64
 		snapshotKeepRecent uint32
+		snapshotMaxDeltas  uint32
 		pruningOpts        pruningtypes.PruningOptions
 	}
 )
@@ -111,12 +112,14 @@ func NewBaseAppSuiteWithSnapshots(t *testing.T, cfg SnapshotsConfig, opts ...fun
 	snapshotTimeout := 1 * time.Minute
 	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), testutil.GetTempDir(t))
 	require.NoError(t, err)
+	snapshotOpts := snapshottypes.NewSnapshotOptions(cfg.snapshotInterval, cfg.snapshotKeepRecent)
+	snapshotOpts.MaxDeltas = cfg.snapshotMaxDeltas
 
 	suite := NewBaseAppSuite(
 		t,
 		append(
 			opts,
-			baseapp.SetSnapshot(snapshotStore, snapshottypes.NewSnapshotOptions(cfg.snapshotInterval, cfg.snapshotKeepRecent)),
+			baseapp.SetSnapshot(snapshotStore, snapshotOpts),
 			baseapp.SetPruning(cfg.pruningOpts),
 		)...,
 	)
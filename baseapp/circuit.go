@@ -1,8 +1,24 @@
 package baseapp
 
-import "context"
+import (
+	"context"
+
+	protov2 "google.golang.org/protobuf/proto"
+)
 
 // CircuitBreaker is an interface that defines the methods for a circuit breaker.
 type CircuitBreaker interface {
 	IsAllowed(ctx context.Context, typeURL string) (bool, error)
 }
+
+// MsgCircuitBreaker is a CircuitBreaker which can also disable the execution of
+// messages based on their content. When the circuit breaker of the
+// MsgServiceRouter implements it, the content of every message routed is
+// checked, including the messages nested in other messages, such as the
+// messages executed by x/authz or x/gov. The content of a message is only
+// checked when HasFilterRules returns true for its type URL.
+type MsgCircuitBreaker interface {
+	CircuitBreaker
+	HasFilterRules(ctx context.Context, typeURL string) (bool, error)
+	IsMsgAllowed(ctx context.Context, msg protov2.Message) (bool, error)
+}
@@ -13,6 +13,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	errorsmod "cosmossdk.io/errors"
+	streamingabci "cosmossdk.io/store/streaming/abci"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -99,4 +100,9 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 
 		server.RegisterService(newDesc, data.handler)
 	}
+
+	// Register the service streaming the state changes of the index, if enabled.
+	if app.changeIndex != nil {
+		streamingabci.RegisterStateChangeServiceServer(server, app.changeIndex)
+	}
 }
@@ -2,12 +2,18 @@ package baseapp
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/cosmos/gogoproto/proto"
 	"google.golang.org/grpc"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -57,6 +63,129 @@ func (msr *MsgServiceRouter) HandlerByTypeURL(typeURL string) MsgServiceHandler
 	return msr.routes[typeURL]
 }
 
+// ExecMsgV2 executes a protobuf v2 message using the handler registered for its type URL,
+// and returns the protobuf v2 response. The events emitted by the handler are emitted
+// in the given context.
+// It allows modules which work with protobuf v2 messages, such as x/accounts, to dispatch
+// messages to other modules. Authorization of the message signers is left to the caller.
+func (msr *MsgServiceRouter) ExecMsgV2(ctx context.Context, msg protov2.Message) (protov2.Message, error) {
+	typeURL := "/" + string(msg.ProtoReflect().Descriptor().FullName())
+	handler := msr.HandlerByTypeURL(typeURL)
+	if handler == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message type: %s", typeURL)
+	}
+
+	// convert the message to its gogoproto counterpart.
+	resolved, err := msr.interfaceRegistry.Resolve(typeURL)
+	if err != nil {
+		return nil, err
+	}
+	sdkMsg, ok := resolved.(sdk.Msg)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "%s does not implement sdk.Msg", typeURL)
+	}
+	bz, err := protov2.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	if err := proto.Unmarshal(bz, sdkMsg); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	res, err := handler(sdkCtx, sdkMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	// emit the events from the executed message
+	sdkEvents := make([]sdk.Event, 0, len(res.Events))
+	for _, event := range res.Events {
+		sdkEvents = append(sdkEvents, sdk.Event(event))
+	}
+	sdkCtx.EventManager().EmitEvents(sdkEvents)
+
+	if len(res.MsgResponses) != 1 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "expected one message response, got %d", len(res.MsgResponses))
+	}
+
+	// convert the response to its protobuf v2 counterpart.
+	anyResp := res.MsgResponses[0]
+	respType, err := msr.messageTypeByURL(anyResp.TypeUrl)
+	if err != nil {
+		return nil, err
+	}
+	resp := respType.New().Interface()
+	if err := protov2.Unmarshal(anyResp.Value, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// messageTypeByURL returns the protobuf v2 type of a message type URL. The
+// messages without a generated protobuf v2 type, such as the gogoproto only
+// messages, get a dynamicpb type built from the descriptor of the interface
+// registry.
+func (msr *MsgServiceRouter) messageTypeByURL(typeURL string) (protoreflect.MessageType, error) {
+	msgType, err := protoregistry.GlobalTypes.FindMessageByURL(typeURL)
+	if err == nil {
+		return msgType, nil
+	}
+	if !errors.Is(err, protoregistry.NotFound) {
+		return nil, err
+	}
+
+	name := typeURL
+	if i := strings.LastIndexByte(typeURL, '/'); i >= 0 {
+		name = typeURL[i+1:]
+	}
+	desc, err := msr.interfaceRegistry.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to resolve message type %s", typeURL)
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "%s is not a message", typeURL)
+	}
+	return dynamicpb.NewMessageType(msgDesc), nil
+}
+
+// isMsgAllowed checks the content of a message against a MsgCircuitBreaker,
+// which works with the protobuf v2 counterpart of the message. The message is
+// only converted when the circuit breaker has filter rules for its type URL.
+func (msr *MsgServiceRouter) isMsgAllowed(ctx context.Context, cb MsgCircuitBreaker, msg sdk.Msg) error {
+	msgURL := sdk.MsgTypeURL(msg)
+	hasRules, err := cb.HasFilterRules(ctx, msgURL)
+	if err != nil {
+		return err
+	}
+	if !hasRules {
+		return nil
+	}
+
+	msgType, err := msr.messageTypeByURL(msgURL)
+	if err != nil {
+		return err
+	}
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	msgV2 := msgType.New().Interface()
+	if err := protov2.Unmarshal(bz, msgV2); err != nil {
+		return err
+	}
+
+	isAllowed, err := cb.IsMsgAllowed(ctx, msgV2)
+	if err != nil {
+		return err
+	}
+	if !isAllowed {
+		return fmt.Errorf("circuit breaker filter rule disables execution of this message: %s", msgURL)
+	}
+	return nil
+}
+
 // RegisterService implements the gRPC Server.RegisterService method. sd is a gRPC
 // service description, handler is an object which implements that gRPC service.
 //
@@ -144,6 +273,12 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 				if !isAllowed {
 					return nil, fmt.Errorf("circuit breaker disables execution of this message: %s", msgURL)
 				}
+
+				if cb, ok := msr.circuitBreaker.(MsgCircuitBreaker); ok {
+					if err := msr.isMsgAllowed(ctx, cb, msg); err != nil {
+						return nil, err
+					}
+				}
 			}
 
 			// Call the method handler from the service description with the handler object.
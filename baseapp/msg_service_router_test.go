@@ -5,17 +5,22 @@ import (
 	"testing"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
 
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata/testpb"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -165,3 +170,101 @@ func TestMsgService(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, abci.CodeTypeOK, res.TxResults[0].Code, "res=%+v", res)
 }
+
+func TestMsgServiceRouter_ExecMsgV2(t *testing.T) {
+	var (
+		appBuilder *runtime.AppBuilder
+		registry   codectypes.InterfaceRegistry
+	)
+	err := depinject.Inject(
+		depinject.Configs(
+			makeMinimalConfig(),
+			depinject.Supply(log.NewNopLogger()),
+		), &appBuilder, &registry)
+	require.NoError(t, err)
+	app := appBuilder.Build(dbm.NewMemDB(), nil)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{})
+
+	// the message is not routed yet.
+	_, err = app.MsgServiceRouter().ExecMsgV2(ctx, &testpb.MsgCreateDog{Dog: &testpb.Dog{Name: "Spot"}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnknownRequest)
+
+	testdata.RegisterInterfaces(registry)
+	testdata.RegisterMsgServer(app.MsgServiceRouter(), testdata.MsgServerImpl{})
+
+	resp, err := app.MsgServiceRouter().ExecMsgV2(ctx, &testpb.MsgCreateDog{Dog: &testpb.Dog{Name: "Spot"}})
+	require.NoError(t, err)
+	require.True(t, protov2.Equal(&testpb.MsgCreateDogResponse{Name: "Spot"}, resp))
+}
+
+// keyValueImpl is a KeyValue msg server which doesn't write to any store.
+type keyValueImpl struct{}
+
+func (keyValueImpl) Set(context.Context, *baseapptestutil.MsgKeyValue) (*baseapptestutil.MsgCreateKeyValueResponse, error) {
+	return &baseapptestutil.MsgCreateKeyValueResponse{}, nil
+}
+
+// mockMsgCircuitBreaker disables the messages whose field "value" is "evil",
+// and records the messages it checked.
+type mockMsgCircuitBreaker struct {
+	rules   map[string]bool
+	checked []protov2.Message
+}
+
+func (m *mockMsgCircuitBreaker) IsAllowed(context.Context, string) (bool, error) {
+	return true, nil
+}
+
+func (m *mockMsgCircuitBreaker) HasFilterRules(_ context.Context, typeURL string) (bool, error) {
+	return m.rules[typeURL], nil
+}
+
+func (m *mockMsgCircuitBreaker) IsMsgAllowed(_ context.Context, msg protov2.Message) (bool, error) {
+	m.checked = append(m.checked, msg)
+	field := msg.ProtoReflect().Descriptor().Fields().ByName("value")
+	return field == nil || string(msg.ProtoReflect().Get(field).Bytes()) != "evil", nil
+}
+
+func TestMsgServiceRouter_MsgCircuitBreaker(t *testing.T) {
+	var (
+		appBuilder *runtime.AppBuilder
+		registry   codectypes.InterfaceRegistry
+	)
+	err := depinject.Inject(
+		depinject.Configs(
+			makeMinimalConfig(),
+			depinject.Supply(log.NewNopLogger()),
+		), &appBuilder, &registry)
+	require.NoError(t, err)
+	app := appBuilder.Build(dbm.NewMemDB(), nil)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{})
+
+	// MsgKeyValue is a gogoproto only message, without a protobuf v2 type.
+	baseapptestutil.RegisterInterfaces(registry)
+	baseapptestutil.RegisterKeyValueServer(app.MsgServiceRouter(), keyValueImpl{})
+	cb := &mockMsgCircuitBreaker{rules: map[string]bool{}}
+	app.MsgServiceRouter().SetCircuit(cb)
+
+	exec := func(value string) error {
+		msg := &baseapptestutil.MsgKeyValue{Key: []byte("key"), Value: []byte(value)}
+		_, err := app.MsgServiceRouter().Handler(msg)(ctx, msg)
+		return err
+	}
+
+	// without filter rules, the message content is not checked
+	require.NoError(t, exec("evil"))
+	require.Empty(t, cb.checked)
+
+	// with filter rules, the content of the message is checked through its
+	// dynamic protobuf v2 counterpart
+	cb.rules["/MsgKeyValue"] = true
+	require.NoError(t, exec("good"))
+	require.ErrorContains(t, exec("evil"), "circuit breaker filter rule disables execution of this message: /MsgKeyValue")
+	require.Len(t, cb.checked, 2)
+
+	// the gogoproto only responses are returned as dynamic messages by ExecMsgV2
+	cb.rules["/MsgKeyValue"] = false
+	resp, err := app.MsgServiceRouter().ExecMsgV2(ctx, cb.checked[0])
+	require.NoError(t, err)
+	require.Equal(t, "MsgCreateKeyValueResponse", string(resp.ProtoReflect().Descriptor().FullName()))
+}
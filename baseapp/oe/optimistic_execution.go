@@ -9,27 +9,67 @@ import (
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/hashicorp/go-metrics"
 
 	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
 // FinalizeBlockFunc is the function that is called by the OE to finalize the
 // block. It is the same as the one in the ABCI app.
 type FinalizeBlockFunc func(context.Context, *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error)
 
+// AbortReason is the cause of the abort of an optimistic execution.
+type AbortReason string
+
+const (
+	// AbortReasonHashMismatch is the cause of the abort of an optimistic
+	// execution whose proposal is not the decided block.
+	AbortReasonHashMismatch AbortReason = "hash_mismatch"
+	// AbortReasonNewProposal is the cause of the abort of an optimistic
+	// execution superseded by a new proposal for the same height.
+	AbortReasonNewProposal AbortReason = "new_proposal"
+	// AbortReasonAbortRate is the cause of the abort of an optimistic execution
+	// emulated by the abort rate testing option.
+	AbortReasonAbortRate AbortReason = "abort_rate"
+)
+
+// speculation is the optimistic execution of a single proposal.
+type speculation struct {
+	request    *abci.RequestFinalizeBlock
+	response   *abci.ResponseFinalizeBlock
+	err        error
+	stopCh     chan struct{}
+	cancelFunc func() // cancel function for the context
+
+	done     bool          // true once the execution finished
+	duration time.Duration // execution time, set once the execution finished
+	aborted  AbortReason   // cause of the abort, empty if not aborted
+}
+
 // OptimisticExecution is a struct that contains the OE context. It is used to
 // run the FinalizeBlock function in a goroutine, and to abort it if needed.
+//
+// By default a single proposal is executed per height, and the execution of a
+// new proposal is expected to be preceded by an Abort. With WithMaxProposals,
+// the OE speculates on several proposals received for the same height, and
+// keeps the result of the one that is decided. The proposals are executed one
+// at a time, in the order they are received, as they share the state of the
+// application.
 type OptimisticExecution struct {
 	finalizeBlockFunc FinalizeBlockFunc // ABCI FinalizeBlock function with a context
 	logger            log.Logger
 
-	mtx         sync.Mutex
-	stopCh      chan struct{}
-	request     *abci.RequestFinalizeBlock
-	response    *abci.ResponseFinalizeBlock
-	err         error
-	cancelFunc  func() // cancel function for the context
-	initialized bool   // A boolean value indicating whether the struct has been initialized
+	mtx          sync.Mutex
+	runMtx       sync.Mutex     // serializes the executions of the proposals
+	speculations []*speculation // executions of the current height, in the order they were started
+	result       *speculation   // execution kept by AbortIfNeeded
+	abortReason  AbortReason    // cause of the last abort
+	initialized  bool           // A boolean value indicating whether the struct has been initialized
+
+	// maxProposals is the maximum number of proposals executed for a height.
+	maxProposals int
 
 	// debugging/testing options
 	abortRate int // number from 0 to 100 that determines the percentage of OE that should be aborted
@@ -38,7 +78,7 @@ type OptimisticExecution struct {
 // NewOptimisticExecution initializes the Optimistic Execution context but does not start it.
 func NewOptimisticExecution(logger log.Logger, fn FinalizeBlockFunc, opts ...func(*OptimisticExecution)) *OptimisticExecution {
 	logger = logger.With(log.ModuleKey, "oe")
-	oe := &OptimisticExecution{logger: logger, finalizeBlockFunc: fn}
+	oe := &OptimisticExecution{logger: logger, finalizeBlockFunc: fn, maxProposals: 1}
 	for _, opt := range opts {
 		opt(oe)
 	}
@@ -54,14 +94,25 @@ func WithAbortRate(rate int) func(*OptimisticExecution) {
 	}
 }
 
+// WithMaxProposals sets the maximum number of proposals received for the same
+// height that the OE executes, so that the execution of the decided block is
+// kept when it is not the last proposal. Proposals received once the maximum
+// is reached are not executed. It defaults to 1.
+func WithMaxProposals(n int) func(*OptimisticExecution) {
+	return func(oe *OptimisticExecution) {
+		if n > 1 {
+			oe.maxProposals = n
+		}
+	}
+}
+
 // Reset resets the OE context. Must be called whenever we want to invalidate
 // the current OE.
 func (oe *OptimisticExecution) Reset() {
 	oe.mtx.Lock()
 	defer oe.mtx.Unlock()
-	oe.request = nil
-	oe.response = nil
-	oe.err = nil
+	oe.speculations = nil
+	oe.result = nil
 	oe.initialized = false
 }
 
@@ -69,6 +120,13 @@ func (oe *OptimisticExecution) Enabled() bool {
 	return oe != nil
 }
 
+// MultipleProposals returns true if the OE executes more than one proposal per
+// height. In this case, the executions of the previous proposals must not be
+// aborted when a new proposal is received.
+func (oe *OptimisticExecution) MultipleProposals() bool {
+	return oe != nil && oe.maxProposals > 1
+}
+
 // Initialized returns true if the OE was initialized, meaning that it contains
 // a request and it was run or it is running.
 func (oe *OptimisticExecution) Initialized() bool {
@@ -81,42 +139,120 @@ func (oe *OptimisticExecution) Initialized() bool {
 	return oe.initialized
 }
 
+// AbortReason returns the cause of the last abort of an execution, or an empty
+// reason if no execution was aborted.
+func (oe *OptimisticExecution) AbortReason() AbortReason {
+	if oe == nil {
+		return ""
+	}
+	oe.mtx.Lock()
+	defer oe.mtx.Unlock()
+
+	return oe.abortReason
+}
+
 // Execute initializes the OE and starts it in a goroutine.
 func (oe *OptimisticExecution) Execute(req *abci.RequestProcessProposal) {
 	oe.mtx.Lock()
 	defer oe.mtx.Unlock()
 
-	oe.stopCh = make(chan struct{})
-	oe.request = &abci.RequestFinalizeBlock{
-		Txs:                req.Txs,
-		DecidedLastCommit:  req.ProposedLastCommit,
-		Misbehavior:        req.Misbehavior,
-		Hash:               req.Hash,
-		Height:             req.Height,
-		Time:               req.Time,
-		NextValidatorsHash: req.NextValidatorsHash,
-		ProposerAddress:    req.ProposerAddress,
+	if len(oe.speculations) > 0 && (!oe.MultipleProposals() || oe.speculations[0].request.Height != req.Height) {
+		for _, s := range oe.speculations {
+			oe.abort(s, AbortReasonNewProposal)
+		}
+		oe.speculations = nil
+	}
+
+	for _, s := range oe.speculations {
+		if bytes.Equal(s.request.Hash, req.Hash) {
+			// the proposal is received again in a later round
+			return
+		}
+	}
+	if len(oe.speculations) >= oe.maxProposals {
+		oe.logger.Debug("OE skipped, too many proposals", "height", req.Height, "hash", hex.EncodeToString(req.Hash), "max_proposals", oe.maxProposals)
+		return
 	}
 
-	oe.logger.Debug("OE started", "height", req.Height, "hash", hex.EncodeToString(req.Hash), "time", req.Time.String())
 	ctx, cancel := context.WithCancel(context.Background())
-	oe.cancelFunc = cancel
+	s := &speculation{
+		request: &abci.RequestFinalizeBlock{
+			Txs:                req.Txs,
+			DecidedLastCommit:  req.ProposedLastCommit,
+			Misbehavior:        req.Misbehavior,
+			Hash:               req.Hash,
+			Height:             req.Height,
+			Time:               req.Time,
+			NextValidatorsHash: req.NextValidatorsHash,
+			ProposerAddress:    req.ProposerAddress,
+		},
+		stopCh:     make(chan struct{}),
+		cancelFunc: cancel,
+	}
+
+	oe.logger.Debug("OE started", "height", req.Height, "hash", hex.EncodeToString(req.Hash), "time", req.Time.String())
+	oe.speculations = append(oe.speculations, s)
 	oe.initialized = true
+	telemetry.IncrCounter(1, "oe", "started")
+
+	go oe.run(ctx, s)
+}
+
+// run executes the proposal of a speculation, once the previous ones finished.
+func (oe *OptimisticExecution) run(ctx context.Context, s *speculation) {
+	oe.runMtx.Lock()
+	start := time.Now()
+	// the speculation may have been aborted while waiting for the previous ones
+	var resp *abci.ResponseFinalizeBlock
+	err := ctx.Err()
+	if err == nil {
+		resp, err = oe.finalizeBlockFunc(ctx, s.request)
+	}
+	executionTime := time.Since(start)
+	oe.runMtx.Unlock()
+
+	oe.mtx.Lock()
+	defer oe.mtx.Unlock()
+	oe.logger.Debug("OE finished", "duration", executionTime.String(), "height", s.request.Height, "hash", hex.EncodeToString(s.request.Hash))
+	s.response, s.err = resp, err
+	s.done, s.duration = true, executionTime
+	if s.aborted != "" {
+		recordWastedTime(s)
+	}
+	close(s.stopCh)
+}
+
+// abort cancels the execution of a speculation and records the cause. It must
+// be called with oe.mtx held.
+func (oe *OptimisticExecution) abort(s *speculation, reason AbortReason) {
+	if s.aborted != "" {
+		return
+	}
+
+	s.cancelFunc()
+	s.aborted = reason
+	oe.abortReason = reason
+	telemetry.IncrCounterWithLabels([]string{"oe", "aborted"}, 1, []metrics.Label{telemetry.NewLabel("reason", string(reason))})
+	if s.done {
+		recordWastedTime(s)
+	}
+}
 
-	go func() {
-		start := time.Now()
-		resp, err := oe.finalizeBlockFunc(ctx, oe.request)
-		oe.mtx.Lock()
-		executionTime := time.Since(start)
-		oe.logger.Debug("OE finished", "duration", executionTime.String(), "height", req.Height, "hash", hex.EncodeToString(req.Hash))
-		oe.response, oe.err = resp, err
-		close(oe.stopCh)
-		oe.mtx.Unlock()
-	}()
+// recordWastedTime records the time spent executing an aborted speculation,
+// once it is both aborted and finished.
+func recordWastedTime(s *speculation) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"oe", "wasted_time_ms"},
+		float32(s.duration.Milliseconds()),
+		[]metrics.Label{telemetry.NewLabel("reason", string(s.aborted))},
+	)
 }
 
 // AbortIfNeeded aborts the OE if the request hash is not the same as the one in
 // the running OE. Returns true if the OE was aborted.
+//
+// When several proposals are executed, the execution of the one with the
+// request hash is kept and the others are aborted.
 func (oe *OptimisticExecution) AbortIfNeeded(reqHash []byte) bool {
 	if oe == nil {
 		return false
@@ -125,33 +261,69 @@ func (oe *OptimisticExecution) AbortIfNeeded(reqHash []byte) bool {
 	oe.mtx.Lock()
 	defer oe.mtx.Unlock()
 
-	if !bytes.Equal(oe.request.Hash, reqHash) {
-		oe.logger.Error("OE aborted due to hash mismatch", "oe_hash", hex.EncodeToString(oe.request.Hash), "req_hash", hex.EncodeToString(reqHash), "oe_height", oe.request.Height, "req_height", oe.request.Height)
-		oe.cancelFunc()
+	oe.result = nil
+	for _, s := range oe.speculations {
+		if s.aborted != "" {
+			continue
+		}
+		if oe.result == nil && bytes.Equal(s.request.Hash, reqHash) {
+			oe.result = s
+			continue
+		}
+
+		oe.logger.Error("OE aborted due to hash mismatch", "oe_hash", hex.EncodeToString(s.request.Hash), "req_hash", hex.EncodeToString(reqHash), "oe_height", s.request.Height)
+		oe.abort(s, AbortReasonHashMismatch)
+	}
+
+	if oe.result == nil {
 		return true
 	} else if oe.abortRate > 0 && rand.Intn(100) < oe.abortRate {
 		// this is for test purposes only, we can emulate a certain percentage of
 		// OE needed to be aborted.
-		oe.cancelFunc()
+		oe.abort(oe.result, AbortReasonAbortRate)
+		oe.result = nil
 		oe.logger.Error("OE aborted due to test abort rate")
 		return true
 	}
 
+	telemetry.IncrCounter(1, "oe", "hit")
 	return false
 }
 
 // Abort aborts the OE unconditionally and waits for it to finish.
 func (oe *OptimisticExecution) Abort() {
-	if oe == nil || oe.cancelFunc == nil {
+	if oe == nil {
 		return
 	}
 
-	oe.cancelFunc()
-	<-oe.stopCh
+	oe.mtx.Lock()
+	speculations := oe.speculations
+	for _, s := range speculations {
+		oe.abort(s, AbortReasonNewProposal)
+	}
+	oe.mtx.Unlock()
+
+	for _, s := range speculations {
+		<-s.stopCh
+	}
 }
 
-// WaitResult waits for the OE to finish and returns the result.
+// WaitResult waits for the OE to finish and returns the result. It is the
+// result of the execution kept by AbortIfNeeded, or of the last one started if
+// AbortIfNeeded was not called or aborted all of them.
 func (oe *OptimisticExecution) WaitResult() (*abci.ResponseFinalizeBlock, error) {
-	<-oe.stopCh
-	return oe.response, oe.err
+	oe.mtx.Lock()
+	speculations, result := oe.speculations, oe.result
+	oe.mtx.Unlock()
+
+	for _, s := range speculations {
+		<-s.stopCh
+	}
+	if result == nil {
+		if len(speculations) == 0 {
+			return nil, nil
+		}
+		result = speculations[len(speculations)-1]
+	}
+	return result.response, result.err
 }
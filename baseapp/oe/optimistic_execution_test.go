@@ -32,3 +32,68 @@ func TestOptimisticExecution(t *testing.T) {
 
 	oe.Reset()
 }
+
+func TestOptimisticExecutionAbortReason(t *testing.T) {
+	oe := NewOptimisticExecution(log.NewNopLogger(), testFinalizeBlock)
+	assert.Equal(t, AbortReason(""), oe.AbortReason())
+
+	oe.Execute(&abci.RequestProcessProposal{Hash: []byte("first")})
+	oe.Abort()
+	assert.Equal(t, AbortReasonNewProposal, oe.AbortReason())
+
+	oe.Execute(&abci.RequestProcessProposal{Hash: []byte("second")})
+	assert.True(t, oe.AbortIfNeeded([]byte("other")))
+	assert.Equal(t, AbortReasonHashMismatch, oe.AbortReason())
+	oe.Reset()
+
+	oe = NewOptimisticExecution(log.NewNopLogger(), testFinalizeBlock, WithAbortRate(100))
+	oe.Execute(&abci.RequestProcessProposal{Hash: []byte("test")})
+	assert.True(t, oe.AbortIfNeeded([]byte("test")))
+	assert.Equal(t, AbortReasonAbortRate, oe.AbortReason())
+}
+
+func TestOptimisticExecutionMultipleProposals(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+	var executed []string
+	fn := func(ctx context.Context, req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+		// executions are serialized, so there is no need for synchronization
+		executed = append(executed, string(req.Hash))
+		if string(req.Hash) == "first" {
+			started <- struct{}{}
+			<-release
+		}
+		return &abci.ResponseFinalizeBlock{AppHash: req.Hash}, nil
+	}
+
+	oe := NewOptimisticExecution(log.NewNopLogger(), fn, WithMaxProposals(2))
+	assert.True(t, oe.MultipleProposals())
+
+	oe.Execute(&abci.RequestProcessProposal{Height: 1, Hash: []byte("first")})
+	<-started
+	oe.Execute(&abci.RequestProcessProposal{Height: 1, Hash: []byte("second")})
+	// received again, or beyond the maximum number of proposals
+	oe.Execute(&abci.RequestProcessProposal{Height: 1, Hash: []byte("first")})
+	oe.Execute(&abci.RequestProcessProposal{Height: 1, Hash: []byte("third")})
+	close(release)
+
+	assert.False(t, oe.AbortIfNeeded([]byte("second")))
+	resp, err := oe.WaitResult()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("second"), resp.AppHash)
+	assert.Equal(t, []string{"first", "second"}, executed)
+	assert.Equal(t, AbortReasonHashMismatch, oe.AbortReason())
+	oe.Reset()
+	assert.False(t, oe.Initialized())
+
+	// an execution aborted before it starts is not run
+	executed = nil
+	release = make(chan struct{})
+	oe.Execute(&abci.RequestProcessProposal{Height: 2, Hash: []byte("first")})
+	<-started
+	oe.Execute(&abci.RequestProcessProposal{Height: 2, Hash: []byte("second")})
+	assert.True(t, oe.AbortIfNeeded([]byte("third")))
+	close(release)
+	_, err = oe.WaitResult()
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []string{"first"}, executed)
+}
@@ -10,9 +10,12 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 
 	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/pruning"
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"cosmossdk.io/store/streaming"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp/oe"
@@ -30,6 +33,27 @@ func SetPruning(opts pruningtypes.PruningOptions) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetPruning(opts) }
 }
 
+// SetPruningSizeFunc sets the function returning the size of the application
+// database, which is required by the size pruning strategy.
+func SetPruningSizeFunc(dbSize pruning.SizeFunc) func(*BaseApp) {
+	return func(bapp *BaseApp) {
+		if rms, ok := bapp.cms.(*rootmulti.Store); ok {
+			rms.SetPruningSizeFunc(dbSize)
+		}
+	}
+}
+
+// SetCommitConcurrency sets the maximum number of stores of the multistore
+// committed concurrently. The stores are committed sequentially if it is lower
+// than 2, the default.
+func SetCommitConcurrency(concurrency int) func(*BaseApp) {
+	return func(bapp *BaseApp) {
+		if rms, ok := bapp.cms.(*rootmulti.Store); ok {
+			rms.SetCommitConcurrency(concurrency)
+		}
+	}
+}
+
 // SetMinGasPrices returns an option that sets the minimum gas prices on the app.
 func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	gasPrices, err := sdk.ParseDecCoins(gasPricesStr)
@@ -110,10 +134,16 @@ func SetChainID(chainID string) func(*BaseApp) {
 // SetOptimisticExecution enables optimistic execution.
 func SetOptimisticExecution(opts ...func(*oe.OptimisticExecution)) func(*BaseApp) {
 	return func(app *BaseApp) {
-		app.optimisticExec = oe.NewOptimisticExecution(app.logger, app.internalFinalizeBlock, opts...)
+		app.optimisticExec = oe.NewOptimisticExecution(app.logger, app.optimisticFinalizeBlock, opts...)
 	}
 }
 
+// SetParallelTxWorkers sets the number of goroutines executing the transactions
+// of a block in parallel in FinalizeBlock.
+func SetParallelTxWorkers(workers int) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetParallelTxWorkers(workers) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -317,6 +347,19 @@ func (app *BaseApp) SetQueryMultiStore(ms storetypes.MultiStore) {
 	app.qms = ms
 }
 
+// SetParallelTxWorkers sets the number of goroutines executing the transactions
+// of a block in parallel in FinalizeBlock. The transactions are executed
+// optimistically in parallel, then committed in the order of the block, the
+// conflicting ones being executed again, such that the results and the state
+// are the same as with a sequential execution. The transactions are executed
+// sequentially if workers is lower than 2, which is the default.
+func (app *BaseApp) SetParallelTxWorkers(workers int) {
+	if app.sealed {
+		panic("SetParallelTxWorkers() on sealed BaseApp")
+	}
+	app.parallelTxWorkers = workers
+}
+
 // SetMempool sets the mempool for the BaseApp and is required for the app to start up.
 func (app *BaseApp) SetMempool(mempool mempool.Mempool) {
 	if app.sealed {
@@ -371,3 +414,13 @@ func (app *BaseApp) SetStoreMetrics(gatherer metrics.StoreMetrics) {
 func (app *BaseApp) SetStreamingManager(manager storetypes.StreamingManager) {
 	app.streamingManager = manager
 }
+
+// SetStreamingProducer sets the message broker producer used by the "broker"
+// streaming sink. It must be set before calling RegisterStreamingServices.
+func (app *BaseApp) SetStreamingProducer(producer streaming.Producer) {
+	if app.sealed {
+		panic("SetStreamingProducer() on sealed BaseApp")
+	}
+
+	app.streamingProducer = producer
+}
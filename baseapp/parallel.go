@@ -0,0 +1,476 @@
+package baseapp
+
+import (
+	"context"
+	"io"
+	"sort"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/tracekv"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// executeTxs executes the transactions of a block in FinalizeBlock, in parallel
+// if it is enabled and supported by the FinalizeBlock state, and returns their
+// results. The context received is only used to handle early cancellation.
+func (app *BaseApp) executeTxs(ctx context.Context, txs [][]byte) ([]*abci.ExecTxResult, error) {
+	if ms, ok := app.finalizeBlockState.ms.(cachemulti.Store); ok && app.parallelTxWorkers > 1 && !ms.TracingEnabled() {
+		return app.executeTxsParallel(ctx, ms, txs)
+	}
+
+	txResults := make([]*abci.ExecTxResult, 0, len(txs))
+	for _, rawTx := range txs {
+		var response *abci.ExecTxResult
+
+		if _, err := app.txDecoder(rawTx); err == nil {
+			response = app.deliverTx(rawTx)
+		} else {
+			response = txDecodeErrorResult()
+		}
+
+		// check after every tx if we should abort
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+			// continue
+		}
+
+		txResults = append(txResults, response)
+	}
+
+	return txResults, nil
+}
+
+// txDecodeErrorResult returns the result of a transaction of a block which
+// cannot be decoded.
+//
+// In the case where a transaction included in a block proposal is malformed,
+// we still want to return a default response to comet. This is because comet
+// expects a response for each transaction included in a block proposal.
+func txDecodeErrorResult() *abci.ExecTxResult {
+	return sdkerrors.ResponseExecTxResultWithEvents(
+		sdkerrors.ErrTxDecode,
+		0,
+		0,
+		nil,
+		false,
+	)
+}
+
+// executeTxsParallel executes the transactions of a block like a sequential
+// execution, producing the same results and the same state, but executes them
+// optimistically in parallel first, similarly to Block-STM:
+//
+//  1. The transactions are executed speculatively by app.parallelTxWorkers
+//     goroutines, each on its own branch of the FinalizeBlock state, recording
+//     the keys it reads from and writes to the state.
+//  2. In the order of the block, a transaction which didn't read any key
+//     written by the transactions before it observed the same state as in a
+//     sequential execution, its branch is written to the FinalizeBlock state.
+//     Otherwise, the transaction is executed again on the FinalizeBlock state
+//     as of after the transactions before it.
+//
+// The gas meter and the block gas meter of the FinalizeBlock context, which
+// change with each transaction, are replaced by speculative gas meters in a
+// speculative execution. The gas consumed on them is applied to the actual
+// meters when the transaction is committed, and the transaction is executed
+// again if it observed them in a way which cannot be validated.
+//
+// The speculative executions run concurrently, so everything they share besides
+// the FinalizeBlock state, such as the tx decoder, the signing context and the
+// in-memory state of the keepers, must be safe for concurrent use.
+func (app *BaseApp) executeTxsParallel(ctx context.Context, ms cachemulti.Store, txs [][]byte) ([]*abci.ExecTxResult, error) {
+	blockCtx := app.finalizeBlockState.ctx
+	gasConsumed := blockCtx.GasMeter().GasConsumed()
+
+	execs := make([]*txExecution, len(txs))
+	for i := range execs {
+		execs[i] = &txExecution{done: make(chan struct{})}
+	}
+
+	var (
+		wg    sync.WaitGroup
+		next  = make(chan int)
+		abort = make(chan struct{})
+	)
+
+	go func() {
+		defer close(next)
+		for i := range txs {
+			select {
+			case next <- i:
+			case <-abort:
+				return
+			}
+		}
+	}()
+
+	wg.Add(app.parallelTxWorkers)
+	for w := 0; w < app.parallelTxWorkers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				app.speculateTx(blockCtx, ms, gasConsumed, txs[i], execs[i])
+			}
+		}()
+	}
+
+	// the speculative executions access the FinalizeBlock state, so they must
+	// be done before returning
+	defer func() {
+		close(abort)
+		wg.Wait()
+	}()
+
+	written := make(map[storetypes.StoreKey]*keySet)
+	txResults := make([]*abci.ExecTxResult, 0, len(txs))
+	for i, rawTx := range txs {
+		exec := execs[i]
+		<-exec.done
+
+		var response *abci.ExecTxResult
+		if exec.decoded {
+			if !app.commitSpeculation(blockCtx, gasConsumed, exec, written) {
+				exec = app.reexecuteTx(ms, rawTx)
+			}
+
+			exec.ms.Write()
+			exec.access.addWrites(written)
+
+			response = app.execTxResult(exec.gInfo, exec.result, exec.anteEvents, exec.err)
+		} else {
+			response = txDecodeErrorResult()
+		}
+
+		// check after every tx if we should abort
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+			// continue
+		}
+
+		txResults = append(txResults, response)
+	}
+
+	return txResults, nil
+}
+
+// txExecution is the execution of a transaction on a tracked branch of the
+// FinalizeBlock state, which isn't written yet.
+type txExecution struct {
+	// done is closed when the speculative execution is done.
+	done chan struct{}
+	// decoded is false if the transaction cannot be decoded, in which case it
+	// isn't executed.
+	decoded bool
+	// panicked is true if the speculative execution panicked outside of runTx.
+	panicked bool
+
+	ms     storetypes.CacheMultiStore
+	access *txAccess
+
+	// gasMeter and blockGasMeter are the speculative gas meters of a
+	// speculative execution.
+	gasMeter      *speculativeGasMeter
+	blockGasMeter *speculativeGasMeter
+
+	gInfo      sdk.GasInfo
+	result     *sdk.Result
+	anteEvents []abci.Event
+	err        error
+}
+
+// speculateTx executes a transaction speculatively on a new tracked branch of
+// the FinalizeBlock state. gasConsumed is the gas consumed by the gas meter of
+// the FinalizeBlock context before the transactions of the block.
+func (app *BaseApp) speculateTx(blockCtx sdk.Context, ms cachemulti.Store, gasConsumed storetypes.Gas, txBytes []byte, exec *txExecution) {
+	defer close(exec.done)
+	defer func() {
+		if r := recover(); r != nil {
+			exec.panicked = true
+		}
+	}()
+
+	if _, err := app.txDecoder(txBytes); err != nil {
+		return
+	}
+	exec.decoded = true
+
+	exec.access = newTxAccess()
+	exec.ms = ms.CacheMultiStoreWithWrapper(exec.access.track)
+
+	gasMeter := storetypes.NewInfiniteGasMeter()
+	gasMeter.ConsumeGas(gasConsumed, "speculative gas meter")
+	exec.gasMeter = &speculativeGasMeter{GasMeter: gasMeter}
+	exec.blockGasMeter = &speculativeGasMeter{GasMeter: storetypes.NewInfiniteGasMeter(), ignoreOutOfGas: true}
+
+	// same as getContextForTx, with the speculative branch and gas meters
+	ctx := blockCtx.
+		WithMultiStore(exec.ms).
+		WithTxBytes(txBytes).
+		WithEventManager(sdk.NewEventManager()).
+		WithGasMeter(exec.gasMeter).
+		WithBlockGasMeter(exec.blockGasMeter)
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+
+	exec.gInfo, exec.result, exec.anteEvents, exec.err = app.runTxWithContext(ctx, execModeFinalize, txBytes)
+}
+
+// commitSpeculation validates the speculative execution of a transaction
+// against the transactions before it, which wrote the given keys, and applies
+// the gas it consumed to the gas meters of the FinalizeBlock context. It
+// returns false if the transaction must be executed again.
+func (app *BaseApp) commitSpeculation(blockCtx sdk.Context, gasConsumed storetypes.Gas, exec *txExecution, written map[storetypes.StoreKey]*keySet) bool {
+	if exec.panicked || exec.access.conflicts(written) {
+		return false
+	}
+
+	// A sequential execution returns early if there is no block gas left, and
+	// panics when consuming block gas past the limit. Otherwise, the block gas
+	// meter doesn't change during the transaction.
+	blockGasMeter := blockCtx.BlockGasMeter()
+	blockGasUsed := exec.blockGasMeter.GasMeter.GasConsumed()
+	if exec.blockGasMeter.observed || blockGasMeter.IsOutOfGas() || blockGasUsed > blockGasMeter.GasRemaining() {
+		return false
+	}
+
+	gasMeter := blockCtx.GasMeter()
+	if exec.gasMeter.observed && gasMeter.GasConsumed() != gasConsumed {
+		return false
+	}
+
+	blockGasMeter.ConsumeGas(blockGasUsed, "block gas meter")
+	gasMeter.ConsumeGas(exec.gasMeter.GasMeter.GasConsumed()-gasConsumed, "speculative gas meter")
+
+	return true
+}
+
+// reexecuteTx executes a transaction on a new tracked branch of the
+// FinalizeBlock state, as in a sequential execution.
+func (app *BaseApp) reexecuteTx(ms cachemulti.Store, txBytes []byte) *txExecution {
+	exec := &txExecution{decoded: true, access: newTxAccess()}
+	exec.ms = ms.CacheMultiStoreWithWrapper(exec.access.track)
+
+	ctx := app.getContextForTx(execModeFinalize, txBytes).WithMultiStore(exec.ms)
+	exec.gInfo, exec.result, exec.anteEvents, exec.err = app.runTxWithContext(ctx, execModeFinalize, txBytes)
+
+	return exec
+}
+
+// speculativeGasMeter is a gas meter standing for a gas meter of the
+// FinalizeBlock context in a speculative execution, recording whether the
+// transaction observed the gas it consumed.
+type speculativeGasMeter struct {
+	storetypes.GasMeter
+	// ignoreOutOfGas is true if IsOutOfGas isn't recorded as an observation,
+	// because it is validated when committing the transaction.
+	ignoreOutOfGas bool
+	observed       bool
+}
+
+func (m *speculativeGasMeter) GasConsumed() storetypes.Gas {
+	m.observed = true
+	return m.GasMeter.GasConsumed()
+}
+
+func (m *speculativeGasMeter) GasConsumedToLimit() storetypes.Gas {
+	m.observed = true
+	return m.GasMeter.GasConsumedToLimit()
+}
+
+func (m *speculativeGasMeter) GasRemaining() storetypes.Gas {
+	m.observed = true
+	return m.GasMeter.GasRemaining()
+}
+
+func (m *speculativeGasMeter) IsPastLimit() bool {
+	m.observed = true
+	return m.GasMeter.IsPastLimit()
+}
+
+func (m *speculativeGasMeter) IsOutOfGas() bool {
+	m.observed = m.observed || !m.ignoreOutOfGas
+	return m.GasMeter.IsOutOfGas()
+}
+
+func (m *speculativeGasMeter) String() string {
+	m.observed = true
+	return m.GasMeter.String()
+}
+
+// txAccess records the keys read and written by a transaction through its
+// tracked branch of the FinalizeBlock state.
+type txAccess struct {
+	reads  map[storetypes.StoreKey]*readSet
+	writes map[storetypes.StoreKey][]string
+}
+
+// readSet is the set of keys and of key ranges read from a store.
+type readSet struct {
+	keys   map[string]struct{}
+	ranges []keyRange
+}
+
+// keyRange is the range of keys of an iterator, nil bounds being unbounded.
+type keyRange struct {
+	start, end []byte
+}
+
+func newTxAccess() *txAccess {
+	return &txAccess{
+		reads:  make(map[storetypes.StoreKey]*readSet),
+		writes: make(map[storetypes.StoreKey][]string),
+	}
+}
+
+// track is the wrapper of the tracked branch of the FinalizeBlock state.
+func (a *txAccess) track(key storetypes.StoreKey, store storetypes.KVStore) storetypes.KVStore {
+	return trackedStore{KVStore: store, key: key, access: a}
+}
+
+func (a *txAccess) readSet(key storetypes.StoreKey) *readSet {
+	reads, ok := a.reads[key]
+	if !ok {
+		reads = &readSet{keys: make(map[string]struct{})}
+		a.reads[key] = reads
+	}
+	return reads
+}
+
+func (a *txAccess) read(key storetypes.StoreKey, k []byte) {
+	a.readSet(key).keys[string(k)] = struct{}{}
+}
+
+func (a *txAccess) readRange(key storetypes.StoreKey, start, end []byte) {
+	reads := a.readSet(key)
+	reads.ranges = append(reads.ranges, keyRange{start: copyKey(start), end: copyKey(end)})
+}
+
+func (a *txAccess) write(key storetypes.StoreKey, k []byte) {
+	a.writes[key] = append(a.writes[key], string(k))
+}
+
+// conflicts returns true if the transaction read a key which is written.
+func (a *txAccess) conflicts(written map[storetypes.StoreKey]*keySet) bool {
+	for key, reads := range a.reads {
+		keys, ok := written[key]
+		if !ok {
+			continue
+		}
+		for k := range reads.keys {
+			if keys.has(k) {
+				return true
+			}
+		}
+		for _, r := range reads.ranges {
+			if keys.hasInRange(r.start, r.end) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// addWrites adds the keys written by the transaction to the written keys.
+func (a *txAccess) addWrites(written map[storetypes.StoreKey]*keySet) {
+	for key, writes := range a.writes {
+		keys, ok := written[key]
+		if !ok {
+			keys = &keySet{}
+			written[key] = keys
+		}
+		for _, k := range writes {
+			keys.add(k)
+		}
+	}
+}
+
+func copyKey(key []byte) []byte {
+	if key == nil {
+		return nil
+	}
+	return append([]byte{}, key...)
+}
+
+// trackedStore is a KVStore recording the keys read from and written to the
+// underlying store in a txAccess.
+type trackedStore struct {
+	storetypes.KVStore
+	key    storetypes.StoreKey
+	access *txAccess
+}
+
+var _ storetypes.KVStore = trackedStore{}
+
+func (s trackedStore) Get(key []byte) []byte {
+	s.access.read(s.key, key)
+	return s.KVStore.Get(key)
+}
+
+func (s trackedStore) Has(key []byte) bool {
+	s.access.read(s.key, key)
+	return s.KVStore.Has(key)
+}
+
+func (s trackedStore) Set(key, value []byte) {
+	s.access.write(s.key, key)
+	s.KVStore.Set(key, value)
+}
+
+func (s trackedStore) Delete(key []byte) {
+	s.access.write(s.key, key)
+	s.KVStore.Delete(key)
+}
+
+func (s trackedStore) Iterator(start, end []byte) storetypes.Iterator {
+	s.access.readRange(s.key, start, end)
+	return s.KVStore.Iterator(start, end)
+}
+
+func (s trackedStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	s.access.readRange(s.key, start, end)
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+func (s trackedStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+func (s trackedStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// keySet is a sorted set of keys.
+type keySet []string
+
+func (ks keySet) has(key string) bool {
+	i := sort.SearchStrings(ks, key)
+	return i < len(ks) && ks[i] == key
+}
+
+// hasInRange returns true if the set has a key in [start, end), nil bounds
+// being unbounded.
+func (ks keySet) hasInRange(start, end []byte) bool {
+	i := sort.SearchStrings(ks, string(start))
+	return i < len(ks) && (end == nil || ks[i] < string(end))
+}
+
+func (ks *keySet) add(key string) {
+	i := sort.SearchStrings(*ks, key)
+	if i < len(*ks) && (*ks)[i] == key {
+		return
+	}
+	*ks = append(*ks, "")
+	copy((*ks)[i+1:], (*ks)[i:])
+	(*ks)[i] = key
+}
@@ -0,0 +1,138 @@
+package baseapp_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// parallelKeyValueImpl appends the value of a message to the value of its key,
+// after the number of keys of the store if the key starts with "count", and
+// fails if the value is "fail".
+type parallelKeyValueImpl struct{}
+
+func (m parallelKeyValueImpl) Set(ctx context.Context, msg *baseapptestutil.MsgKeyValue) (*baseapptestutil.MsgCreateKeyValueResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := sdkCtx.KVStore(capKey2)
+
+	value := append([]byte{}, store.Get(msg.Key)...)
+	if len(msg.Key) >= 5 && string(msg.Key[:5]) == "count" {
+		it := store.Iterator(nil, nil)
+		count := 0
+		for ; it.Valid(); it.Next() {
+			count++
+		}
+		it.Close()
+		value = append(value, fmt.Sprintf("%d:", count)...)
+	}
+	value = append(value, msg.Value...)
+	store.Set(msg.Key, value)
+
+	sdkCtx.GasMeter().ConsumeGas(uint64(len(value)), "parallel")
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent("set", sdk.NewAttribute("value", string(value))))
+
+	if string(msg.Value) == "fail" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message handler failure")
+	}
+
+	return &baseapptestutil.MsgCreateKeyValueResponse{}, nil
+}
+
+func newParallelBaseAppSuite(t *testing.T, opts ...func(*baseapp.BaseApp)) *BaseAppSuite {
+	t.Helper()
+
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			ctx = ctx.WithGasMeter(storetypes.NewGasMeter(tx.(sdk.FeeTx).GetGas()))
+
+			// every transaction reads and writes the counter of its signer
+			store := ctx.KVStore(capKey1)
+			signer := []byte(tx.(sdk.FeeTx).FeePayer())
+			store.Set(signer, append(store.Get(signer), 'x'))
+
+			return ctx, nil
+		})
+	}
+	suite := NewBaseAppSuite(t, append(opts, anteOpt)...)
+	baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), parallelKeyValueImpl{})
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxGas: 150000},
+		},
+	})
+	require.NoError(t, err)
+
+	return suite
+}
+
+func TestBaseApp_ParallelTxExecution(t *testing.T) {
+	sequential := newParallelBaseAppSuite(t)
+	parallel := newParallelBaseAppSuite(t, baseapp.SetParallelTxWorkers(4))
+
+	signers := make([]string, 10)
+	for i := range signers {
+		_, _, addr := testdata.KeyTestPubAddr()
+		signers[i] = addr.String()
+	}
+
+	r := rand.New(rand.NewSource(12))
+	for height := int64(1); height <= 20; height++ {
+		txs := make([][]byte, 0, 30)
+		for i := 0; i < 30; i++ {
+			if r.Intn(20) == 0 {
+				txs = append(txs, []byte("undecodable"))
+				continue
+			}
+
+			key := fmt.Sprintf("key%d", r.Intn(40))
+			if r.Intn(10) == 0 {
+				key = fmt.Sprintf("count%d", r.Intn(3))
+			}
+			value := fmt.Sprintf("%d.%d", height, i)
+			if r.Intn(10) == 0 {
+				value = "fail"
+			}
+
+			builder := sequential.txConfig.NewTxBuilder()
+			require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgKeyValue{
+				Key:    []byte(key),
+				Value:  []byte(value),
+				Signer: signers[r.Intn(len(signers))],
+			}))
+			builder.SetGasLimit(uint64(7000 + r.Intn(3000)))
+			setTxSignature(t, builder, uint64(i))
+
+			txBytes, err := sequential.txConfig.TxEncoder()(builder.GetTx())
+			require.NoError(t, err)
+			txs = append(txs, txBytes)
+		}
+
+		req := &abci.RequestFinalizeBlock{Height: height, Txs: txs}
+		expected, err := sequential.baseApp.FinalizeBlock(req)
+		require.NoError(t, err)
+		res, err := parallel.baseApp.FinalizeBlock(req)
+		require.NoError(t, err)
+		require.Equal(t, expected, res)
+
+		_, err = sequential.baseApp.Commit()
+		require.NoError(t, err)
+		_, err = parallel.baseApp.Commit()
+		require.NoError(t, err)
+		require.Equal(t, sequential.baseApp.LastCommitID(), parallel.baseApp.LastCommitID())
+	}
+}
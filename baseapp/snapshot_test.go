@@ -39,6 +39,28 @@ func TestABCI_ListSnapshots(t *testing.T) {
 	}}, resp)
 }
 
+func TestABCI_ListSnapshots_Deltas(t *testing.T) {
+	ssCfg := SnapshotsConfig{
+		blocks:             6,
+		blockTxs:           4,
+		snapshotInterval:   2,
+		snapshotKeepRecent: 0,
+		snapshotMaxDeltas:  1,
+		pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+	}
+
+	suite := NewBaseAppSuiteWithSnapshots(t, ssCfg)
+
+	// the delta snapshot at height 4 is not served through state sync
+	resp, err := suite.baseApp.ListSnapshots(&abci.RequestListSnapshots{})
+	require.NoError(t, err)
+	heights := []uint64{}
+	for _, s := range resp.Snapshots {
+		heights = append(heights, s.Height)
+	}
+	require.Equal(t, []uint64{6, 2}, heights)
+}
+
 func TestABCI_SnapshotWithPruning(t *testing.T) {
 	testCases := map[string]struct {
 		ssCfg             SnapshotsConfig
@@ -1,10 +1,13 @@
 package baseapp
 
 import (
+	"errors"
 	"fmt"
+	"path/filepath"
 	"sort"
 	"strings"
 
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/spf13/cast"
 
 	"cosmossdk.io/store/streaming"
@@ -20,10 +23,33 @@ const (
 	StreamingABCIPluginTomlKey        = "plugin"
 	StreamingABCIKeysTomlKey          = "keys"
 	StreamingABCIStopNodeOnErrTomlKey = "stop-node-on-err"
+	StreamingABCISinkTomlKey          = "sink"
+	StreamingABCIBufferSizeTomlKey    = "buffer-size"
+	StreamingABCIFileDirTomlKey       = "file-dir"
+	StreamingABCIFileMaxBytesTomlKey  = "file-max-bytes"
+	StreamingABCIBrokerTopicTomlKey   = "broker-topic"
+
+	StreamingIndexTomlKey              = "index"
+	StreamingIndexEnableTomlKey        = "enable"
+	StreamingIndexRetainHeightsTomlKey = "retain-heights"
+)
+
+// Built-in sinks of the in-process ABCI listener, selected by the
+// streaming.abci.sink option.
+const (
+	StreamingSinkFile   = "file"
+	StreamingSinkBroker = "broker"
 )
 
 // RegisterStreamingServices registers streaming services with the BaseApp.
+// A streaming sink is used instead of a streaming plugin, both cannot be enabled,
+// while the state change index can be enabled along with either.
 func (app *BaseApp) RegisterStreamingServices(appOpts servertypes.AppOptions, keys map[string]*storetypes.KVStoreKey) error {
+	sinkName := strings.TrimSpace(cast.ToString(appOpts.Get(streamingABCIKey(StreamingABCISinkTomlKey))))
+	if len(sinkName) > 0 && len(strings.TrimSpace(cast.ToString(appOpts.Get(streamingABCIKey(StreamingABCIPluginTomlKey))))) > 0 {
+		return errors.New("cannot enable both a streaming plugin and a streaming sink")
+	}
+
 	// register streaming services
 	streamingCfg := cast.ToStringMap(appOpts.Get(StreamingTomlKey))
 	for service := range streamingCfg {
@@ -41,9 +67,103 @@ func (app *BaseApp) RegisterStreamingServices(appOpts servertypes.AppOptions, ke
 		}
 	}
 
+	// register the in-process ABCI listener
+	if len(sinkName) > 0 {
+		abciListener, err := app.newStreamingSinkListener(appOpts, sinkName)
+		if err != nil {
+			return fmt.Errorf("failed to create streaming sink: %w", err)
+		}
+		app.registerABCIListenerPlugin(appOpts, keys, abciListener)
+	}
+
+	// register the state change index
+	enableIndexKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingIndexTomlKey, StreamingIndexEnableTomlKey)
+	if cast.ToBool(appOpts.Get(enableIndexKey)) {
+		changeIndex, err := newChangeIndex(appOpts)
+		if err != nil {
+			return fmt.Errorf("failed to open state change index: %w", err)
+		}
+		app.changeIndex = changeIndex
+		app.registerABCIListenerPlugin(appOpts, keys, changeIndex)
+	}
+
 	return nil
 }
 
+// newChangeIndex opens the state change index in the data directory of the node,
+// with the database backend of the application.
+func newChangeIndex(appOpts servertypes.AppOptions) (*streaming.ChangeIndex, error) {
+	backend := dbm.BackendType(cast.ToString(appOpts.Get("app-db-backend")))
+	if backend == "" {
+		backend = dbm.GoLevelDBBackend
+	}
+
+	dataDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data")
+	db, err := dbm.NewDB("streaming_index", backend, dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	retainHeightsKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingIndexTomlKey, StreamingIndexRetainHeightsTomlKey)
+	changeIndex, err := streaming.NewChangeIndex(db, cast.ToInt64(appOpts.Get(retainHeightsKey)))
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return changeIndex, nil
+}
+
+// newStreamingSinkListener returns an in-process ABCI listener writing to the
+// given built-in sink. If the node stops on streaming errors, the commit of a
+// block waits for its records to be written and the listener stops writing
+// after an error of the sink, else the failing records are dropped.
+func (app *BaseApp) newStreamingSinkListener(appOpts servertypes.AppOptions, sinkName string) (*streaming.SinkListener, error) {
+	var (
+		sink streaming.Sink
+		err  error
+	)
+	switch sinkName {
+	case StreamingSinkFile:
+		dir := cast.ToString(appOpts.Get(streamingABCIKey(StreamingABCIFileDirTomlKey)))
+		if dir == "" {
+			dir = filepath.Join("data", "streaming")
+		}
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), dir)
+		}
+		maxBytes := cast.ToInt64(appOpts.Get(streamingABCIKey(StreamingABCIFileMaxBytesTomlKey)))
+		sink, err = streaming.NewFileSink(dir, maxBytes)
+		if err != nil {
+			return nil, err
+		}
+
+	case StreamingSinkBroker:
+		if app.streamingProducer == nil {
+			return nil, errors.New("no message broker producer set, see BaseApp.SetStreamingProducer")
+		}
+		topic := cast.ToString(appOpts.Get(streamingABCIKey(StreamingABCIBrokerTopicTomlKey)))
+		sink = streaming.NewBrokerSink(app.streamingProducer, topic)
+
+	default:
+		return nil, fmt.Errorf("unknown streaming sink %q, expected %q or %q", sinkName, StreamingSinkFile, StreamingSinkBroker)
+	}
+
+	opts := streaming.SinkListenerOptions{
+		BufferSize: cast.ToInt(appOpts.Get(streamingABCIKey(StreamingABCIBufferSizeTomlKey))),
+		ErrorMode:  streaming.ErrorModeDrop,
+	}
+	if cast.ToBool(appOpts.Get(streamingABCIKey(StreamingABCIStopNodeOnErrTomlKey))) {
+		opts.ErrorMode = streaming.ErrorModeHalt
+	}
+
+	return streaming.NewSinkListener(sink, opts, app.logger.With("module", "streaming")), nil
+}
+
+func streamingABCIKey(key string) string {
+	return fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingABCITomlKey, key)
+}
+
 // registerStreamingPlugin registers streaming plugins with the BaseApp.
 func (app *BaseApp) registerStreamingPlugin(
 	appOpts servertypes.AppOptions,
@@ -59,7 +179,8 @@ func (app *BaseApp) registerStreamingPlugin(
 	return nil
 }
 
-// registerABCIListenerPlugin registers plugins that implement the ABCIListener interface.
+// registerABCIListenerPlugin registers plugins that implement the ABCIListener interface,
+// in addition to the ABCI listeners already registered.
 func (app *BaseApp) registerABCIListenerPlugin(
 	appOpts servertypes.AppOptions,
 	keys map[string]*storetypes.KVStoreKey,
@@ -73,7 +194,7 @@ func (app *BaseApp) registerABCIListenerPlugin(
 	app.cms.AddListeners(exposedKeys)
 	app.SetStreamingManager(
 		storetypes.StreamingManager{
-			ABCIListeners: []storetypes.ABCIListener{abciListener},
+			ABCIListeners: append(app.streamingManager.ABCIListeners, abciListener),
 			StopNodeOnErr: stopNodeOnErr,
 		},
 	)
@@ -2,17 +2,27 @@ package baseapp_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/streaming"
+	streamingabci "cosmossdk.io/store/streaming/abci"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 )
 
 var _ storetypes.ABCIListener = (*MockABCIListener)(nil)
@@ -146,3 +156,187 @@ func Test_Ctx_with_StreamingManager(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+var _ streaming.Producer = (*mockProducer)(nil)
+
+// mockProducer is a message broker producer keeping the messages in memory.
+type mockProducer struct {
+	mtx      sync.Mutex
+	messages [][]byte
+	err      error
+}
+
+func (p *mockProducer) Produce(_ string, _, value []byte) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if p.err != nil {
+		return p.err
+	}
+	p.messages = append(p.messages, value)
+	return nil
+}
+
+func (p *mockProducer) Close() error { return nil }
+
+func (p *mockProducer) setErr(err error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.err = err
+}
+
+func TestABCI_StreamingSink(t *testing.T) {
+	producer := &mockProducer{}
+	appOpts := simtestutil.AppOptionsMap{
+		"streaming.abci.sink":             baseapp.StreamingSinkBroker,
+		"streaming.abci.buffer-size":      1,
+		"streaming.abci.stop-node-on-err": true,
+	}
+	streamingOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetStreamingProducer(producer)
+		require.NoError(t, bapp.RegisterStreamingServices(appOpts, nil))
+	}
+	suite := NewBaseAppSuite(t, streamingOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{ConsensusParams: &tmproto.ConsensusParams{}})
+	require.NoError(t, err)
+
+	nBlocks := 3
+	for height := int64(1); height <= int64(nBlocks); height++ {
+		_, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+	require.NoError(t, suite.baseApp.Close())
+
+	require.Len(t, producer.messages, 2*nBlocks)
+	for i, message := range producer.messages {
+		var record streamingabci.StreamRecord
+		require.NoError(t, record.Unmarshal(message))
+		height := int64(i/2 + 1)
+		if i%2 == 0 {
+			require.Equal(t, height, record.GetFinalizeBlock().Req.Height)
+			continue
+		}
+		require.Equal(t, height, record.GetCommit().BlockHeight)
+	}
+}
+
+func TestABCI_StreamingSink_StopNodeOnErr(t *testing.T) {
+	producer := &mockProducer{err: errors.New("broker unavailable")}
+	appOpts := simtestutil.AppOptionsMap{
+		"streaming.abci.sink":             baseapp.StreamingSinkBroker,
+		"streaming.abci.buffer-size":      1,
+		"streaming.abci.stop-node-on-err": true,
+	}
+	streamingOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetStreamingProducer(producer)
+		require.NoError(t, bapp.RegisterStreamingServices(appOpts, nil))
+	}
+	suite := NewBaseAppSuite(t, streamingOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{ConsensusParams: &tmproto.ConsensusParams{}})
+	require.NoError(t, err)
+
+	// the error of the sink is eventually returned to stop the node
+	require.Eventually(t, func() bool {
+		height := suite.baseApp.LastBlockHeight() + 1
+		if _, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height}); err != nil {
+			return errors.Is(err, producer.err)
+		}
+		_, err := suite.baseApp.Commit()
+		return errors.Is(err, producer.err)
+	}, time.Second, time.Millisecond)
+	require.NoError(t, suite.baseApp.Close())
+}
+
+func TestRegisterStreamingServices_Sink(t *testing.T) {
+	testCases := map[string]struct {
+		appOpts     simtestutil.AppOptionsMap
+		producer    streaming.Producer
+		expectedErr string
+	}{
+		"unknown sink": {
+			appOpts:     simtestutil.AppOptionsMap{"streaming.abci.sink": "kafka"},
+			expectedErr: `unknown streaming sink "kafka"`,
+		},
+		"broker sink without producer": {
+			appOpts:     simtestutil.AppOptionsMap{"streaming.abci.sink": baseapp.StreamingSinkBroker},
+			expectedErr: "no message broker producer set",
+		},
+		"file sink": {
+			appOpts: simtestutil.AppOptionsMap{"streaming.abci.sink": baseapp.StreamingSinkFile, "streaming.abci.file-dir": t.TempDir()},
+		},
+		"plugin and sink": {
+			appOpts: simtestutil.AppOptionsMap{
+				"streaming":               map[string]any{"abci": map[string]any{}},
+				"streaming.abci.plugin":   "abci_v1",
+				"streaming.abci.sink":     baseapp.StreamingSinkFile,
+				"streaming.abci.file-dir": t.TempDir(),
+			},
+			expectedErr: "cannot enable both a streaming plugin and a streaming sink",
+		},
+		"file sink and index": {
+			appOpts: simtestutil.AppOptionsMap{
+				"streaming.abci.sink":     baseapp.StreamingSinkFile,
+				"streaming.abci.file-dir": t.TempDir(),
+				"streaming.index.enable":  true,
+				flags.FlagHome:            t.TempDir(),
+				"app-db-backend":          string(dbm.GoLevelDBBackend),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			app := baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), nil)
+			if tc.producer != nil {
+				app.SetStreamingProducer(tc.producer)
+			}
+
+			err := app.RegisterStreamingServices(tc.appOpts, nil)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, app.Close())
+		})
+	}
+}
+
+func TestABCI_StreamingIndex(t *testing.T) {
+	home := t.TempDir()
+	appOpts := simtestutil.AppOptionsMap{
+		flags.FlagHome:                    home,
+		"app-db-backend":                  string(dbm.GoLevelDBBackend),
+		"streaming.index.enable":          true,
+		"streaming.index.retain-heights":  2,
+		"streaming.abci.stop-node-on-err": true,
+	}
+	streamingOpt := func(bapp *baseapp.BaseApp) {
+		require.NoError(t, bapp.RegisterStreamingServices(appOpts, nil))
+	}
+	suite := NewBaseAppSuite(t, streamingOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{ConsensusParams: &tmproto.ConsensusParams{}})
+	require.NoError(t, err)
+
+	for height := int64(1); height <= 3; height++ {
+		_, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+	require.NoError(t, suite.baseApp.Close())
+
+	db, err := dbm.NewDB("streaming_index", dbm.GoLevelDBBackend, filepath.Join(home, "data"))
+	require.NoError(t, err)
+	changeIndex, err := streaming.NewChangeIndex(db, 2)
+	require.NoError(t, err)
+	defer changeIndex.Close()
+
+	earliest, latest := changeIndex.Heights()
+	require.Equal(t, int64(2), earliest)
+	require.Equal(t, int64(3), latest)
+}
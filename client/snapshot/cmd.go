@@ -19,6 +19,7 @@ func Cmd(appCreator servertypes.AppCreator) *cobra.Command {
 		DumpArchiveCmd(),
 		LoadArchiveCmd(),
 		DeleteSnapshotCmd(),
+		VerifySnapshotCmd(),
 	)
 	return cmd
 }
@@ -0,0 +1,215 @@
+package snapshot
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const flagAppHash = "app-hash"
+
+// VerifySnapshotCmd returns a command to verify a local snapshot against a trusted app hash
+func VerifySnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify <height> <format>",
+		Short: "Verify a local snapshot against a trusted app hash without restoring it",
+		Long: `Verify a local snapshot against a trusted app hash without restoring it.
+
+The chunks of the snapshot are checked against the chunk hashes of its metadata, then the
+IAVL tree of each store of the snapshot is rebuilt in memory to recompute the app hash of
+the snapshot height, which is compared to the given trusted app hash, e.g. the app hash of
+the block header at the next height.
+
+If the app hashes don't match, the stores whose hash differs are only reported when the
+local application database has the commit info of the snapshot height, i.e. when the node
+committed that height and didn't prune it. Otherwise, only the app hashes are compared.`,
+		Example: fmt.Sprintf("$ %s snapshots verify 1000 3 --%s 5E4F...", version.AppName, flagAppHash),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := server.GetServerContextFromCmd(cmd)
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			format, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			appHashStr, err := cmd.Flags().GetString(flagAppHash)
+			if err != nil {
+				return err
+			}
+			appHash, err := hex.DecodeString(appHashStr)
+			if err != nil || len(appHash) == 0 {
+				return fmt.Errorf("invalid app hash %q", appHashStr)
+			}
+
+			snapshotStore, err := server.GetSnapshotStore(ctx.Viper)
+			if err != nil {
+				return err
+			}
+
+			snapshot, chunks, err := snapshotStore.Load(height, uint32(format))
+			if err != nil {
+				return err
+			}
+			if snapshot == nil {
+				return errors.New("snapshot doesn't exist")
+			}
+			defer snapshots.DrainChunks(chunks)
+			if snapshot.IsDelta() {
+				return fmt.Errorf("snapshot at height %d is a delta snapshot of the snapshot at height %d and cannot be verified on its own",
+					snapshot.Height, snapshot.Metadata.ParentHeight)
+			}
+			if uint32(len(snapshot.Metadata.ChunkHashes)) != snapshot.Chunks {
+				return fmt.Errorf("snapshot has %d chunk hashes, but %d chunks", len(snapshot.Metadata.ChunkHashes), snapshot.Chunks)
+			}
+
+			verifiedChunks := verifyChunks(snapshot, chunks)
+			defer snapshots.DrainChunks(verifiedChunks)
+
+			streamReader, err := snapshots.NewStreamReader(verifiedChunks)
+			if err != nil {
+				return err
+			}
+			defer streamReader.Close()
+
+			commitInfo, _, err := rootmulti.SnapshotCommitInfo(height, uint32(format), streamReader)
+			if err != nil {
+				return fmt.Errorf("failed to rebuild the snapshot stores: %w", err)
+			}
+
+			for _, storeInfo := range commitInfo.StoreInfos {
+				cmd.Println("store:", storeInfo.Name, "hash:", fmt.Sprintf("%X", storeInfo.CommitId.Hash))
+			}
+
+			snapshotAppHash := commitInfo.Hash()
+			if bytes.Equal(snapshotAppHash, appHash) {
+				cmd.Println("app hash:", fmt.Sprintf("%X", snapshotAppHash), "matches the trusted app hash")
+				return nil
+			}
+
+			localCommitInfo, err := loadLocalCommitInfo(ctx.Config.RootDir, server.GetAppDBBackend(ctx.Viper), int64(height))
+			if err != nil {
+				cmd.PrintErrln("cannot compare the store hashes, the local application database has no commit info of the snapshot height:", err)
+			} else {
+				for _, mismatch := range storeMismatches(localCommitInfo, commitInfo) {
+					cmd.Println("mismatch:", mismatch)
+				}
+			}
+
+			return fmt.Errorf("snapshot app hash %X doesn't match the trusted app hash %X", snapshotAppHash, appHash)
+		},
+	}
+
+	cmd.Flags().String(flagAppHash, "", "Trusted app hash of the snapshot height, hex encoded")
+	_ = cmd.MarkFlagRequired(flagAppHash)
+
+	return cmd
+}
+
+// verifyChunks returns the chunks of the snapshot once they are checked against
+// the chunk hashes of its metadata, reading one chunk at a time. The chunk which
+// fails to be read or checked returns the error, and no chunk follows it.
+func verifyChunks(snapshot *snapshottypes.Snapshot, chunks <-chan io.ReadCloser) <-chan io.ReadCloser {
+	verified := make(chan io.ReadCloser)
+	go func() {
+		defer close(verified)
+
+		var index int
+		for chunk := range chunks {
+			body, err := io.ReadAll(chunk)
+			_ = chunk.Close()
+			if err == nil {
+				switch {
+				case index >= len(snapshot.Metadata.ChunkHashes):
+					err = fmt.Errorf("unexpected chunk %d", index)
+				case !bytes.Equal(snapshot.Metadata.ChunkHashes[index], hashChunk(body)):
+					err = fmt.Errorf("chunk %d hash mismatch, expected %X", index, snapshot.Metadata.ChunkHashes[index])
+				}
+			}
+			if err != nil {
+				pr, pw := io.Pipe()
+				_ = pw.CloseWithError(err)
+				verified <- pr
+				snapshots.DrainChunks(chunks)
+				return
+			}
+
+			verified <- io.NopCloser(bytes.NewReader(body))
+			index++
+		}
+	}()
+
+	return verified
+}
+
+func hashChunk(body []byte) []byte {
+	hash := sha256.Sum256(body)
+	return hash[:]
+}
+
+// loadLocalCommitInfo loads the commit info of a height from the local application database,
+// without creating the database if it doesn't exist.
+func loadLocalCommitInfo(rootDir string, backendType dbm.BackendType, height int64) (*storetypes.CommitInfo, error) {
+	if _, err := os.Stat(filepath.Join(rootDir, "data", "application.db")); err != nil {
+		return nil, err
+	}
+
+	db, err := openDB(rootDir, backendType)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	return rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics()).GetCommitInfo(height)
+}
+
+// storeMismatches returns a description of each store whose hash differs between the expected
+// and the actual commit info, or which is missing from one of them.
+func storeMismatches(expected, actual *storetypes.CommitInfo) []string {
+	actualHashes := make(map[string][]byte, len(actual.StoreInfos))
+	for _, storeInfo := range actual.StoreInfos {
+		actualHashes[storeInfo.Name] = storeInfo.CommitId.Hash
+	}
+
+	var mismatches []string
+	for _, storeInfo := range expected.StoreInfos {
+		hash, ok := actualHashes[storeInfo.Name]
+		delete(actualHashes, storeInfo.Name)
+		switch {
+		case !ok:
+			mismatches = append(mismatches, fmt.Sprintf("store %s is missing from the snapshot", storeInfo.Name))
+		case !bytes.Equal(hash, storeInfo.CommitId.Hash):
+			mismatches = append(mismatches, fmt.Sprintf("store %s has hash %X, expected %X", storeInfo.Name, hash, storeInfo.CommitId.Hash))
+		}
+	}
+	for _, storeInfo := range actual.StoreInfos {
+		if _, ok := actualHashes[storeInfo.Name]; ok {
+			mismatches = append(mismatches, fmt.Sprintf("store %s is not in the local commit info", storeInfo.Name))
+		}
+	}
+
+	return mismatches
+}
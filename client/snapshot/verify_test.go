@@ -0,0 +1,126 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+func TestStoreMismatches(t *testing.T) {
+	storeInfo := func(name, hash string) storetypes.StoreInfo {
+		return storetypes.StoreInfo{Name: name, CommitId: storetypes.CommitID{Hash: []byte(hash)}}
+	}
+	expected := &storetypes.CommitInfo{StoreInfos: []storetypes.StoreInfo{
+		storeInfo("acc", "1"), storeInfo("bank", "2"), storeInfo("gov", "3"),
+	}}
+
+	require.Empty(t, storeMismatches(expected, expected))
+
+	actual := &storetypes.CommitInfo{StoreInfos: []storetypes.StoreInfo{
+		storeInfo("acc", "1"), storeInfo("bank", "4"), storeInfo("mint", "5"),
+	}}
+	require.Equal(t, []string{
+		"store bank has hash 34, expected 32",
+		"store gov is missing from the snapshot",
+		"store mint is not in the local commit info",
+	}, storeMismatches(expected, actual))
+}
+
+// createSnapshot commits a height in the application database of the home
+// directory, and takes a snapshot of it. It returns the app hash of the height.
+func createSnapshot(t *testing.T, home string) []byte {
+	t.Helper()
+
+	db, err := openDB(home, dbm.GoLevelDBBackend)
+	require.NoError(t, err)
+	ms := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	key := storetypes.NewKVStoreKey("store")
+	ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	for i := byte(0); i < 100; i++ {
+		ms.GetCommitKVStore(key).Set([]byte{i}, bytes.Repeat([]byte{i}, 100))
+	}
+	appHash := ms.Commit().Hash
+
+	snapshotDir := filepath.Join(home, "data", "snapshots")
+	snapshotDB, err := dbm.NewDB("metadata", dbm.GoLevelDBBackend, snapshotDir)
+	require.NoError(t, err)
+	snapshotStore, err := snapshots.NewStore(snapshotDB, snapshotDir)
+	require.NoError(t, err)
+	manager := snapshots.NewManager(snapshotStore, snapshottypes.NewSnapshotOptions(0, 0), ms, nil, log.NewNopLogger())
+	_, err = manager.Create(1)
+	require.NoError(t, err)
+
+	require.NoError(t, snapshotDB.Close())
+	require.NoError(t, db.Close())
+	return appHash
+}
+
+func runVerifySnapshotCmd(t *testing.T, home string, appHash []byte) (string, error) {
+	t.Helper()
+
+	serverCtx := server.NewDefaultContext()
+	serverCtx.Config.RootDir = home
+	serverCtx.Viper.Set(flags.FlagHome, home)
+	ctx := context.WithValue(context.Background(), server.ServerContextKey, serverCtx)
+
+	var out bytes.Buffer
+	cmd := VerifySnapshotCmd()
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"1", fmt.Sprint(snapshottypes.CurrentFormat), fmt.Sprintf("--%s=%X", flagAppHash, appHash)})
+	err := cmd.ExecuteContext(ctx)
+	return out.String(), err
+}
+
+func TestVerifySnapshotCmd(t *testing.T) {
+	// the snapshot store isn't closed by the command, each run uses its own home
+	home := t.TempDir()
+	appHash := createSnapshot(t, home)
+	out, err := runVerifySnapshotCmd(t, home, appHash)
+	require.NoError(t, err)
+	require.Contains(t, out, fmt.Sprintf("app hash: %X matches the trusted app hash", appHash))
+
+	// the local commit info matches the snapshot, no store is reported
+	home = t.TempDir()
+	createSnapshot(t, home)
+	out, err = runVerifySnapshotCmd(t, home, []byte("other"))
+	require.ErrorContains(t, err, "doesn't match the trusted app hash")
+	require.NotContains(t, out, "mismatch:")
+	require.NotContains(t, out, "cannot compare the store hashes")
+
+	// without the local commit info, only the app hashes are compared
+	home = t.TempDir()
+	createSnapshot(t, home)
+	require.NoError(t, os.RemoveAll(filepath.Join(home, "data", "application.db")))
+	out, err = runVerifySnapshotCmd(t, home, []byte("other"))
+	require.ErrorContains(t, err, "doesn't match the trusted app hash")
+	require.Contains(t, out, "cannot compare the store hashes")
+
+	// the chunks are checked against the snapshot metadata
+	home = t.TempDir()
+	appHash = createSnapshot(t, home)
+	chunkPath := filepath.Join(home, "data", "snapshots", "1", fmt.Sprint(snapshottypes.CurrentFormat), "0")
+	chunk, err := os.ReadFile(chunkPath)
+	require.NoError(t, err)
+	chunk[len(chunk)/2] ^= 0xff
+	require.NoError(t, os.WriteFile(chunkPath, chunk, 0o600))
+	_, err = runVerifySnapshotCmd(t, home, appHash)
+	require.ErrorContains(t, err, "chunk 0 hash mismatch")
+}
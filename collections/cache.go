@@ -0,0 +1,156 @@
+package collections
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+)
+
+// DefaultCacheMaxEntries is the default maximum number of values kept in
+// memory by a CachedMap.
+const DefaultCacheMaxEntries = 1024
+
+type cacheOptions struct {
+	maxEntries int
+}
+
+// WithCacheMaxEntries is an option that can be passed to NewCachedMap to set
+// the maximum number of values kept in memory.
+func WithCacheMaxEntries(maxEntries int) func(*cacheOptions) {
+	return func(o *cacheOptions) {
+		o.maxEntries = maxEntries
+	}
+}
+
+// cachedValue is a decoded value along with the bytes it was decoded from.
+type cachedValue[V any] struct {
+	raw   []byte
+	value V
+}
+
+// valueCache keeps decoded values by raw key. It is safe for concurrent use,
+// as the transactions of a block may be executed concurrently.
+type valueCache[V any] struct {
+	mtx        sync.Mutex
+	entries    map[string]cachedValue[V]
+	maxEntries int
+}
+
+func newValueCache[V any](maxEntries int) *valueCache[V] {
+	return &valueCache[V]{entries: make(map[string]cachedValue[V]), maxEntries: maxEntries}
+}
+
+// get returns the cached value of key if it was decoded from raw.
+func (c *valueCache[V]) get(key, raw []byte) (v V, ok bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	entry, ok := c.entries[string(key)]
+	if !ok || !bytes.Equal(entry.raw, raw) {
+		return v, false
+	}
+	return entry.value, true
+}
+
+func (c *valueCache[V]) set(key, raw []byte, value V) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if _, ok := c.entries[string(key)]; !ok && len(c.entries) >= c.maxEntries {
+		// evict any entry, the values of a block are expected to fit
+		for k := range c.entries {
+			delete(c.entries, k)
+			break
+		}
+	}
+	c.entries[string(key)] = cachedValue[V]{raw: bytes.Clone(raw), value: value}
+}
+
+func (c *valueCache[V]) clear() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.entries = make(map[string]cachedValue[V])
+}
+
+// CachedMap is a Map which keeps the decoded values in memory, so that hot
+// values are not decoded on every read.
+//
+// The value bytes are still read from the store on every Get, which charges
+// the same gas as a Map, and the cached value is returned only if it was
+// decoded from the same bytes. So the cache can never return a stale value:
+// values written by other means, or written in a branch of the store which was
+// later discarded, are decoded again.
+//
+// The values returned by Get are shared between the callers, so they must not
+// be mutated, for example the fields of a pointer, or the elements of a slice.
+// The values passed to Set are not cached, as their callers may still mutate
+// them, and are decoded on their first Get. ClearCache can be called at the end
+// of a block to release the memory of the values of the block.
+type CachedMap[K, V any] struct {
+	Map[K, V]
+	cache *valueCache[V]
+}
+
+// NewCachedMap wraps the provided Map with a cache of its decoded values.
+func NewCachedMap[K, V any](m Map[K, V], options ...func(*cacheOptions)) CachedMap[K, V] {
+	o := cacheOptions{maxEntries: DefaultCacheMaxEntries}
+	for _, opt := range options {
+		opt(&o)
+	}
+	return CachedMap[K, V]{Map: m, cache: newValueCache[V](o.maxEntries)}
+}
+
+// Get returns the value associated with the provided key, like Map.Get, without
+// decoding it if it is cached.
+func (m CachedMap[K, V]) Get(ctx context.Context, key K) (v V, err error) {
+	bytesKey, err := EncodeKeyWithPrefix(m.prefix, m.kc, key)
+	if err != nil {
+		return v, err
+	}
+
+	kvStore := m.sa(ctx)
+	valueBytes, err := kvStore.Get(bytesKey)
+	if err != nil {
+		return v, err
+	}
+	if valueBytes == nil {
+		return v, fmt.Errorf("%w: key '%s' of type %s", ErrNotFound, m.kc.Stringify(key), m.vc.ValueType())
+	}
+
+	if v, ok := m.cache.get(bytesKey, valueBytes); ok {
+		return v, nil
+	}
+	v, err = m.vc.Decode(valueBytes)
+	if err != nil {
+		return v, fmt.Errorf("%w: value decode: %s", ErrEncoding, err)
+	}
+	m.cache.set(bytesKey, valueBytes, v)
+	return v, nil
+}
+
+// ClearCache releases the cached values.
+func (m CachedMap[K, V]) ClearCache() {
+	m.cache.clear()
+}
+
+// CachedItem is an Item which keeps its decoded value in memory, see CachedMap.
+type CachedItem[V any] struct {
+	Item[V]
+	m CachedMap[noKey, V]
+}
+
+// NewCachedItem wraps the provided Item with a cache of its decoded value.
+func NewCachedItem[V any](item Item[V]) CachedItem[V] {
+	return CachedItem[V]{Item: item, m: NewCachedMap((Map[noKey, V])(item), WithCacheMaxEntries(1))}
+}
+
+// Get gets the item, like Item.Get, without decoding it if it is cached.
+func (i CachedItem[V]) Get(ctx context.Context) (V, error) {
+	return i.m.Get(ctx, noKey{})
+}
+
+// ClearCache releases the cached value.
+func (i CachedItem[V]) ClearCache() {
+	i.m.ClearCache()
+}
@@ -0,0 +1,159 @@
+package collections
+
+import (
+	"context"
+	"testing"
+
+	db "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections/codec"
+	"cosmossdk.io/core/store"
+)
+
+// countingValueCodec counts the values decoded.
+type countingValueCodec[V any] struct {
+	codec.ValueCodec[V]
+	decoded *int
+}
+
+func (c countingValueCodec[V]) Decode(b []byte) (V, error) {
+	*c.decoded++
+	return c.ValueCodec.Decode(b)
+}
+
+// countingStore counts the reads of the store.
+type countingStore struct {
+	store.KVStore
+	reads *int
+}
+
+func (s countingStore) Get(key []byte) ([]byte, error) {
+	*s.reads++
+	return s.KVStore.Get(key)
+}
+
+type branchKey struct{}
+
+func TestCachedMap(t *testing.T) {
+	var decoded, reads int
+	parent := testStore{db.NewMemDB()}
+	branch := testStore{db.NewMemDB()}
+	// the store of a context is its branch, if any
+	schema := NewSchemaBuilderFromAccessor(func(ctx context.Context) store.KVStore {
+		if ctx.Value(branchKey{}) != nil {
+			return countingStore{branch, &reads}
+		}
+		return countingStore{parent, &reads}
+	})
+	ctx := context.Background()
+	branchCtx := context.WithValue(ctx, branchKey{}, true)
+
+	m := NewMap(schema, NewPrefix(0), "m", StringKey, countingValueCodec[uint64]{Uint64Value, &decoded})
+	cached := NewCachedMap(m)
+
+	_, err := cached.Get(ctx, "a")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// values are decoded once, but read from the store every time
+	require.NoError(t, m.Set(ctx, "a", 1))
+	for i := 0; i < 3; i++ {
+		v, err := cached.Get(ctx, "a")
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), v)
+	}
+	require.Equal(t, 1, decoded)
+	require.Equal(t, 4, reads)
+
+	// written values are not cached, but decoded on the next read, as their
+	// callers may still mutate them
+	require.NoError(t, cached.Set(ctx, "a", 2))
+	require.Equal(t, 1, decoded)
+	v, err := cached.Get(ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), v)
+	require.Equal(t, 2, decoded)
+
+	// values written in a branch which is discarded are not returned
+	require.NoError(t, branch.Set([]byte("\x00a"), []byte{0, 0, 0, 0, 0, 0, 0, 3}))
+	v, err = cached.Get(branchCtx, "a")
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), v)
+	require.NoError(t, cached.Set(branchCtx, "a", 4))
+	v, err = cached.Get(ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), v)
+	require.Equal(t, 4, decoded)
+
+	// values written or removed without the cache are not returned
+	require.NoError(t, m.Set(ctx, "a", 5))
+	v, err = cached.Get(ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), v)
+	require.NoError(t, m.Remove(ctx, "a"))
+	_, err = cached.Get(ctx, "a")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	cached.ClearCache()
+	require.Empty(t, cached.cache.entries)
+}
+
+func TestCachedMapMaxEntries(t *testing.T) {
+	sk, ctx := deps()
+	schema := NewSchemaBuilder(sk)
+	cached := NewCachedMap(NewMap(schema, NewPrefix(0), "m", Uint64Key, Uint64Value), WithCacheMaxEntries(2))
+
+	for i := uint64(0); i < 5; i++ {
+		require.NoError(t, cached.Set(ctx, i, i))
+	}
+	require.Empty(t, cached.cache.entries)
+	for i := uint64(0); i < 5; i++ {
+		v, err := cached.Get(ctx, i)
+		require.NoError(t, err)
+		require.Equal(t, i, v)
+	}
+	require.Len(t, cached.cache.entries, 2)
+}
+
+func TestCachedMapSetMutation(t *testing.T) {
+	sk, ctx := deps()
+	schema := NewSchemaBuilder(sk)
+	cached := NewCachedMap(NewMap(schema, NewPrefix(0), "m", StringKey, BytesValue))
+
+	// mutating a value after setting it doesn't change the value read
+	value := []byte("value")
+	require.NoError(t, cached.Set(ctx, "a", value))
+	value[0] = 'V'
+	v, err := cached.Get(ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), v)
+}
+
+func TestCachedItem(t *testing.T) {
+	var decoded int
+	sk, ctx := deps()
+	schema := NewSchemaBuilder(sk)
+	item := NewItem(schema, NewPrefix(0), "item", countingValueCodec[string]{StringValue, &decoded})
+	cached := NewCachedItem(item)
+
+	require.NoError(t, item.Set(ctx, "params"))
+	for i := 0; i < 3; i++ {
+		v, err := cached.Get(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "params", v)
+	}
+	require.Equal(t, 1, decoded)
+
+	require.NoError(t, cached.Set(ctx, "new params"))
+	v, err := cached.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "new params", v)
+	require.Equal(t, 2, decoded)
+
+	has, err := cached.Has(ctx)
+	require.NoError(t, err)
+	require.True(t, has)
+	require.NoError(t, cached.Remove(ctx))
+	_, err = cached.Get(ctx)
+	require.ErrorIs(t, err, ErrNotFound)
+}
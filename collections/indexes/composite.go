@@ -0,0 +1,198 @@
+package indexes
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+)
+
+type compositeOptions struct {
+	uncheckedValue bool
+}
+
+// WithCompositeUncheckedValue is an option that can be passed to NewComposite or
+// NewComposite3 to ignore index values different from '[]byte{}' and continue
+// with the operation. This should be used only to behave nicely in case you have
+// used values different from '[]byte{}' in your storage before migrating to
+// collections. Refer to WithKeySetUncheckedValue for more information.
+func WithCompositeUncheckedValue() func(*compositeOptions) {
+	return func(o *compositeOptions) {
+		o.uncheckedValue = true
+	}
+}
+
+// Composite is an index over two fields of value. It creates a reference between
+// the composite key made of the two fields and the primary key, which can be
+// queried by both fields, or by the first field only. Multiple primary keys can
+// be mapped to the same composite key as the index does not enforce uniqueness
+// constraints. Use Composite3 for an index over three fields.
+type Composite[K1, K2, PrimaryKey, Value any] struct {
+	getRefKey func(pk PrimaryKey, value Value) (collections.Pair[K1, K2], error)
+	refKeys   collections.KeySet[collections.Triple[K1, K2, PrimaryKey]]
+}
+
+// NewComposite instantiates a new Composite instance given a schema, a Prefix,
+// the humanized name for the index, the key codecs of the two fields and the
+// primary key key codec. The getRefKeyFunc is a function that given the primary
+// key and value returns the composite key made of the two fields.
+func NewComposite[K1, K2, PrimaryKey, Value any](
+	schema *collections.SchemaBuilder,
+	prefix collections.Prefix,
+	name string,
+	k1Codec codec.KeyCodec[K1],
+	k2Codec codec.KeyCodec[K2],
+	pkCodec codec.KeyCodec[PrimaryKey],
+	getRefKeyFunc func(pk PrimaryKey, value Value) (collections.Pair[K1, K2], error),
+	options ...func(*compositeOptions),
+) *Composite[K1, K2, PrimaryKey, Value] {
+	o := new(compositeOptions)
+	for _, opt := range options {
+		opt(o)
+	}
+	if o.uncheckedValue {
+		return &Composite[K1, K2, PrimaryKey, Value]{
+			getRefKey: getRefKeyFunc,
+			refKeys:   collections.NewKeySet(schema, prefix, name, collections.TripleKeyCodec(k1Codec, k2Codec, pkCodec), collections.WithKeySetUncheckedValue()),
+		}
+	}
+
+	return &Composite[K1, K2, PrimaryKey, Value]{
+		getRefKey: getRefKeyFunc,
+		refKeys:   collections.NewKeySet(schema, prefix, name, collections.TripleKeyCodec(k1Codec, k2Codec, pkCodec)),
+	}
+}
+
+// Reference implements collections.Index
+func (c *Composite[K1, K2, PrimaryKey, Value]) Reference(ctx context.Context, pk PrimaryKey, newValue Value, lazyOldValue func() (Value, error)) error {
+	oldValue, err := lazyOldValue()
+	switch {
+	// if no error it means the value existed, and we need to remove the old indexes
+	case err == nil:
+		err = c.unreference(ctx, pk, oldValue)
+		if err != nil {
+			return err
+		}
+	// if error is ErrNotFound, it means that the object does not exist, so we're creating indexes for the first time.
+	// we do nothing.
+	case errors.Is(err, collections.ErrNotFound):
+	// default case means that there was some other error
+	default:
+		return err
+	}
+	// create new indexes
+	refKey, err := c.getRefKey(pk, newValue)
+	if err != nil {
+		return err
+	}
+	return c.refKeys.Set(ctx, collections.Join3(refKey.K1(), refKey.K2(), pk))
+}
+
+// Unreference implements collections.Index
+func (c *Composite[K1, K2, PrimaryKey, Value]) Unreference(ctx context.Context, pk PrimaryKey, getValue func() (Value, error)) error {
+	value, err := getValue()
+	if err != nil {
+		return err
+	}
+	return c.unreference(ctx, pk, value)
+}
+
+func (c *Composite[K1, K2, PrimaryKey, Value]) unreference(ctx context.Context, pk PrimaryKey, value Value) error {
+	refKey, err := c.getRefKey(pk, value)
+	if err != nil {
+		return err
+	}
+	return c.refKeys.Remove(ctx, collections.Join3(refKey.K1(), refKey.K2(), pk))
+}
+
+// Iterate exposes the raw iterator API.
+func (c *Composite[K1, K2, PrimaryKey, Value]) Iterate(ctx context.Context, ranger collections.Ranger[collections.Triple[K1, K2, PrimaryKey]]) (CompositeIterator[K1, K2, PrimaryKey], error) {
+	iter, err := c.refKeys.Iterate(ctx, ranger)
+	return (CompositeIterator[K1, K2, PrimaryKey])(iter), err
+}
+
+func (c *Composite[K1, K2, PrimaryKey, Value]) Walk(
+	ctx context.Context,
+	ranger collections.Ranger[collections.Triple[K1, K2, PrimaryKey]],
+	walkFunc func(k1 K1, k2 K2, indexedKey PrimaryKey) (stop bool, err error),
+) error {
+	return c.refKeys.Walk(ctx, ranger, func(key collections.Triple[K1, K2, PrimaryKey]) (bool, error) {
+		return walkFunc(key.K1(), key.K2(), key.K3())
+	})
+}
+
+// MatchExact returns a CompositeIterator containing all the primary keys referenced by the provided fields.
+func (c *Composite[K1, K2, PrimaryKey, Value]) MatchExact(ctx context.Context, k1 K1, k2 K2) (CompositeIterator[K1, K2, PrimaryKey], error) {
+	return c.Iterate(ctx, collections.NewSuperPrefixedTripleRange[K1, K2, PrimaryKey](k1, k2))
+}
+
+// MatchPrefix returns a CompositeIterator containing all the primary keys referenced by the provided first field,
+// ordered by the second field.
+func (c *Composite[K1, K2, PrimaryKey, Value]) MatchPrefix(ctx context.Context, k1 K1) (CompositeIterator[K1, K2, PrimaryKey], error) {
+	return c.Iterate(ctx, collections.NewPrefixedTripleRange[K1, K2, PrimaryKey](k1))
+}
+
+// MatchRange returns a CompositeIterator containing all the primary keys referenced by the provided first field
+// and by a second field in the range [start, end), ordered by the second field, or in reverse order if
+// order is collections.OrderDescending.
+func (c *Composite[K1, K2, PrimaryKey, Value]) MatchRange(ctx context.Context, k1 K1, start, end K2, order collections.Order) (CompositeIterator[K1, K2, PrimaryKey], error) {
+	rng := new(collections.Range[collections.Triple[K1, K2, PrimaryKey]]).
+		StartInclusive(collections.TripleSuperPrefix[K1, K2, PrimaryKey](k1, start)).
+		EndExclusive(collections.TripleSuperPrefix[K1, K2, PrimaryKey](k1, end))
+	if order == collections.OrderDescending {
+		rng = rng.Descending()
+	}
+	return c.Iterate(ctx, rng)
+}
+
+func (c *Composite[K1, K2, PrimaryKey, Value]) KeyCodec() codec.KeyCodec[collections.Triple[K1, K2, PrimaryKey]] {
+	return c.refKeys.KeyCodec()
+}
+
+// CompositeIterator is just a KeySetIterator with key as Triple[K1, K2, PrimaryKey].
+type CompositeIterator[K1, K2, PrimaryKey any] collections.KeySetIterator[collections.Triple[K1, K2, PrimaryKey]]
+
+// PrimaryKey returns the iterator's current primary key.
+func (i CompositeIterator[K1, K2, PrimaryKey]) PrimaryKey() (PrimaryKey, error) {
+	fullKey, err := i.FullKey()
+	return fullKey.K3(), err
+}
+
+// PrimaryKeys fully consumes the iterator and returns the list of primary keys.
+func (i CompositeIterator[K1, K2, PrimaryKey]) PrimaryKeys() ([]PrimaryKey, error) {
+	fullKeys, err := i.FullKeys()
+	if err != nil {
+		return nil, err
+	}
+	pks := make([]PrimaryKey, len(fullKeys))
+	for i, fullKey := range fullKeys {
+		pks[i] = fullKey.K3()
+	}
+	return pks, nil
+}
+
+// FullKey returns the current full reference key as Triple[K1, K2, PrimaryKey].
+func (i CompositeIterator[K1, K2, PrimaryKey]) FullKey() (collections.Triple[K1, K2, PrimaryKey], error) {
+	return (collections.KeySetIterator[collections.Triple[K1, K2, PrimaryKey]])(i).Key()
+}
+
+// FullKeys fully consumes the iterator and returns all the list of full reference keys.
+func (i CompositeIterator[K1, K2, PrimaryKey]) FullKeys() ([]collections.Triple[K1, K2, PrimaryKey], error) {
+	return (collections.KeySetIterator[collections.Triple[K1, K2, PrimaryKey]])(i).Keys()
+}
+
+// Next advances the iterator.
+func (i CompositeIterator[K1, K2, PrimaryKey]) Next() {
+	(collections.KeySetIterator[collections.Triple[K1, K2, PrimaryKey]])(i).Next()
+}
+
+// Valid asserts if the iterator is still valid or not.
+func (i CompositeIterator[K1, K2, PrimaryKey]) Valid() bool {
+	return (collections.KeySetIterator[collections.Triple[K1, K2, PrimaryKey]])(i).Valid()
+}
+
+// Close closes the iterator.
+func (i CompositeIterator[K1, K2, PrimaryKey]) Close() error {
+	return (collections.KeySetIterator[collections.Triple[K1, K2, PrimaryKey]])(i).Close()
+}
@@ -0,0 +1,199 @@
+package indexes
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+)
+
+// Composite3 is an index over three fields of value. It creates a reference
+// between the composite key made of the three fields and the primary key, which
+// can be queried by the three fields, by the first two fields, or by the first
+// field only. Multiple primary keys can be mapped to the same composite key as
+// the index does not enforce uniqueness constraints. Use Composite for an index
+// over two fields.
+type Composite3[K1, K2, K3, PrimaryKey, Value any] struct {
+	getRefKey func(pk PrimaryKey, value Value) (collections.Triple[K1, K2, K3], error)
+	refKeys   collections.KeySet[collections.Triple[K1, K2, collections.Pair[K3, PrimaryKey]]]
+}
+
+// NewComposite3 instantiates a new Composite3 instance given a schema, a Prefix,
+// the humanized name for the index, the key codecs of the three fields and the
+// primary key key codec. The getRefKeyFunc is a function that given the primary
+// key and value returns the composite key made of the three fields.
+func NewComposite3[K1, K2, K3, PrimaryKey, Value any](
+	schema *collections.SchemaBuilder,
+	prefix collections.Prefix,
+	name string,
+	k1Codec codec.KeyCodec[K1],
+	k2Codec codec.KeyCodec[K2],
+	k3Codec codec.KeyCodec[K3],
+	pkCodec codec.KeyCodec[PrimaryKey],
+	getRefKeyFunc func(pk PrimaryKey, value Value) (collections.Triple[K1, K2, K3], error),
+	options ...func(*compositeOptions),
+) *Composite3[K1, K2, K3, PrimaryKey, Value] {
+	o := new(compositeOptions)
+	for _, opt := range options {
+		opt(o)
+	}
+	keyCodec := collections.TripleKeyCodec(k1Codec, k2Codec, collections.PairKeyCodec(k3Codec, pkCodec))
+	if o.uncheckedValue {
+		return &Composite3[K1, K2, K3, PrimaryKey, Value]{
+			getRefKey: getRefKeyFunc,
+			refKeys:   collections.NewKeySet(schema, prefix, name, keyCodec, collections.WithKeySetUncheckedValue()),
+		}
+	}
+
+	return &Composite3[K1, K2, K3, PrimaryKey, Value]{
+		getRefKey: getRefKeyFunc,
+		refKeys:   collections.NewKeySet(schema, prefix, name, keyCodec),
+	}
+}
+
+// Reference implements collections.Index
+func (c *Composite3[K1, K2, K3, PrimaryKey, Value]) Reference(ctx context.Context, pk PrimaryKey, newValue Value, lazyOldValue func() (Value, error)) error {
+	oldValue, err := lazyOldValue()
+	switch {
+	// if no error it means the value existed, and we need to remove the old indexes
+	case err == nil:
+		err = c.unreference(ctx, pk, oldValue)
+		if err != nil {
+			return err
+		}
+	// if error is ErrNotFound, it means that the object does not exist, so we're creating indexes for the first time.
+	// we do nothing.
+	case errors.Is(err, collections.ErrNotFound):
+	// default case means that there was some other error
+	default:
+		return err
+	}
+	// create new indexes
+	refKey, err := c.getRefKey(pk, newValue)
+	if err != nil {
+		return err
+	}
+	return c.refKeys.Set(ctx, joinComposite3(refKey, pk))
+}
+
+// Unreference implements collections.Index
+func (c *Composite3[K1, K2, K3, PrimaryKey, Value]) Unreference(ctx context.Context, pk PrimaryKey, getValue func() (Value, error)) error {
+	value, err := getValue()
+	if err != nil {
+		return err
+	}
+	return c.unreference(ctx, pk, value)
+}
+
+func (c *Composite3[K1, K2, K3, PrimaryKey, Value]) unreference(ctx context.Context, pk PrimaryKey, value Value) error {
+	refKey, err := c.getRefKey(pk, value)
+	if err != nil {
+		return err
+	}
+	return c.refKeys.Remove(ctx, joinComposite3(refKey, pk))
+}
+
+// Iterate exposes the raw iterator API.
+func (c *Composite3[K1, K2, K3, PrimaryKey, Value]) Iterate(ctx context.Context, ranger collections.Ranger[collections.Triple[K1, K2, collections.Pair[K3, PrimaryKey]]]) (Composite3Iterator[K1, K2, K3, PrimaryKey], error) {
+	iter, err := c.refKeys.Iterate(ctx, ranger)
+	return (Composite3Iterator[K1, K2, K3, PrimaryKey])(iter), err
+}
+
+func (c *Composite3[K1, K2, K3, PrimaryKey, Value]) Walk(
+	ctx context.Context,
+	ranger collections.Ranger[collections.Triple[K1, K2, collections.Pair[K3, PrimaryKey]]],
+	walkFunc func(k1 K1, k2 K2, k3 K3, indexedKey PrimaryKey) (stop bool, err error),
+) error {
+	return c.refKeys.Walk(ctx, ranger, func(key collections.Triple[K1, K2, collections.Pair[K3, PrimaryKey]]) (bool, error) {
+		return walkFunc(key.K1(), key.K2(), key.K3().K1(), key.K3().K2())
+	})
+}
+
+// MatchExact returns a Composite3Iterator containing all the primary keys referenced by the provided fields.
+func (c *Composite3[K1, K2, K3, PrimaryKey, Value]) MatchExact(ctx context.Context, k1 K1, k2 K2, k3 K3) (Composite3Iterator[K1, K2, K3, PrimaryKey], error) {
+	rng := new(collections.Range[collections.Triple[K1, K2, collections.Pair[K3, PrimaryKey]]]).
+		Prefix(collections.Join3(k1, k2, collections.PairPrefix[K3, PrimaryKey](k3)))
+	return c.Iterate(ctx, rng)
+}
+
+// MatchPrefix2 returns a Composite3Iterator containing all the primary keys referenced by the provided first
+// two fields, ordered by the third field.
+func (c *Composite3[K1, K2, K3, PrimaryKey, Value]) MatchPrefix2(ctx context.Context, k1 K1, k2 K2) (Composite3Iterator[K1, K2, K3, PrimaryKey], error) {
+	return c.Iterate(ctx, collections.NewSuperPrefixedTripleRange[K1, K2, collections.Pair[K3, PrimaryKey]](k1, k2))
+}
+
+// MatchPrefix returns a Composite3Iterator containing all the primary keys referenced by the provided first field,
+// ordered by the second and the third fields.
+func (c *Composite3[K1, K2, K3, PrimaryKey, Value]) MatchPrefix(ctx context.Context, k1 K1) (Composite3Iterator[K1, K2, K3, PrimaryKey], error) {
+	return c.Iterate(ctx, collections.NewPrefixedTripleRange[K1, K2, collections.Pair[K3, PrimaryKey]](k1))
+}
+
+// MatchRange returns a Composite3Iterator containing all the primary keys referenced by the provided first two
+// fields and by a third field in the range [start, end), ordered by the third field, or in reverse order if
+// order is collections.OrderDescending.
+func (c *Composite3[K1, K2, K3, PrimaryKey, Value]) MatchRange(ctx context.Context, k1 K1, k2 K2, start, end K3, order collections.Order) (Composite3Iterator[K1, K2, K3, PrimaryKey], error) {
+	rng := new(collections.Range[collections.Triple[K1, K2, collections.Pair[K3, PrimaryKey]]]).
+		StartInclusive(collections.Join3(k1, k2, collections.PairPrefix[K3, PrimaryKey](start))).
+		EndExclusive(collections.Join3(k1, k2, collections.PairPrefix[K3, PrimaryKey](end)))
+	if order == collections.OrderDescending {
+		rng = rng.Descending()
+	}
+	return c.Iterate(ctx, rng)
+}
+
+func (c *Composite3[K1, K2, K3, PrimaryKey, Value]) KeyCodec() codec.KeyCodec[collections.Triple[K1, K2, collections.Pair[K3, PrimaryKey]]] {
+	return c.refKeys.KeyCodec()
+}
+
+// joinComposite3 returns the reference key of a primary key made of the three fields.
+func joinComposite3[K1, K2, K3, PrimaryKey any](refKey collections.Triple[K1, K2, K3], pk PrimaryKey) collections.Triple[K1, K2, collections.Pair[K3, PrimaryKey]] {
+	return collections.Join3(refKey.K1(), refKey.K2(), collections.Join(refKey.K3(), pk))
+}
+
+// Composite3Iterator is just a KeySetIterator with key as Triple[K1, K2, Pair[K3, PrimaryKey]].
+type Composite3Iterator[K1, K2, K3, PrimaryKey any] collections.KeySetIterator[collections.Triple[K1, K2, collections.Pair[K3, PrimaryKey]]]
+
+// PrimaryKey returns the iterator's current primary key.
+func (i Composite3Iterator[K1, K2, K3, PrimaryKey]) PrimaryKey() (PrimaryKey, error) {
+	fullKey, err := i.FullKey()
+	return fullKey.K3().K2(), err
+}
+
+// PrimaryKeys fully consumes the iterator and returns the list of primary keys.
+func (i Composite3Iterator[K1, K2, K3, PrimaryKey]) PrimaryKeys() ([]PrimaryKey, error) {
+	fullKeys, err := i.FullKeys()
+	if err != nil {
+		return nil, err
+	}
+	pks := make([]PrimaryKey, len(fullKeys))
+	for i, fullKey := range fullKeys {
+		pks[i] = fullKey.K3().K2()
+	}
+	return pks, nil
+}
+
+// FullKey returns the current full reference key as Triple[K1, K2, Pair[K3, PrimaryKey]].
+func (i Composite3Iterator[K1, K2, K3, PrimaryKey]) FullKey() (collections.Triple[K1, K2, collections.Pair[K3, PrimaryKey]], error) {
+	return (collections.KeySetIterator[collections.Triple[K1, K2, collections.Pair[K3, PrimaryKey]]])(i).Key()
+}
+
+// FullKeys fully consumes the iterator and returns all the list of full reference keys.
+func (i Composite3Iterator[K1, K2, K3, PrimaryKey]) FullKeys() ([]collections.Triple[K1, K2, collections.Pair[K3, PrimaryKey]], error) {
+	return (collections.KeySetIterator[collections.Triple[K1, K2, collections.Pair[K3, PrimaryKey]]])(i).Keys()
+}
+
+// Next advances the iterator.
+func (i Composite3Iterator[K1, K2, K3, PrimaryKey]) Next() {
+	(collections.KeySetIterator[collections.Triple[K1, K2, collections.Pair[K3, PrimaryKey]]])(i).Next()
+}
+
+// Valid asserts if the iterator is still valid or not.
+func (i Composite3Iterator[K1, K2, K3, PrimaryKey]) Valid() bool {
+	return (collections.KeySetIterator[collections.Triple[K1, K2, collections.Pair[K3, PrimaryKey]]])(i).Valid()
+}
+
+// Close closes the iterator.
+func (i Composite3Iterator[K1, K2, K3, PrimaryKey]) Close() error {
+	return (collections.KeySetIterator[collections.Triple[K1, K2, collections.Pair[K3, PrimaryKey]]])(i).Close()
+}
@@ -0,0 +1,119 @@
+package indexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+)
+
+type order struct {
+	Market string
+	Side   string
+	Price  uint64
+}
+
+type orderIndexes struct {
+	MarketSidePrice *Composite3[string, string, uint64, uint64, order]
+}
+
+func (o orderIndexes) IndexesList() []collections.Index[uint64, order] {
+	return []collections.Index[uint64, order]{o.MarketSidePrice}
+}
+
+func TestComposite3Index(t *testing.T) {
+	sk, ctx := deps()
+	sb := collections.NewSchemaBuilder(sk)
+
+	orders := collections.NewIndexedMap(
+		sb, collections.NewPrefix("orders"), "orders",
+		collections.Uint64Key, colltest.MockValueCodec[order](),
+		orderIndexes{
+			MarketSidePrice: NewComposite3(
+				sb, collections.NewPrefix("market_side_price"), "market_side_price",
+				collections.StringKey, collections.StringKey, collections.Uint64Key, collections.Uint64Key,
+				func(_ uint64, o order) (collections.Triple[string, string, uint64], error) {
+					return collections.Join3(o.Market, o.Side, o.Price), nil
+				},
+			),
+		},
+	)
+
+	require.NoError(t, orders.Set(ctx, 1, order{Market: "atom", Side: "buy", Price: 30}))
+	require.NoError(t, orders.Set(ctx, 2, order{Market: "atom", Side: "buy", Price: 10}))
+	require.NoError(t, orders.Set(ctx, 3, order{Market: "atom", Side: "sell", Price: 10}))
+	require.NoError(t, orders.Set(ctx, 4, order{Market: "atom", Side: "buy", Price: 20}))
+	require.NoError(t, orders.Set(ctx, 5, order{Market: "atom", Side: "buy", Price: 20}))
+	require.NoError(t, orders.Set(ctx, 6, order{Market: "osmo", Side: "buy", Price: 20}))
+
+	// match the three fields
+	iter, err := orders.Indexes.MarketSidePrice.MatchExact(ctx, "atom", "buy", 20)
+	require.NoError(t, err)
+	pks, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{4, 5}, pks)
+
+	// match the first two fields, ordered by the third one
+	iter, err = orders.Indexes.MarketSidePrice.MatchPrefix2(ctx, "atom", "buy")
+	require.NoError(t, err)
+	pks, err = iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 4, 5, 1}, pks)
+
+	// match the first field, ordered by the second and the third ones
+	iter, err = orders.Indexes.MarketSidePrice.MatchPrefix(ctx, "atom")
+	require.NoError(t, err)
+	pks, err = iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 4, 5, 1, 3}, pks)
+
+	// match a range of the third field
+	iter, err = orders.Indexes.MarketSidePrice.MatchRange(ctx, "atom", "buy", 10, 30, collections.OrderAscending)
+	require.NoError(t, err)
+	pks, err = iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 4, 5}, pks)
+
+	iter, err = orders.Indexes.MarketSidePrice.MatchRange(ctx, "atom", "buy", 15, 31, collections.OrderDescending)
+	require.NoError(t, err)
+	values, err := CollectValues(ctx, orders, iter)
+	require.NoError(t, err)
+	require.Equal(t, []order{{"atom", "buy", 30}, {"atom", "buy", 20}, {"atom", "buy", 20}}, values)
+
+	// updating the value updates the references
+	require.NoError(t, orders.Set(ctx, 4, order{Market: "atom", Side: "sell", Price: 20}))
+	iter, err = orders.Indexes.MarketSidePrice.MatchExact(ctx, "atom", "buy", 20)
+	require.NoError(t, err)
+	pks, err = iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5}, pks)
+
+	// removing the value removes the references
+	require.NoError(t, orders.Remove(ctx, 6))
+	iter, err = orders.Indexes.MarketSidePrice.MatchPrefix(ctx, "osmo")
+	require.NoError(t, err)
+	pks, err = iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Empty(t, pks)
+
+	// test iter methods
+	iter, err = orders.Indexes.MarketSidePrice.Iterate(ctx, nil)
+	require.NoError(t, err)
+	fullKey, err := iter.FullKey()
+	require.NoError(t, err)
+	require.Equal(t, collections.Join3("atom", "buy", collections.Join(uint64(10), uint64(2))), fullKey)
+	pk, err := iter.PrimaryKey()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), pk)
+	require.NoError(t, iter.Close())
+
+	var walked []uint64
+	err = orders.Indexes.MarketSidePrice.Walk(ctx, nil, func(market, side string, price, id uint64) (bool, error) {
+		walked = append(walked, id)
+		return false, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 5, 1, 3, 4}, walked)
+}
@@ -0,0 +1,110 @@
+package indexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+)
+
+type proposal struct {
+	Status  string
+	EndTime uint64
+}
+
+type proposalIndexes struct {
+	StatusEndTime *Composite[string, uint64, uint64, proposal]
+}
+
+func (p proposalIndexes) IndexesList() []collections.Index[uint64, proposal] {
+	return []collections.Index[uint64, proposal]{p.StatusEndTime}
+}
+
+func TestCompositeIndex(t *testing.T) {
+	sk, ctx := deps()
+	sb := collections.NewSchemaBuilder(sk)
+
+	proposals := collections.NewIndexedMap(
+		sb, collections.NewPrefix("proposals"), "proposals",
+		collections.Uint64Key, colltest.MockValueCodec[proposal](),
+		proposalIndexes{
+			StatusEndTime: NewComposite(
+				sb, collections.NewPrefix("status_end_time"), "status_end_time",
+				collections.StringKey, collections.Uint64Key, collections.Uint64Key,
+				func(_ uint64, p proposal) (collections.Pair[string, uint64], error) {
+					return collections.Join(p.Status, p.EndTime), nil
+				},
+			),
+		},
+	)
+
+	require.NoError(t, proposals.Set(ctx, 1, proposal{Status: "voting", EndTime: 30}))
+	require.NoError(t, proposals.Set(ctx, 2, proposal{Status: "voting", EndTime: 10}))
+	require.NoError(t, proposals.Set(ctx, 3, proposal{Status: "deposit", EndTime: 10}))
+	require.NoError(t, proposals.Set(ctx, 4, proposal{Status: "voting", EndTime: 20}))
+	require.NoError(t, proposals.Set(ctx, 5, proposal{Status: "voting", EndTime: 20}))
+
+	// match both fields
+	iter, err := proposals.Indexes.StatusEndTime.MatchExact(ctx, "voting", 20)
+	require.NoError(t, err)
+	pks, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{4, 5}, pks)
+
+	// match the first field, ordered by the second one
+	iter, err = proposals.Indexes.StatusEndTime.MatchPrefix(ctx, "voting")
+	require.NoError(t, err)
+	pks, err = iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 4, 5, 1}, pks)
+
+	// match a range of the second field
+	iter, err = proposals.Indexes.StatusEndTime.MatchRange(ctx, "voting", 10, 30, collections.OrderAscending)
+	require.NoError(t, err)
+	pks, err = iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 4, 5}, pks)
+
+	iter, err = proposals.Indexes.StatusEndTime.MatchRange(ctx, "voting", 15, 31, collections.OrderDescending)
+	require.NoError(t, err)
+	values, err := CollectValues(ctx, proposals, iter)
+	require.NoError(t, err)
+	require.Equal(t, []proposal{{"voting", 30}, {"voting", 20}, {"voting", 20}}, values)
+
+	// updating the value updates the references
+	require.NoError(t, proposals.Set(ctx, 4, proposal{Status: "passed", EndTime: 20}))
+	iter, err = proposals.Indexes.StatusEndTime.MatchExact(ctx, "voting", 20)
+	require.NoError(t, err)
+	pks, err = iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5}, pks)
+
+	// removing the value removes the references
+	require.NoError(t, proposals.Remove(ctx, 3))
+	iter, err = proposals.Indexes.StatusEndTime.MatchPrefix(ctx, "deposit")
+	require.NoError(t, err)
+	pks, err = iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Empty(t, pks)
+
+	// test iter methods
+	iter, err = proposals.Indexes.StatusEndTime.Iterate(ctx, nil)
+	require.NoError(t, err)
+	fullKey, err := iter.FullKey()
+	require.NoError(t, err)
+	require.Equal(t, collections.Join3("passed", uint64(20), uint64(4)), fullKey)
+	pk, err := iter.PrimaryKey()
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), pk)
+	require.NoError(t, iter.Close())
+
+	var walked []uint64
+	err = proposals.Indexes.StatusEndTime.Walk(ctx, nil, func(status string, endTime, id uint64) (bool, error) {
+		walked = append(walked, id)
+		return false, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{4, 2, 5, 1}, walked)
+}
@@ -0,0 +1,160 @@
+package indexes
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+)
+
+type reverseTripleOptions struct {
+	uncheckedValue bool
+}
+
+// WithReverseTripleUncheckedValue is an option that can be passed to NewReverseTriple to
+// ignore index values different from '[]byte{}' and continue with the operation.
+// This should be used only if you are migrating to collections and have used a different
+// placeholder value in your storage index keys.
+// Refer to WithKeySetUncheckedValue for more information.
+func WithReverseTripleUncheckedValue() func(*reverseTripleOptions) {
+	return func(o *reverseTripleOptions) {
+		o.uncheckedValue = true
+	}
+}
+
+// ReverseTriple is an index that is used with collections.Triple keys. It indexes objects by the third part
+// of the key, and then by the second part. When the value is being indexed by collections.IndexedMap then
+// ReverseTriple will create a relationship between the third and second parts of the primary key and the first part.
+type ReverseTriple[K1, K2, K3, Value any] struct {
+	refKeys collections.KeySet[collections.Triple[K3, K2, K1]] // refKeys has the relationships between Join3(K3, K2, K1)
+}
+
+// tripleKeyCodec is an interface to cast a collections.KeyCodec to a triple
+// codec, see pairKeyCodec.
+type tripleKeyCodec[K1, K2, K3 any] interface {
+	KeyCodec1() codec.KeyCodec[K1]
+	KeyCodec2() codec.KeyCodec[K2]
+	KeyCodec3() codec.KeyCodec[K3]
+}
+
+// NewReverseTriple instantiates a new ReverseTriple index.
+// NOTE: when using this function you will need to type hint: doing NewReverseTriple[Value]()
+// Example: if the value of the indexed map is string, you need to do NewReverseTriple[string](...)
+func NewReverseTriple[Value, K1, K2, K3 any](
+	sb *collections.SchemaBuilder,
+	prefix collections.Prefix,
+	name string,
+	tripleCodec codec.KeyCodec[collections.Triple[K1, K2, K3]],
+	options ...func(*reverseTripleOptions),
+) *ReverseTriple[K1, K2, K3, Value] {
+	tkc := tripleCodec.(tripleKeyCodec[K1, K2, K3])
+	o := new(reverseTripleOptions)
+	for _, option := range options {
+		option(o)
+	}
+	if o.uncheckedValue {
+		return &ReverseTriple[K1, K2, K3, Value]{
+			refKeys: collections.NewKeySet(sb, prefix, name, collections.TripleKeyCodec(tkc.KeyCodec3(), tkc.KeyCodec2(), tkc.KeyCodec1()), collections.WithKeySetUncheckedValue()),
+		}
+	}
+
+	return &ReverseTriple[K1, K2, K3, Value]{
+		refKeys: collections.NewKeySet(sb, prefix, name, collections.TripleKeyCodec(tkc.KeyCodec3(), tkc.KeyCodec2(), tkc.KeyCodec1())),
+	}
+}
+
+// Iterate exposes the raw iterator API.
+func (i *ReverseTriple[K1, K2, K3, Value]) Iterate(ctx context.Context, ranger collections.Ranger[collections.Triple[K3, K2, K1]]) (iter ReverseTripleIterator[K3, K2, K1], err error) {
+	sIter, err := i.refKeys.Iterate(ctx, ranger)
+	if err != nil {
+		return
+	}
+	return (ReverseTripleIterator[K3, K2, K1])(sIter), nil
+}
+
+// MatchExact will return an iterator containing only the primary keys ending with the provided second and third
+// parts of the multipart triple key.
+func (i *ReverseTriple[K1, K2, K3, Value]) MatchExact(ctx context.Context, k3 K3, k2 K2) (ReverseTripleIterator[K3, K2, K1], error) {
+	return i.Iterate(ctx, collections.NewSuperPrefixedTripleRange[K3, K2, K1](k3, k2))
+}
+
+// MatchPrefix will return an iterator containing only the primary keys ending with the provided third part of the
+// multipart triple key, ordered by their second part.
+func (i *ReverseTriple[K1, K2, K3, Value]) MatchPrefix(ctx context.Context, k3 K3) (ReverseTripleIterator[K3, K2, K1], error) {
+	return i.Iterate(ctx, collections.NewPrefixedTripleRange[K3, K2, K1](k3))
+}
+
+// Reference implements collections.Index
+func (i *ReverseTriple[K1, K2, K3, Value]) Reference(ctx context.Context, pk collections.Triple[K1, K2, K3], _ Value, _ func() (Value, error)) error {
+	return i.refKeys.Set(ctx, collections.Join3(pk.K3(), pk.K2(), pk.K1()))
+}
+
+// Unreference implements collections.Index
+func (i *ReverseTriple[K1, K2, K3, Value]) Unreference(ctx context.Context, pk collections.Triple[K1, K2, K3], _ func() (Value, error)) error {
+	return i.refKeys.Remove(ctx, collections.Join3(pk.K3(), pk.K2(), pk.K1()))
+}
+
+func (i *ReverseTriple[K1, K2, K3, Value]) Walk(
+	ctx context.Context,
+	ranger collections.Ranger[collections.Triple[K3, K2, K1]],
+	walkFunc func(k3 K3, k2 K2, k1 K1) (stop bool, err error),
+) error {
+	return i.refKeys.Walk(ctx, ranger, func(key collections.Triple[K3, K2, K1]) (bool, error) {
+		return walkFunc(key.K1(), key.K2(), key.K3())
+	})
+}
+
+func (i *ReverseTriple[K1, K2, K3, Value]) IterateRaw(
+	ctx context.Context, start, end []byte, order collections.Order,
+) (
+	iter collections.Iterator[collections.Triple[K3, K2, K1], collections.NoValue], err error,
+) {
+	return i.refKeys.IterateRaw(ctx, start, end, order)
+}
+
+func (i *ReverseTriple[K1, K2, K3, Value]) KeyCodec() codec.KeyCodec[collections.Triple[K3, K2, K1]] {
+	return i.refKeys.KeyCodec()
+}
+
+// ReverseTripleIterator is a helper type around a collections.KeySetIterator when used to work
+// with ReverseTriple indexes iterations.
+type ReverseTripleIterator[K3, K2, K1 any] collections.KeySetIterator[collections.Triple[K3, K2, K1]]
+
+// PrimaryKey returns the primary key from the index. The index is composed like a reverse
+// triple key. So we just fetch the triple key from the index and return the reverse.
+func (m ReverseTripleIterator[K3, K2, K1]) PrimaryKey() (triple collections.Triple[K1, K2, K3], err error) {
+	reverseTriple, err := m.FullKey()
+	if err != nil {
+		return triple, err
+	}
+	return collections.Join3(reverseTriple.K3(), reverseTriple.K2(), reverseTriple.K1()), nil
+}
+
+// PrimaryKeys returns all the primary keys contained in the iterator.
+func (m ReverseTripleIterator[K3, K2, K1]) PrimaryKeys() (triples []collections.Triple[K1, K2, K3], err error) {
+	defer m.Close()
+	for ; m.Valid(); m.Next() {
+		triple, err := m.PrimaryKey()
+		if err != nil {
+			return nil, err
+		}
+		triples = append(triples, triple)
+	}
+	return triples, err
+}
+
+func (m ReverseTripleIterator[K3, K2, K1]) FullKey() (p collections.Triple[K3, K2, K1], err error) {
+	return (collections.KeySetIterator[collections.Triple[K3, K2, K1]])(m).Key()
+}
+
+func (m ReverseTripleIterator[K3, K2, K1]) Next() {
+	(collections.KeySetIterator[collections.Triple[K3, K2, K1]])(m).Next()
+}
+
+func (m ReverseTripleIterator[K3, K2, K1]) Valid() bool {
+	return (collections.KeySetIterator[collections.Triple[K3, K2, K1]])(m).Valid()
+}
+
+func (m ReverseTripleIterator[K3, K2, K1]) Close() error {
+	return (collections.KeySetIterator[collections.Triple[K3, K2, K1]])(m).Close()
+}
@@ -0,0 +1,79 @@
+package indexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+)
+
+type (
+	Group  = uint64
+	Member = string
+)
+
+// our votes index, allows us to efficiently create an index between the key that maps
+// votes which is a collections.Triple[Member, Group, ProposalID] and the proposal and group.
+type votesIndex struct {
+	Proposal *ReverseTriple[Member, Group, uint64, string]
+}
+
+func (v votesIndex) IndexesList() []collections.Index[collections.Triple[Member, Group, uint64], string] {
+	return []collections.Index[collections.Triple[Member, Group, uint64], string]{v.Proposal}
+}
+
+func TestReverseTriple(t *testing.T) {
+	sk, ctx := deps()
+	sb := collections.NewSchemaBuilder(sk)
+	keyCodec := collections.TripleKeyCodec(collections.StringKey, collections.Uint64Key, collections.Uint64Key)
+
+	votes := collections.NewIndexedMap(
+		sb,
+		collections.NewPrefix("votes"), "votes",
+		keyCodec,
+		collections.StringValue,
+		votesIndex{
+			Proposal: NewReverseTriple[string](sb, collections.NewPrefix("proposal_index"), "proposal_index", keyCodec),
+		},
+	)
+
+	require.NoError(t, votes.Set(ctx, collections.Join3("member1", Group(1), uint64(10)), "yes"))
+	require.NoError(t, votes.Set(ctx, collections.Join3("member2", Group(2), uint64(10)), "no"))
+	require.NoError(t, votes.Set(ctx, collections.Join3("member2", Group(1), uint64(10)), "yes"))
+	require.NoError(t, votes.Set(ctx, collections.Join3("member1", Group(1), uint64(11)), "no"))
+
+	// assert if we iterate over proposal 10 we find the votes of all groups, ordered by group
+	iter, err := votes.Indexes.Proposal.MatchPrefix(ctx, 10)
+	require.NoError(t, err)
+	pks, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Triple[Member, Group, uint64]{
+		collections.Join3("member1", Group(1), uint64(10)),
+		collections.Join3("member2", Group(1), uint64(10)),
+		collections.Join3("member2", Group(2), uint64(10)),
+	}, pks)
+
+	// assert if we iterate over proposal 10 and group 1 we find member1 and member2
+	iter, err = votes.Indexes.Proposal.MatchExact(ctx, 10, 1)
+	require.NoError(t, err)
+	values, err := CollectValues(ctx, votes, iter)
+	require.NoError(t, err)
+	require.Equal(t, []string{"yes", "yes"}, values)
+
+	// assert if we remove the vote of member1 on proposal 11, we can no longer find it in the index
+	require.NoError(t, votes.Remove(ctx, collections.Join3("member1", Group(1), uint64(11))))
+	iter, err = votes.Indexes.Proposal.MatchPrefix(ctx, 11)
+	require.NoError(t, err)
+	pks, err = iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Empty(t, pks)
+
+	var walked []Member
+	err = votes.Indexes.Proposal.Walk(ctx, nil, func(proposalID uint64, group Group, member Member) (bool, error) {
+		walked = append(walked, member)
+		return false, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []Member{"member1", "member2", "member2"}, walked)
+}
@@ -0,0 +1,269 @@
+package collections
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections/codec"
+)
+
+const (
+	migrationInProgress byte = iota
+	migrationDone
+)
+
+type valueMigrationOptions struct {
+	migrateOnRead bool
+}
+
+// WithMigrateOnRead is an option that can be passed to NewValueMigration to
+// write the values in the new encoding when they are read through
+// ValueMigration.Get, instead of transforming them on every read until they
+// are migrated by ValueMigration.Migrate.
+func WithMigrateOnRead() func(*valueMigrationOptions) {
+	return func(o *valueMigrationOptions) {
+		o.migrateOnRead = true
+	}
+}
+
+// ValueMigration migrates the values of a Map from the encoding of an old
+// ValueCodec to the encoding of the Map ValueCodec, given a function which
+// transforms the old values to the new ones.
+//
+// The values are migrated in batches, in the order of their keys, by Migrate,
+// which can be called across several blocks. The progress of the migration is
+// tracked in its own Item: the values up to the last migrated key are in the
+// new encoding, and the values after it are in the old encoding, except the
+// ones written since the migration started, which are tracked in a KeySet.
+// Until the migration is done, the values must be accessed through the
+// ValueMigration Get, Set and Remove methods, which handle both encodings.
+type ValueMigration[K, OldV, NewV any] struct {
+	m         Map[K, NewV]
+	old       Map[K, OldV] // view of m with the old value codec
+	transform func(key K, value OldV) (NewV, error)
+	options   valueMigrationOptions
+
+	// progress is the status of the migration, followed by the last migrated
+	// key when in progress.
+	progress Item[[]byte]
+	// migrated holds the keys after the last migrated key whose value is in the
+	// new encoding.
+	migrated KeySet[K]
+}
+
+// NewValueMigration instantiates a new ValueMigration of the values of the
+// provided Map, previously encoded with oldValueCodec. The progress of the
+// migration is stored under the provided prefix, which must not overlap with
+// the other prefixes of the schema, and the collections storing it are named
+// after the provided name.
+func NewValueMigration[K, OldV, NewV any](
+	schema *SchemaBuilder,
+	prefix Prefix,
+	name string,
+	m Map[K, NewV],
+	oldValueCodec codec.ValueCodec[OldV],
+	transform func(key K, value OldV) (NewV, error),
+	options ...func(*valueMigrationOptions),
+) *ValueMigration[K, OldV, NewV] {
+	v := &ValueMigration[K, OldV, NewV]{
+		m: m,
+		old: Map[K, OldV]{
+			kc:     m.kc,
+			vc:     oldValueCodec,
+			sa:     m.sa,
+			prefix: m.prefix,
+			name:   m.name,
+		},
+		transform: transform,
+		progress:  NewItem(schema, NewPrefix(append(prefix.Bytes(), 0)), name+"_progress", BytesValue),
+		migrated:  NewKeySet(schema, NewPrefix(append(prefix.Bytes(), 1)), name+"_migrated", m.kc),
+	}
+	for _, opt := range options {
+		opt(&v.options)
+	}
+	return v
+}
+
+// Done returns true if all the values are migrated, after which the Map can be
+// used directly.
+func (v *ValueMigration[K, OldV, NewV]) Done(ctx context.Context) (bool, error) {
+	done, _, err := v.getProgress(ctx)
+	return done, err
+}
+
+// Migrate migrates the values of at most limit keys after the last migrated
+// key, and returns true if all the values are migrated.
+func (v *ValueMigration[K, OldV, NewV]) Migrate(ctx context.Context, limit uint64) (done bool, err error) {
+	done, lastKey, err := v.getProgress(ctx)
+	if err != nil || done {
+		return done, err
+	}
+
+	var start []byte
+	if lastKey != nil {
+		start = nextBytesKey(lastKey)
+	}
+	iter, err := v.old.IterateRaw(ctx, start, nil, OrderAscending)
+	if err != nil {
+		return false, err
+	}
+
+	// the batch is collected before being written, as the store must not be
+	// written while being iterated over
+	var keys []K
+	for ; iter.Valid() && uint64(len(keys)) < limit; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			iter.Close()
+			return false, err
+		}
+		keys = append(keys, key)
+	}
+	done = !iter.Valid()
+	if err := iter.Close(); err != nil {
+		return false, err
+	}
+
+	for _, key := range keys {
+		migrated, err := v.migrated.Has(ctx, key)
+		if err != nil {
+			return false, err
+		}
+		if migrated {
+			if err := v.migrated.Remove(ctx, key); err != nil {
+				return false, err
+			}
+			continue
+		}
+
+		if _, err := v.migrate(ctx, key); err != nil {
+			return false, err
+		}
+	}
+
+	if done {
+		return true, v.progress.Set(ctx, []byte{migrationDone})
+	}
+	if len(keys) == 0 {
+		return false, nil
+	}
+	lastKey, err = EncodeKeyWithPrefix(nil, v.m.kc, keys[len(keys)-1])
+	if err != nil {
+		return false, err
+	}
+	return false, v.progress.Set(ctx, append([]byte{migrationInProgress}, lastKey...))
+}
+
+// Get returns the value of the provided key in the new encoding, transforming
+// it if it is not migrated yet.
+func (v *ValueMigration[K, OldV, NewV]) Get(ctx context.Context, key K) (value NewV, err error) {
+	pending, err := v.isPending(ctx, key)
+	if err != nil {
+		return value, err
+	}
+	if pending {
+		migrated, err := v.migrated.Has(ctx, key)
+		if err != nil {
+			return value, err
+		}
+		pending = !migrated
+	}
+
+	switch {
+	case !pending:
+		return v.m.Get(ctx, key)
+	case v.options.migrateOnRead:
+		value, err = v.migrate(ctx, key)
+		if err != nil {
+			return value, err
+		}
+		return value, v.migrated.Set(ctx, key)
+	default:
+		oldValue, err := v.old.Get(ctx, key)
+		if err != nil {
+			return value, err
+		}
+		return v.transform(key, oldValue)
+	}
+}
+
+// Set sets the value of the provided key in the new encoding.
+func (v *ValueMigration[K, OldV, NewV]) Set(ctx context.Context, key K, value NewV) error {
+	pending, err := v.isPending(ctx, key)
+	if err != nil {
+		return err
+	}
+	if err := v.m.Set(ctx, key, value); err != nil {
+		return err
+	}
+	if !pending {
+		return nil
+	}
+	return v.migrated.Set(ctx, key)
+}
+
+// Remove removes the value of the provided key.
+func (v *ValueMigration[K, OldV, NewV]) Remove(ctx context.Context, key K) error {
+	pending, err := v.isPending(ctx, key)
+	if err != nil {
+		return err
+	}
+	if err := v.m.Remove(ctx, key); err != nil {
+		return err
+	}
+	if !pending {
+		return nil
+	}
+	return v.migrated.Remove(ctx, key)
+}
+
+// migrate writes the value of the provided key in the new encoding.
+func (v *ValueMigration[K, OldV, NewV]) migrate(ctx context.Context, key K) (value NewV, err error) {
+	oldValue, err := v.old.Get(ctx, key)
+	if err != nil {
+		return value, err
+	}
+	value, err = v.transform(key, oldValue)
+	if err != nil {
+		return value, err
+	}
+	return value, v.m.Set(ctx, key, value)
+}
+
+// isPending returns true if the provided key is after the last migrated key,
+// meaning that its value is in the old encoding unless it is in v.migrated.
+func (v *ValueMigration[K, OldV, NewV]) isPending(ctx context.Context, key K) (bool, error) {
+	done, lastKey, err := v.getProgress(ctx)
+	if err != nil || done {
+		return false, err
+	}
+	if lastKey == nil {
+		return true, nil
+	}
+	rawKey, err := EncodeKeyWithPrefix(nil, v.m.kc, key)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Compare(rawKey, lastKey) > 0, nil
+}
+
+// getProgress returns whether the migration is done and, if not, the last
+// migrated key, nil if no key was migrated yet.
+func (v *ValueMigration[K, OldV, NewV]) getProgress(ctx context.Context) (done bool, lastKey []byte, err error) {
+	progress, err := v.progress.Get(ctx)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return false, nil, nil
+	case err != nil:
+		return false, nil, err
+	case len(progress) == 0:
+		return false, nil, ErrEncoding
+	case progress[0] == migrationDone:
+		return true, nil, nil
+	case len(progress) == 1:
+		return false, nil, nil
+	default:
+		return false, progress[1:], nil
+	}
+}
@@ -0,0 +1,115 @@
+package collections
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValueMigration(t *testing.T) {
+	sk, ctx := deps()
+
+	// the values were previously encoded as uint64
+	oldSchema := NewSchemaBuilder(sk)
+	oldMap := NewMap(oldSchema, NewPrefix(0), "m", Uint64Key, Uint64Value)
+	for i := uint64(0); i < 10; i++ {
+		require.NoError(t, oldMap.Set(ctx, i, i*10))
+	}
+
+	schema := NewSchemaBuilder(sk)
+	m := NewMap(schema, NewPrefix(0), "m", Uint64Key, StringValue)
+	migration := NewValueMigration(schema, NewPrefix(1), "m_migration", m, Uint64Value, func(_, value uint64) (string, error) {
+		return strconv.FormatUint(value, 10), nil
+	})
+	_, err := schema.Build()
+	require.NoError(t, err)
+
+	// values are transformed before being migrated
+	value, err := migration.Get(ctx, 5)
+	require.NoError(t, err)
+	require.Equal(t, "50", value)
+	_, err = migration.Get(ctx, 42)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// first batch
+	done, err := migration.Migrate(ctx, 4)
+	require.NoError(t, err)
+	require.False(t, done)
+	value, err = m.Get(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, "30", value)
+
+	// values written or removed ahead of the migration are not migrated again
+	require.NoError(t, migration.Set(ctx, 6, "sixty"))
+	require.NoError(t, migration.Set(ctx, 11, "110"))
+	require.NoError(t, migration.Remove(ctx, 7))
+	require.NoError(t, migration.Set(ctx, 2, "twenty"))
+	value, err = migration.Get(ctx, 6)
+	require.NoError(t, err)
+	require.Equal(t, "sixty", value)
+
+	for !done {
+		done, err = migration.Migrate(ctx, 3)
+		require.NoError(t, err)
+	}
+	done, err = migration.Done(ctx)
+	require.NoError(t, err)
+	require.True(t, done)
+
+	kvs, err := m.Iterate(ctx, nil)
+	require.NoError(t, err)
+	values, err := kvs.KeyValues()
+	require.NoError(t, err)
+	require.Equal(t, []KeyValue[uint64, string]{
+		{0, "0"}, {1, "10"}, {2, "twenty"}, {3, "30"}, {4, "40"}, {5, "50"},
+		{6, "sixty"}, {8, "80"}, {9, "90"}, {11, "110"},
+	}, values)
+
+	// the keys written ahead of the migration are no longer tracked
+	iter, err := migration.migrated.Iterate(ctx, nil)
+	require.NoError(t, err)
+	keys, err := iter.Keys()
+	require.NoError(t, err)
+	require.Empty(t, keys)
+}
+
+func TestValueMigrationMigrateOnRead(t *testing.T) {
+	sk, ctx := deps()
+
+	oldSchema := NewSchemaBuilder(sk)
+	oldMap := NewMap(oldSchema, NewPrefix(0), "m", Uint64Key, Uint64Value)
+	for i := uint64(0); i < 4; i++ {
+		require.NoError(t, oldMap.Set(ctx, i, i))
+	}
+
+	schema := NewSchemaBuilder(sk)
+	m := NewMap(schema, NewPrefix(0), "m", Uint64Key, StringValue)
+	transformed := 0
+	migration := NewValueMigration(schema, NewPrefix(1), "m_migration", m, Uint64Value, func(_, value uint64) (string, error) {
+		transformed++
+		return strconv.FormatUint(value, 10), nil
+	}, WithMigrateOnRead())
+
+	// the value read is written in the new encoding
+	value, err := migration.Get(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, "2", value)
+	value, err = m.Get(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, "2", value)
+	_, err = migration.Get(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, 1, transformed)
+
+	done, err := migration.Migrate(ctx, 10)
+	require.NoError(t, err)
+	require.True(t, done)
+	require.Equal(t, 4, transformed)
+
+	// once done, the values are read from the map
+	value, err = migration.Get(context.Background(), 3)
+	require.NoError(t, err)
+	require.Equal(t, "3", value)
+}
@@ -0,0 +1,197 @@
+package collections
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections/codec"
+)
+
+// DefaultPageLimit is the number of entries of a page when PageRequest.Limit is zero.
+const DefaultPageLimit = 100
+
+// ErrInvalidCursor is returned when a PageRequest cursor is not a key of the
+// paginated collection.
+var ErrInvalidCursor = errors.New("collections: invalid cursor")
+
+// PageRequest defines a page of a cursor based pagination.
+type PageRequest struct {
+	// Cursor is the NextCursor of the previous page, nil for the first page.
+	// As it identifies the last key of the previous page, pages are stable
+	// across writes: entries inserted or removed in other pages do not shift
+	// the entries of the next pages.
+	Cursor []byte
+	// Limit is the maximum number of entries of the page, DefaultPageLimit if zero.
+	Limit uint64
+	// Reverse returns the entries in the reverse order of the range.
+	Reverse bool
+	// CountTotal requests the total number of entries in the range.
+	CountTotal bool
+}
+
+// PageResponse is the response of a page of a cursor based pagination.
+type PageResponse struct {
+	// NextCursor is the cursor of the next page, nil if there are no more entries.
+	NextCursor []byte
+	// Total is the total number of entries in the range, only set if
+	// PageRequest.CountTotal is true.
+	Total uint64
+}
+
+// PaginatedCollection defines the minimum required API of a collection
+// to work with Paginate.
+type PaginatedCollection[K, V any] interface {
+	// IterateRaw allows to iterate over a raw set of byte keys.
+	IterateRaw(ctx context.Context, start, end []byte, order Order) (Iterator[K, V], error)
+	// KeyCodec exposes the KeyCodec of a collection, required to encode the
+	// cursors from and to keys.
+	KeyCodec() codec.KeyCodec[K]
+}
+
+type paginateOptions struct {
+	counter func(ctx context.Context) (uint64, error)
+}
+
+// WithPaginateCounter is an option that can be passed to Paginate to provide
+// the total number of entries in the range when PageRequest.CountTotal is true,
+// for example the Get method of an Item tracking the size of a Map. Without
+// a counter, the entries of the range are iterated to be counted.
+func WithPaginateCounter(counter func(ctx context.Context) (uint64, error)) func(*paginateOptions) {
+	return func(o *paginateOptions) {
+		o.counter = counter
+	}
+}
+
+// Paginate returns a page of the entries of a collection in the provided range,
+// which can be nil to paginate over all the entries. The page starts after the
+// key identified by the request cursor, and the response contains the cursor
+// of the next page.
+func Paginate[K, V any, C PaginatedCollection[K, V]](
+	ctx context.Context,
+	coll C,
+	ranger Ranger[K],
+	req PageRequest,
+	options ...func(*paginateOptions),
+) ([]KeyValue[K, V], PageResponse, error) {
+	o := new(paginateOptions)
+	for _, opt := range options {
+		opt(o)
+	}
+
+	start, end, order, err := rawRange(coll.KeyCodec(), ranger)
+	if err != nil {
+		return nil, PageResponse{}, err
+	}
+	if req.Reverse {
+		order ^= OrderDescending
+	}
+
+	var res PageResponse
+	if req.CountTotal {
+		if o.counter != nil {
+			res.Total, err = o.counter(ctx)
+		} else {
+			res.Total, err = countRaw(ctx, coll, start, end)
+		}
+		if err != nil {
+			return nil, PageResponse{}, err
+		}
+	}
+
+	// narrow the range to the entries after the cursor
+	if req.Cursor != nil {
+		n, _, err := coll.KeyCodec().Decode(req.Cursor)
+		if err != nil || n != len(req.Cursor) {
+			return nil, PageResponse{}, fmt.Errorf("%w: %x", ErrInvalidCursor, req.Cursor)
+		}
+		switch order {
+		case OrderAscending:
+			if cursorStart := nextBytesKey(bytes.Clone(req.Cursor)); bytes.Compare(cursorStart, start) > 0 {
+				start = cursorStart
+			}
+		case OrderDescending:
+			if end == nil || bytes.Compare(req.Cursor, end) < 0 {
+				end = req.Cursor
+			}
+		}
+		if end != nil && bytes.Compare(start, end) >= 0 {
+			// the cursor is past the range
+			return nil, res, nil
+		}
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = DefaultPageLimit
+	}
+
+	iter, err := coll.IterateRaw(ctx, start, end, order)
+	if err != nil {
+		return nil, PageResponse{}, err
+	}
+	defer iter.Close()
+
+	var kvs []KeyValue[K, V]
+	var lastKey K
+	for ; iter.Valid(); iter.Next() {
+		if uint64(len(kvs)) == limit {
+			res.NextCursor, err = EncodeKeyWithPrefix(nil, coll.KeyCodec(), lastKey)
+			if err != nil {
+				return nil, PageResponse{}, err
+			}
+			break
+		}
+
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, PageResponse{}, err
+		}
+		kvs = append(kvs, kv)
+		lastKey = kv.Key
+	}
+
+	return kvs, res, nil
+}
+
+// rawRange encodes the bounds of a Ranger, without the collection prefix. A nil
+// end means there is no end.
+func rawRange[K any](keyCodec codec.KeyCodec[K], ranger Ranger[K]) (start, end []byte, order Order, err error) {
+	if ranger == nil {
+		return nil, nil, OrderAscending, nil
+	}
+
+	startKey, endKey, order, err := ranger.RangeValues()
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	if startKey != nil {
+		start, err = encodeRangeBound(nil, keyCodec, startKey)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+	}
+	if endKey != nil {
+		end, err = encodeRangeBound(nil, keyCodec, endKey)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+	}
+	return start, end, order, nil
+}
+
+// countRaw counts the entries of a collection in a raw range.
+func countRaw[K, V any, C PaginatedCollection[K, V]](ctx context.Context, coll C, start, end []byte) (uint64, error) {
+	iter, err := coll.IterateRaw(ctx, start, end, OrderAscending)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	var count uint64
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count, nil
+}
@@ -0,0 +1,104 @@
+package collections
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaginate(t *testing.T) {
+	sk, ctx := deps()
+	schema := NewSchemaBuilder(sk)
+	m := NewMap(schema, NewPrefix(0), "m", Uint64Key, Uint64Value)
+	for i := uint64(0); i < 10; i++ {
+		require.NoError(t, m.Set(ctx, i*10, i))
+	}
+
+	keys := func(kvs []KeyValue[uint64, uint64]) (keys []uint64) {
+		for _, kv := range kvs {
+			keys = append(keys, kv.Key)
+		}
+		return keys
+	}
+
+	// first page
+	kvs, res, err := Paginate(ctx, m, nil, PageRequest{Limit: 4, CountTotal: true})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 10, 20, 30}, keys(kvs))
+	require.Equal(t, uint64(10), res.Total)
+	require.NotNil(t, res.NextCursor)
+
+	// entries inserted and removed before the cursor do not shift the next page
+	require.NoError(t, m.Set(ctx, 5, 0))
+	require.NoError(t, m.Remove(ctx, 10))
+	kvs, res, err = Paginate(ctx, m, nil, PageRequest{Cursor: res.NextCursor, Limit: 4})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{40, 50, 60, 70}, keys(kvs))
+	require.Zero(t, res.Total)
+
+	// last page
+	kvs, res, err = Paginate(ctx, m, nil, PageRequest{Cursor: res.NextCursor, Limit: 4})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{80, 90}, keys(kvs))
+	require.Nil(t, res.NextCursor)
+
+	// reverse order, in a range
+	rng := new(Range[uint64]).StartInclusive(20).EndExclusive(90)
+	kvs, res, err = Paginate(ctx, m, rng, PageRequest{Limit: 3, Reverse: true})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{80, 70, 60}, keys(kvs))
+	kvs, res, err = Paginate(ctx, m, rng, PageRequest{Cursor: res.NextCursor, Limit: 3, Reverse: true})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{50, 40, 30}, keys(kvs))
+	kvs, res, err = Paginate(ctx, m, rng, PageRequest{Cursor: res.NextCursor, Limit: 3, Reverse: true, CountTotal: true})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{20}, keys(kvs))
+	require.Nil(t, res.NextCursor)
+	require.Equal(t, uint64(7), res.Total)
+
+	// a cursor out of the range returns no entries
+	cursor, err := EncodeKeyWithPrefix(nil, Uint64Key, uint64(95))
+	require.NoError(t, err)
+	kvs, res, err = Paginate(ctx, m, rng, PageRequest{Cursor: cursor})
+	require.NoError(t, err)
+	require.Empty(t, kvs)
+	require.Nil(t, res.NextCursor)
+
+	// the total is provided by the counter
+	_, res, err = Paginate(ctx, m, nil, PageRequest{CountTotal: true}, WithPaginateCounter(func(context.Context) (uint64, error) {
+		return 42, nil
+	}))
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), res.Total)
+
+	// invalid cursor
+	_, _, err = Paginate(ctx, m, nil, PageRequest{Cursor: []byte("invalid")})
+	require.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestPaginatePrefix(t *testing.T) {
+	sk, ctx := deps()
+	schema := NewSchemaBuilder(sk)
+	ks := NewKeySet(schema, NewPrefix(0), "ks", PairKeyCodec(StringKey, StringKey))
+	for _, k1 := range []string{"a", "b", "c"} {
+		for _, k2 := range []string{"x", "y", "z"} {
+			require.NoError(t, ks.Set(ctx, Join(k1, k2)))
+		}
+	}
+
+	var page []Pair[string, string]
+	var cursor []byte
+	for {
+		kvs, res, err := Paginate(ctx, ks, NewPrefixedPairRange[string, string]("b"), PageRequest{Cursor: cursor, Limit: 2})
+		require.NoError(t, err)
+		for _, kv := range kvs {
+			page = append(page, kv.Key)
+		}
+		if res.NextCursor == nil {
+			break
+		}
+		cursor = res.NextCursor
+	}
+	require.Equal(t, []Pair[string, string]{Join("b", "x"), Join("b", "y"), Join("b", "z")}, page)
+}
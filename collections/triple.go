@@ -70,6 +70,12 @@ type tripleKeyCodec[K1, K2, K3 any] struct {
 	keyCodec3 codec.KeyCodec[K3]
 }
 
+func (t tripleKeyCodec[K1, K2, K3]) KeyCodec1() codec.KeyCodec[K1] { return t.keyCodec1 }
+
+func (t tripleKeyCodec[K1, K2, K3]) KeyCodec2() codec.KeyCodec[K2] { return t.keyCodec2 }
+
+func (t tripleKeyCodec[K1, K2, K3]) KeyCodec3() codec.KeyCodec[K3] { return t.keyCodec3 }
+
 type jsonTripleKey [3]json.RawMessage
 
 func (t tripleKeyCodec[K1, K2, K3]) EncodeJSON(value Triple[K1, K2, K3]) ([]byte, error) {
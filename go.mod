@@ -167,7 +167,11 @@ require (
 // replace (
 // 	<temporary replace>
 // )
-replace cosmossdk.io/x/protocolpool => ./x/protocolpool
+replace (
+	cosmossdk.io/store => ./store
+	cosmossdk.io/x/protocolpool => ./x/protocolpool
+	cosmossdk.io/x/tx => ./x/tx
+)
 
 // Below are the long-lived replace of the Cosmos SDK
 replace (
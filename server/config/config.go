@@ -47,6 +47,19 @@ type BaseConfig struct {
 	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
 	PruningInterval   string `mapstructure:"pruning-interval"`
 
+	// PruningKeepTime is the duration of the recent heights kept with the time
+	// pruning strategy, e.g. "720h".
+	PruningKeepTime string `mapstructure:"pruning-keep-time"`
+
+	// PruningMaxSizeMB is the size in MB of the application database above which
+	// the oldest heights are pruned with the size pruning strategy.
+	PruningMaxSizeMB uint64 `mapstructure:"pruning-max-size-mb"`
+
+	// PruningPinnedHeights are heights which are never pruned, e.g. upgrade
+	// heights. Pruning stops below the earliest pinned height which isn't pruned
+	// yet, keeping the heights following it as well.
+	PruningPinnedHeights []int64 `mapstructure:"pruning-pinned-heights"`
+
 	// HaltHeight contains a non-zero block height at which a node will gracefully
 	// halt and shutdown that can be used to assist upgrades and testing.
 	//
@@ -89,6 +102,15 @@ type BaseConfig struct {
 	// IAVLDisableFastNode enables or disables the fast sync node.
 	IAVLDisableFastNode bool `mapstructure:"iavl-disable-fastnode"`
 
+	// CommitConcurrency defines the maximum number of stores committed
+	// concurrently. The stores are committed sequentially if it is lower than 2.
+	CommitConcurrency int `mapstructure:"commit-concurrency"`
+
+	// ParallelTxWorkers defines the number of goroutines executing the
+	// transactions of a block in parallel. The transactions are executed
+	// sequentially if it is lower than 2.
+	ParallelTxWorkers int `mapstructure:"parallel-tx-workers"`
+
 	// AppDBBackend defines the type of Database to use for the application and snapshots databases.
 	// An empty string indicates that the CometBFT config's DBBackend value should be used.
 	AppDBBackend string `mapstructure:"app-db-backend"`
@@ -157,6 +179,10 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// SnapshotMaxDeltas sets the maximum number of delta snapshots taken on top of a
+	// full snapshot. 0 disables delta snapshots.
+	SnapshotMaxDeltas uint32 `mapstructure:"snapshot-max-deltas"`
 }
 
 // MempoolConfig defines the configurations for the SDK built-in app-side mempool
@@ -173,13 +199,28 @@ type MempoolConfig struct {
 type (
 	// StreamingConfig defines application configuration for external streaming services
 	StreamingConfig struct {
-		ABCI ABCIListenerConfig `mapstructure:"abci"`
+		ABCI  ABCIListenerConfig `mapstructure:"abci"`
+		Index StateIndexConfig   `mapstructure:"index"`
 	}
 	// ABCIListenerConfig defines application configuration for ABCIListener streaming service
 	ABCIListenerConfig struct {
 		Keys          []string `mapstructure:"keys"`
 		Plugin        string   `mapstructure:"plugin"`
 		StopNodeOnErr bool     `mapstructure:"stop-node-on-err"`
+
+		// Sink defines the built-in sink of the in-process ABCI listener, "file"
+		// or "broker", as an alternative to a plugin.
+		Sink         string `mapstructure:"sink"`
+		BufferSize   int    `mapstructure:"buffer-size"`
+		FileDir      string `mapstructure:"file-dir"`
+		FileMaxBytes int64  `mapstructure:"file-max-bytes"`
+		BrokerTopic  string `mapstructure:"broker-topic"`
+	}
+	// StateIndexConfig defines application configuration for the state change index,
+	// persisting the streamed change sets to serve them through gRPC.
+	StateIndexConfig struct {
+		Enable        bool  `mapstructure:"enable"`
+		RetainHeights int64 `mapstructure:"retain-heights"`
 	}
 )
 
@@ -228,17 +269,20 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:        defaultMinGasPrices,
-			QueryGasLimit:       0,
-			InterBlockCache:     true,
-			Pruning:             pruningtypes.PruningOptionDefault,
-			PruningKeepRecent:   "0",
-			PruningInterval:     "0",
-			MinRetainBlocks:     0,
-			IndexEvents:         make([]string, 0),
-			IAVLCacheSize:       781250,
-			IAVLDisableFastNode: false,
-			AppDBBackend:        "",
+			MinGasPrices:         defaultMinGasPrices,
+			QueryGasLimit:        0,
+			InterBlockCache:      true,
+			Pruning:              pruningtypes.PruningOptionDefault,
+			PruningKeepRecent:    "0",
+			PruningInterval:      "0",
+			PruningKeepTime:      "0s",
+			PruningPinnedHeights: []int64{},
+			MinRetainBlocks:      0,
+			IndexEvents:          make([]string, 0),
+			IAVLCacheSize:        781250,
+			IAVLDisableFastNode:  false,
+			CommitConcurrency:    1,
+			AppDBBackend:         "",
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -269,6 +313,14 @@ func DefaultConfig() *Config {
 			ABCI: ABCIListenerConfig{
 				Keys:          []string{},
 				StopNodeOnErr: true,
+				BufferSize:    1000,
+				FileDir:       "data/streaming",
+				FileMaxBytes:  100 << 20,
+				BrokerTopic:   "cosmos-sdk-abci",
+			},
+			Index: StateIndexConfig{
+				Enable:        false,
+				RetainHeights: 10000,
 			},
 		},
 		Mempool: MempoolConfig{
@@ -141,6 +141,29 @@ func TestIndexEventsWriteRead(t *testing.T) {
 	require.Equal(t, expected, actual, "config value")
 }
 
+func TestPruningWriteRead(t *testing.T) {
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := DefaultConfig()
+	conf.Pruning = "size"
+	conf.PruningKeepTime = "720h"
+	conf.PruningMaxSizeMB = 1024
+	conf.PruningPinnedHeights = []int64{100, 2000}
+
+	err := WriteConfigFile(confFile, conf)
+	require.NoError(t, err)
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig())
+
+	cfg, err := ParseConfig(vpr)
+	require.NoError(t, err)
+	require.Equal(t, conf.Pruning, cfg.Pruning)
+	require.Equal(t, conf.PruningKeepTime, cfg.PruningKeepTime)
+	require.Equal(t, conf.PruningMaxSizeMB, cfg.PruningMaxSizeMB)
+	require.Equal(t, conf.PruningPinnedHeights, cfg.PruningPinnedHeights)
+}
+
 func TestGlobalLabelsEventsMarshalling(t *testing.T) {
 	expectedIn := `global-labels = [
   ["labelname1", "labelvalue1"],
@@ -29,12 +29,28 @@ query-gas-limit = "{{ .BaseConfig.QueryGasLimit }}"
 # nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 # everything: 2 latest states will be kept; pruning at 10 block intervals.
 # custom: allow pruning options to be manually specified through 'pruning-keep-recent', and 'pruning-interval'
+# time: the states of the last 'pruning-keep-time' are kept, and at least the last 'pruning-keep-recent' states
+# size: the oldest states are deleted while the application database is larger than 'pruning-max-size-mb',
+# keeping at least the last 'pruning-keep-recent' states
 pruning = "{{ .BaseConfig.Pruning }}"
 
-# These are applied if and only if the pruning strategy is custom.
+# These are applied if and only if the pruning strategy is custom, time or size.
 pruning-keep-recent = "{{ .BaseConfig.PruningKeepRecent }}"
 pruning-interval = "{{ .BaseConfig.PruningInterval }}"
 
+# The duration of the recent states kept if the pruning strategy is time, e.g. "720h".
+pruning-keep-time = "{{ .BaseConfig.PruningKeepTime }}"
+
+# The size in MB of the application database above which the oldest states are
+# pruned if the pruning strategy is size. As the database size only decreases
+# once its deleted entries are compacted, the states are pruned gradually.
+pruning-max-size-mb = {{ .BaseConfig.PruningMaxSizeMB }}
+
+# The heights whose states are never pruned, e.g. upgrade heights. As the IAVL
+# stores prune their states in order, pruning stops below the earliest pinned height
+# which isn't pruned yet: the states following it are kept as well, until it is unpinned.
+pruning-pinned-heights = [{{ range .BaseConfig.PruningPinnedHeights }}{{ printf "%d, " . }}{{end}}]
+
 # HaltHeight contains a non-zero block height at which a node will gracefully
 # halt and shutdown that can be used to assist upgrades and testing.
 #
@@ -81,6 +97,18 @@ iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 # Default is false.
 iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 
+# CommitConcurrency defines the maximum number of stores committed concurrently
+# at the end of a block. The app hash doesn't depend on it.
+# The stores are committed sequentially if it is lower than 2, the default.
+commit-concurrency = {{ .BaseConfig.CommitConcurrency }}
+
+# ParallelTxWorkers defines the number of goroutines executing the transactions
+# of a block in parallel. The transactions are executed optimistically in
+# parallel, and the conflicting ones are executed again in the order of the
+# block, producing the same results as a sequential execution.
+# The transactions are executed sequentially if it is lower than 2, the default.
+parallel-tx-workers = {{ .BaseConfig.ParallelTxWorkers }}
+
 # AppDBBackend defines the database backend type to use for the application and snapshots DBs.
 # An empty string indicates that a fallback will be used.
 # The fallback is the db_backend value set in CometBFT's config.toml.
@@ -197,6 +225,12 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 
+# snapshot-max-deltas specifies the maximum number of delta snapshots, containing only the state
+# changes since the previous snapshot, taken before taking a new full snapshot (0 to disable).
+# Delta snapshots can only be restored locally, and require the heights between two snapshots not
+# to be pruned, i.e. pruning-keep-recent must be greater than snapshot-interval.
+snapshot-max-deltas = {{ .StateSync.SnapshotMaxDeltas }}
+
 ###############################################################################
 ###                              State Streaming                            ###
 ###############################################################################
@@ -221,8 +255,43 @@ keys = [{{ range .Streaming.ABCI.Keys }}{{ printf "%q, " . }}{{end}}]
 plugin = "{{ .Streaming.ABCI.Plugin }}"
 
 # stop-node-on-err specifies whether to stop the node on message delivery error.
+# With a sink, the commit of a block waits for its records to be written if it is true,
+# and the records failing to be written are dropped if it is false.
 stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 
+# The built-in sink used for streaming from an in-process listener, instead of a plugin:
+# a plugin and a sink cannot both be set.
+# Streaming is only enabled if this or plugin is set.
+# Supported sinks:
+#   file: length-prefixed protobuf StreamRecord messages written to files rotated by size
+#   broker: StreamRecord messages published to a message broker, with the producer set by the app
+sink = "{{ .Streaming.ABCI.Sink }}"
+
+# buffer-size is the maximum number of records waiting to be written by the sink.
+# The node waits for the sink while the buffer is full.
+buffer-size = {{ .Streaming.ABCI.BufferSize }}
+
+# file-dir is the directory of the files of the file sink, relative to the node home if not absolute.
+file-dir = "{{ .Streaming.ABCI.FileDir }}"
+
+# file-max-bytes is the size in bytes above which the files of the file sink are rotated.
+file-max-bytes = {{ .Streaming.ABCI.FileMaxBytes }}
+
+# broker-topic is the topic the broker sink publishes to.
+broker-topic = "{{ .Streaming.ABCI.BrokerTopic }}"
+
+# streaming.index specifies the configuration of the state change index, persisting the change sets
+# of the stores of streaming.abci.keys for a number of recent heights. When enabled, the change sets are
+# served by the gRPC cosmos.store.streaming.abci.StateChangeService, from which external indexers can
+# resume streaming from any retained height.
+[streaming.index]
+
+# Enable defines if the state change index should be enabled.
+enable = {{ .Streaming.Index.Enable }}
+
+# retain-heights is the number of recent heights whose change sets are retained.
+retain-heights = {{ .Streaming.Index.RetainHeights }}
+
 ###############################################################################
 ###                         Mempool                                         ###
 ###############################################################################
@@ -2,10 +2,13 @@ package server
 
 import (
 	"fmt"
+	"io/fs"
+	"path/filepath"
 	"strings"
 
 	"github.com/spf13/cast"
 
+	"cosmossdk.io/store/pruning"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 
 	"github.com/cosmos/cosmos-sdk/server/types"
@@ -17,23 +20,67 @@ import (
 func GetPruningOptionsFromFlags(appOpts types.AppOptions) (pruningtypes.PruningOptions, error) {
 	strategy := strings.ToLower(cast.ToString(appOpts.Get(FlagPruning)))
 
+	var opts pruningtypes.PruningOptions
 	switch strategy {
-	case pruningtypes.PruningOptionDefault, pruningtypes.PruningOptionNothing, pruningtypes.PruningOptionEverything:
+	case pruningtypes.PruningOptionNothing:
 		return pruningtypes.NewPruningOptionsFromString(strategy), nil
 
+	case pruningtypes.PruningOptionDefault, pruningtypes.PruningOptionEverything:
+		opts = pruningtypes.NewPruningOptionsFromString(strategy)
+
 	case pruningtypes.PruningOptionCustom:
-		opts := pruningtypes.NewCustomPruningOptions(
+		opts = pruningtypes.NewCustomPruningOptions(
 			cast.ToUint64(appOpts.Get(FlagPruningKeepRecent)),
 			cast.ToUint64(appOpts.Get(FlagPruningInterval)),
 		)
 
-		if err := opts.Validate(); err != nil {
-			return opts, fmt.Errorf("invalid custom pruning options: %w", err)
-		}
+	case pruningtypes.PruningOptionTime:
+		opts = pruningtypes.NewTimePruningOptions(
+			cast.ToDuration(appOpts.Get(FlagPruningKeepTime)),
+			cast.ToUint64(appOpts.Get(FlagPruningKeepRecent)),
+			cast.ToUint64(appOpts.Get(FlagPruningInterval)),
+		)
 
-		return opts, nil
+	case pruningtypes.PruningOptionSize:
+		opts = pruningtypes.NewSizePruningOptions(
+			cast.ToUint64(appOpts.Get(FlagPruningMaxSizeMB))<<20,
+			cast.ToUint64(appOpts.Get(FlagPruningKeepRecent)),
+			cast.ToUint64(appOpts.Get(FlagPruningInterval)),
+		)
 
 	default:
 		return pruningtypes.PruningOptions{}, fmt.Errorf("unknown pruning strategy %s", strategy)
 	}
+
+	for _, height := range cast.ToIntSlice(appOpts.Get(FlagPruningPinnedHeights)) {
+		opts.PinnedHeights = append(opts.PinnedHeights, int64(height))
+	}
+
+	if err := opts.Validate(); err != nil {
+		return opts, fmt.Errorf("invalid %s pruning options: %w", strategy, err)
+	}
+
+	return opts, nil
+}
+
+// dirSize returns a pruning.SizeFunc returning the total size of the files of a
+// directory, e.g. of the application database.
+func dirSize(dir string) pruning.SizeFunc {
+	return func() (uint64, error) {
+		var size uint64
+		err := filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			size += uint64(info.Size())
+			return nil
+		})
+
+		return size, err
+	}
 }
@@ -1,7 +1,10 @@
 package server
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
@@ -46,6 +49,65 @@ func TestGetPruningOptionsFromFlags(t *testing.T) {
 			},
 			expectedOptions: pruningtypes.NewPruningOptions(pruningtypes.PruningDefault),
 		},
+		{
+			name: "time pruning options",
+			initParams: func() *viper.Viper {
+				v := viper.New()
+				v.Set(FlagPruning, pruningtypes.PruningOptionTime)
+				v.Set(FlagPruningKeepTime, "720h")
+				v.Set(FlagPruningKeepRecent, 100)
+				v.Set(FlagPruningInterval, 10)
+				return v
+			},
+			expectedOptions: pruningtypes.NewTimePruningOptions(720*time.Hour, 100, 10),
+		},
+		{
+			name: "invalid time pruning options",
+			initParams: func() *viper.Viper {
+				v := viper.New()
+				v.Set(FlagPruning, pruningtypes.PruningOptionTime)
+				v.Set(FlagPruningKeepRecent, 100)
+				v.Set(FlagPruningInterval, 10)
+				return v
+			},
+			wantErr: true,
+		},
+		{
+			name: "size pruning options",
+			initParams: func() *viper.Viper {
+				v := viper.New()
+				v.Set(FlagPruning, pruningtypes.PruningOptionSize)
+				v.Set(FlagPruningMaxSizeMB, 1024)
+				v.Set(FlagPruningKeepRecent, 100)
+				v.Set(FlagPruningInterval, 10)
+				return v
+			},
+			expectedOptions: pruningtypes.NewSizePruningOptions(1<<30, 100, 10),
+		},
+		{
+			name: "pinned heights",
+			initParams: func() *viper.Viper {
+				v := viper.New()
+				v.Set(FlagPruning, pruningtypes.PruningOptionEverything)
+				v.Set(FlagPruningPinnedHeights, []interface{}{int64(100), int64(2000)})
+				return v
+			},
+			expectedOptions: func() pruningtypes.PruningOptions {
+				opts := pruningtypes.NewPruningOptions(pruningtypes.PruningEverything)
+				opts.PinnedHeights = []int64{100, 2000}
+				return opts
+			}(),
+		},
+		{
+			name: "invalid pinned heights",
+			initParams: func() *viper.Viper {
+				v := viper.New()
+				v.Set(FlagPruning, pruningtypes.PruningOptionDefault)
+				v.Set(FlagPruningPinnedHeights, []int{-1})
+				return v
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -66,3 +128,17 @@ func TestGetPruningOptionsFromFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestDirSize(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a"), make([]byte, 100), 0o600))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "sub"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sub", "b"), make([]byte, 50), 0o600))
+
+	size, err := dirSize(dir)()
+	require.NoError(t, err)
+	require.Equal(t, uint64(150), size)
+
+	_, err = dirSize(filepath.Join(dir, "missing"))()
+	require.Error(t, err)
+}
@@ -57,18 +57,24 @@ const (
 	FlagTrace              = "trace"
 	FlagInvCheckPeriod     = "inv-check-period"
 
-	FlagPruning             = "pruning"
-	FlagPruningKeepRecent   = "pruning-keep-recent"
-	FlagPruningInterval     = "pruning-interval"
-	FlagIndexEvents         = "index-events"
-	FlagMinRetainBlocks     = "min-retain-blocks"
-	FlagIAVLCacheSize       = "iavl-cache-size"
-	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
-	FlagShutdownGrace       = "shutdown-grace"
+	FlagPruning              = "pruning"
+	FlagPruningKeepRecent    = "pruning-keep-recent"
+	FlagPruningInterval      = "pruning-interval"
+	FlagPruningKeepTime      = "pruning-keep-time"
+	FlagPruningMaxSizeMB     = "pruning-max-size-mb"
+	FlagPruningPinnedHeights = "pruning-pinned-heights"
+	FlagIndexEvents          = "index-events"
+	FlagMinRetainBlocks      = "min-retain-blocks"
+	FlagIAVLCacheSize        = "iavl-cache-size"
+	FlagDisableIAVLFastNode  = "iavl-disable-fastnode"
+	FlagParallelTxWorkers    = "parallel-tx-workers"
+	FlagCommitConcurrency    = "commit-concurrency"
+	FlagShutdownGrace        = "shutdown-grace"
 
 	// state sync-related flags
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
 	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotMaxDeltas  = "state-sync.snapshot-max-deltas"
 
 	// api-related flags
 	FlagAPIEnable             = "api.enable"
@@ -130,6 +136,12 @@ default: the last 362880 states are kept, pruning at 10 block intervals
 nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 everything: 2 latest states will be kept; pruning at 10 block intervals.
 custom: allow pruning options to be manually specified through 'pruning-keep-recent', and 'pruning-interval'
+time: the states of the last 'pruning-keep-time' are kept, and at least the last 'pruning-keep-recent' states; pruning at 'pruning-interval' block intervals
+size: the oldest states are deleted while the application database is larger than 'pruning-max-size-mb', keeping at least the last 'pruning-keep-recent' states; pruning at 'pruning-interval' block intervals
+
+The heights given with 'pruning-pinned-heights' in app.toml, e.g. upgrade heights, are never pruned. As states are
+pruned in order, pruning stops below the earliest pinned height which isn't pruned yet: the states following it are
+kept as well, until it is unpinned.
 
 Node halting configurations exist in the form of two flags: '--halt-height' and '--halt-time'. During
 the ABCI Commit phase, the node will check if the current block height is greater than or equal to
@@ -197,9 +209,11 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
 	cmd.Flags().Bool(FlagTrace, false, "Provide full stack traces for errors in ABCI Log")
-	cmd.Flags().String(FlagPruning, pruningtypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
-	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
-	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
+	cmd.Flags().String(FlagPruning, pruningtypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom|time|size)")
+	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom', 'time' or 'size')")
+	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom', 'time' or 'size')")
+	cmd.Flags().Duration(FlagPruningKeepTime, 0, "Duration of the recent heights to keep on disk, e.g. 720h (ignored if pruning is not 'time')")
+	cmd.Flags().Uint64(FlagPruningMaxSizeMB, 0, "Size in MB of the application database above which the oldest heights are pruned (ignored if pruning is not 'size')")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune CometBFT blocks")
 	cmd.Flags().Bool(FlagAPIEnable, false, "Define if the API server should be enabled")
@@ -216,7 +230,10 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Bool(flagGRPCWebEnable, true, "Define if the gRPC-Web server should be enabled. (Note: gRPC must also be enabled)")
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().Uint32(FlagStateSyncSnapshotMaxDeltas, 0, "Maximum number of delta snapshots taken on top of a full snapshot")
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
+	cmd.Flags().Int(FlagCommitConcurrency, 1, "Maximum number of stores committed concurrently (sequential if lower than 2)")
+	cmd.Flags().Int(FlagParallelTxWorkers, 0, "Number of goroutines executing the transactions of a block in parallel (sequential if lower than 2)")
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
 	cmd.Flags().Duration(FlagShutdownGrace, 0*time.Second, "On Shutdown, duration to wait for resource clean up")
 
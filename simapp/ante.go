@@ -40,11 +40,11 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
-		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewSetPubKeyDecorator(options.AccountKeeper).WithAccountsModKeeper(options.AccountsModKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
-		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
-		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
-		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer).WithAccountsModKeeper(options.AccountsModKeeper),
+		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler).WithAccountsModKeeper(options.AccountsModKeeper),
+		ante.NewIncrementSequenceDecorator(options.AccountKeeper).WithAccountsModKeeper(options.AccountsModKeeper),
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
@@ -0,0 +1,137 @@
+package simapp
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// TestParallelTxExecutionDeterminism executes blocks of bank transactions,
+// with conflicting and failing ones, on a SimApp executing them sequentially
+// and on one executing them in parallel, and checks that the results and the
+// app hashes are the same.
+func TestParallelTxExecutionDeterminism(t *testing.T) {
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	const numAccounts = 20
+	privs := make([]cryptotypes.PrivKey, numAccounts)
+	genAccs := make([]authtypes.GenesisAccount, numAccounts)
+	balances := make([]banktypes.Balance, numAccounts)
+	for i := range privs {
+		privs[i] = secp256k1.GenPrivKey()
+		addr := sdk.AccAddress(privs[i].PubKey().Address())
+		genAccs[i] = authtypes.NewBaseAccount(addr, privs[i].PubKey(), uint64(i), 0)
+		balances[i] = banktypes.Balance{
+			Address: addr.String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100000000000000))),
+		}
+	}
+
+	newApp := func(opts ...func(*baseapp.BaseApp)) *SimApp {
+		appOptions := simtestutil.NewAppOptionsWithFlagHome(t.TempDir())
+		app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOptions, append(opts, baseapp.SetChainID(SimAppChainID))...)
+
+		genesisState, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), app.DefaultGenesis(), valSet, genAccs, balances...)
+		require.NoError(t, err)
+		stateBytes, err := json.MarshalIndent(genesisState, "", " ")
+		require.NoError(t, err)
+
+		_, err = app.InitChain(&abci.RequestInitChain{
+			ChainId:         SimAppChainID,
+			ConsensusParams: simtestutil.DefaultConsensusParams,
+			AppStateBytes:   stateBytes,
+		})
+		require.NoError(t, err)
+
+		return app
+	}
+	sequential := newApp()
+	parallel := newApp(baseapp.SetParallelTxWorkers(4))
+
+	seqs := make([]uint64, numAccounts)
+
+	r := rand.New(rand.NewSource(7))
+	txConfig := sequential.TxConfig()
+	blockTime := time.Now()
+	for height := int64(1); height <= 10; height++ {
+		txs := make([][]byte, 0, 40)
+		for i := 0; i < 40; i++ {
+			sender := r.Intn(numAccounts)
+			from := genAccs[sender].GetAddress()
+
+			// send to the other accounts, or to new ones which are created
+			to := genAccs[r.Intn(numAccounts)].GetAddress()
+			if r.Intn(5) == 0 {
+				to = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			}
+			amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, r.Int63n(1000)+1))
+			if r.Intn(10) == 0 {
+				// fails for insufficient funds
+				amount = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000000000000))
+			}
+
+			var msg sdk.Msg = banktypes.NewMsgSend(from, to, amount)
+			if r.Intn(10) == 0 {
+				msg = banktypes.NewMsgMultiSend(
+					banktypes.NewInput(from, amount.Add(amount...)),
+					[]banktypes.Output{banktypes.NewOutput(to, amount), banktypes.NewOutput(genAccs[r.Intn(numAccounts)].GetAddress(), amount)},
+				)
+			}
+
+			// some transactions pay fees, which conflict with each other
+			var fees sdk.Coins
+			if r.Intn(4) == 0 {
+				fees = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, r.Int63n(100)+1))
+			}
+
+			tx, err := simtestutil.GenSignedMockTx(r, txConfig, []sdk.Msg{msg}, fees, simtestutil.DefaultGenTxGas,
+				SimAppChainID, []uint64{uint64(sender)}, []uint64{seqs[sender]}, privs[sender])
+			require.NoError(t, err)
+			txBytes, err := txConfig.TxEncoder()(tx)
+			require.NoError(t, err)
+
+			txs = append(txs, txBytes)
+			seqs[sender]++
+		}
+
+		blockTime = blockTime.Add(5 * time.Second)
+		req := &abci.RequestFinalizeBlock{
+			Height:             height,
+			Time:               blockTime,
+			Txs:                txs,
+			NextValidatorsHash: valSet.Hash(),
+		}
+		expected, err := sequential.FinalizeBlock(req)
+		require.NoError(t, err)
+		res, err := parallel.FinalizeBlock(req)
+		require.NoError(t, err)
+		require.Equal(t, expected, res)
+
+		_, err = sequential.Commit()
+		require.NoError(t, err)
+		_, err = parallel.Commit()
+		require.NoError(t, err)
+		require.Equal(t, sequential.LastCommitID(), parallel.LastCommitID())
+	}
+}
@@ -63,6 +63,92 @@ func BenchmarkDeepCacheStack13(b *testing.B) {
 	DoBenchmarkDeepCacheStack(b, 13)
 }
 
+// DoBenchmarkCacheWrap benchmarks branching a store holding nItems cached
+// writes, and writing to the branch, which copies the B-tree nodes on the path
+// of the written key.
+func DoBenchmarkCacheWrap(b *testing.B, nItems int) {
+	b.Helper()
+	store := cachekv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()})
+	for i := 0; i < nItems; i++ {
+		store.Set([]byte(fmt.Sprintf("hello%08d", i)), []byte{0})
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		branch := store.CacheWrap().(types.CacheKVStore)
+		branch.Set([]byte("hello"), []byte{1})
+	}
+}
+
+func BenchmarkCacheWrap100(b *testing.B) {
+	DoBenchmarkCacheWrap(b, 100)
+}
+
+func BenchmarkCacheWrap10000(b *testing.B) {
+	DoBenchmarkCacheWrap(b, 10000)
+}
+
+// DoBenchmarkNestedGet benchmarks reading a key written to the bottom store of
+// a stack of depth nested branches.
+func DoBenchmarkNestedGet(b *testing.B, depth int) {
+	b.Helper()
+	var stack CacheStack
+	stack.Reset(cachekv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()}))
+	stack.CurrentStore().Set([]byte("hello"), []byte{0})
+	for i := 0; i < depth; i++ {
+		stack.Snapshot()
+		stack.CurrentStore().Set([]byte(fmt.Sprintf("hello%03d", i)), []byte{byte(i)})
+	}
+
+	store := stack.CurrentStore()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		require.Equal(b, []byte{0}, store.Get([]byte("hello")))
+	}
+}
+
+func BenchmarkNestedGet1(b *testing.B) {
+	DoBenchmarkNestedGet(b, 1)
+}
+
+func BenchmarkNestedGet100(b *testing.B) {
+	DoBenchmarkNestedGet(b, 100)
+}
+
+// DoBenchmarkNestedIteration benchmarks iterating over a stack of depth nested
+// branches, each one writing a key.
+func DoBenchmarkNestedIteration(b *testing.B, depth int) {
+	b.Helper()
+	var stack CacheStack
+	stack.Reset(cachekv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()}))
+	for i := 0; i < depth; i++ {
+		stack.Snapshot()
+		stack.CurrentStore().Set([]byte(fmt.Sprintf("hello%03d", i)), []byte{byte(i)})
+	}
+
+	store := stack.CurrentStore()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		it := store.Iterator(nil, nil)
+		n := 0
+		for ; it.Valid(); it.Next() {
+			n++
+		}
+		it.Close()
+		require.Equal(b, depth, n)
+	}
+}
+
+func BenchmarkNestedIteration1(b *testing.B) {
+	DoBenchmarkNestedIteration(b, 1)
+}
+
+func BenchmarkNestedIteration100(b *testing.B) {
+	DoBenchmarkNestedIteration(b, 100)
+}
+
 // CacheStack manages a stack of nested cache store to
 // support the evm `StateDB`'s `Snapshot` and `RevertToSnapshot` methods.
 type CacheStack struct {
@@ -48,6 +48,13 @@ func (bt BTree) Get(key []byte) []byte {
 	return i.value
 }
 
+// Lookup returns the value of a key, and whether the key is in the tree, its
+// value being possibly nil.
+func (bt BTree) Lookup(key []byte) ([]byte, bool) {
+	i, found := bt.tree.Get(newItem(key, nil))
+	return i.value, found
+}
+
 func (bt BTree) Delete(key []byte) {
 	bt.tree.Delete(newItem(key, nil))
 }
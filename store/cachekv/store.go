@@ -1,52 +1,80 @@
 package cachekv
 
 import (
-	"bytes"
 	"io"
 	"sort"
 	"sync"
+	"sync/atomic"
 
-	dbm "github.com/cosmos/cosmos-db"
-
-	"cosmossdk.io/math"
 	"cosmossdk.io/store/cachekv/internal"
 	"cosmossdk.io/store/internal/conv"
-	"cosmossdk.io/store/internal/kv"
 	"cosmossdk.io/store/tracekv"
 	"cosmossdk.io/store/types"
 )
 
-// cValue represents a cached value.
-// If dirty is true, it indicates the cached value is different from the underlying value.
-type cValue struct {
-	value []byte
-	dirty bool
-}
-
 // Store wraps an in-memory cache around an underlying types.KVStore.
+//
+// The cache is a copy-on-write B-tree of the values written to the store and
+// of the values read from the underlying store. A Store branched from another
+// Store, e.g. with CacheWrap, starts with a copy of the cache of its parent,
+// made in constant time, and reads from the first underlying store which isn't
+// a Store, called the base: however deep the branches are nested, a read is a
+// single cache lookup and an iterator merges a single cache with the base.
+//
+// A branch keeps seeing the writes made to its ancestors after it is created:
+// each Store has a generation incremented when its writes change, and a branch
+// whose ancestors changed rebuilds its cache from the cache of its parent
+// before being accessed, applying its own writes on top of it.
 type Store struct {
-	mtx           sync.Mutex
-	cache         map[string]*cValue
-	unsortedCache map[string]struct{}
-	sortedCache   internal.BTree // always ascending sorted
-	parent        types.KVStore
+	mtx sync.Mutex
+	// cache holds the cached values of the store and of its ancestors, a nil
+	// value meaning the key is deleted or doesn't exist.
+	cache internal.BTree
+	// dirty is the set of keys written to the store, directly or by writing
+	// one of its branches, since the store was last written.
+	dirty map[string]struct{}
+	// gen is the generation of the store, incremented when its writes change.
+	gen atomic.Uint64
+
+	// parent is the Store the store is branched from, nil if the store
+	// directly wraps the base.
+	parent *Store
+	// parentGens are the generations of the ancestors of the store, parent
+	// first, when the cache was last rebuilt from the cache of the parent.
+	parentGens []uint64
+	// base is the first underlying store which isn't a Store.
+	base types.KVStore
 }
 
 var _ types.CacheKVStore = (*Store)(nil)
 
-// NewStore creates a new Store object
+// NewStore creates a new Store object. If parent is a Store, the new store is
+// a copy-on-write branch of it.
 func NewStore(parent types.KVStore) *Store {
-	return &Store{
-		cache:         make(map[string]*cValue),
-		unsortedCache: make(map[string]struct{}),
-		sortedCache:   internal.NewBTree(),
-		parent:        parent,
+	store := &Store{dirty: make(map[string]struct{})}
+
+	p, ok := parent.(*Store)
+	if !ok {
+		store.cache = internal.NewBTree()
+		store.base = parent
+		return store
 	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.sync()
+	store.cache = p.cache.Copy()
+	store.parent = p
+	store.parentGens = p.gens()
+	store.base = p.base
+
+	return store
 }
 
 // GetStoreType implements Store.
 func (store *Store) GetStoreType() types.StoreType {
-	return store.parent.GetStoreType()
+	return store.base.GetStoreType()
 }
 
 // Get implements types.KVStore.
@@ -56,12 +84,11 @@ func (store *Store) Get(key []byte) (value []byte) {
 
 	types.AssertValidKey(key)
 
-	cacheValue, ok := store.cache[conv.UnsafeBytesToStr(key)]
+	store.sync()
+	value, ok := store.cache.Lookup(key)
 	if !ok {
-		value = store.parent.Get(key)
-		store.setCacheValue(key, value, false)
-	} else {
-		value = cacheValue.value
+		value = store.base.Get(key)
+		store.cache.Set(key, value)
 	}
 
 	return value
@@ -74,7 +101,7 @@ func (store *Store) Set(key, value []byte) {
 
 	store.mtx.Lock()
 	defer store.mtx.Unlock()
-	store.setCacheValue(key, value, true)
+	store.setDirtyValue(key, value)
 }
 
 // Has implements types.KVStore.
@@ -90,75 +117,50 @@ func (store *Store) Delete(key []byte) {
 	store.mtx.Lock()
 	defer store.mtx.Unlock()
 
-	store.setCacheValue(key, nil, true)
-}
-
-func (store *Store) resetCaches() {
-	if len(store.cache) > 100_000 {
-		// Cache is too large. We likely did something linear time
-		// (e.g. Epoch block, Genesis block, etc). Free the old caches from memory, and let them get re-allocated.
-		// TODO: In a future CacheKV redesign, such linear workloads should get into a different cache instantiation.
-		// 100_000 is arbitrarily chosen as it solved Osmosis' InitGenesis RAM problem.
-		store.cache = make(map[string]*cValue)
-		store.unsortedCache = make(map[string]struct{})
-	} else {
-		// Clear the cache using the map clearing idiom
-		// and not allocating fresh objects.
-		// Please see https://bencher.orijtech.com/perfclinic/mapclearing/
-		for key := range store.cache {
-			delete(store.cache, key)
-		}
-		for key := range store.unsortedCache {
-			delete(store.unsortedCache, key)
-		}
-	}
-	store.sortedCache = internal.NewBTree()
+	store.setDirtyValue(key, nil)
 }
 
-// Implements Cachetypes.KVStore.
+// Write implements Cachetypes.KVStore. A branch writes its dirty values to its
+// parent, in constant time if the parent didn't change since the branch was
+// created or rebuilt, while the other stores write them to the base.
 func (store *Store) Write() {
 	store.mtx.Lock()
 	defer store.mtx.Unlock()
 
-	if len(store.cache) == 0 && len(store.unsortedCache) == 0 {
-		store.sortedCache = internal.NewBTree()
+	if len(store.dirty) == 0 {
 		return
 	}
 
-	type cEntry struct {
-		key string
-		val *cValue
+	if store.parent != nil {
+		store.writeParent()
+		return
 	}
 
-	// We need a copy of all of the keys.
-	// Not the best. To reduce RAM pressure, we copy the values as well
-	// and clear out the old caches right after the copy.
-	sortedCache := make([]cEntry, 0, len(store.cache))
-
-	for key, dbValue := range store.cache {
-		if dbValue.dirty {
-			sortedCache = append(sortedCache, cEntry{key, dbValue})
-		}
+	keys := make([]string, 0, len(store.dirty))
+	for key := range store.dirty {
+		keys = append(keys, key)
 	}
-	store.resetCaches()
-	sort.Slice(sortedCache, func(i, j int) bool {
-		return sortedCache[i].key < sortedCache[j].key
-	})
+	sort.Strings(keys)
 
 	// TODO: Consider allowing usage of Batch, which would allow the write to
 	// at least happen atomically.
-	for _, obj := range sortedCache {
+	for _, key := range keys {
 		// We use []byte(key) instead of conv.UnsafeStrToBytes because we cannot
 		// be sure if the underlying store might do a save with the byteslice or
 		// not. Once we get confirmation that .Delete is guaranteed not to
 		// save the byteslice, then we can assume only a read-only copy is sufficient.
-		if obj.val.value != nil {
+		if value := store.cache.Get(conv.UnsafeStrToBytes(key)); value != nil {
 			// It already exists in the parent, hence update it.
-			store.parent.Set([]byte(obj.key), obj.val.value)
+			store.base.Set([]byte(key), value)
 		} else {
-			store.parent.Delete([]byte(obj.key))
+			store.base.Delete([]byte(key))
 		}
 	}
+
+	// The written values are now in the base, the cache is dropped to free
+	// the memory of large writes, e.g. at genesis.
+	store.cache = internal.NewBTree()
+	store.dirty = make(map[string]struct{})
 }
 
 // CacheWrap implements CacheWrapper.
@@ -188,8 +190,8 @@ func (store *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 	store.mtx.Lock()
 	defer store.mtx.Unlock()
 
-	store.dirtyItems(start, end)
-	isoSortedCache := store.sortedCache.Copy()
+	store.sync()
+	isoCache := store.cache.Copy()
 
 	var (
 		err           error
@@ -197,11 +199,11 @@ func (store *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 	)
 
 	if ascending {
-		parent = store.parent.Iterator(start, end)
-		cache, err = isoSortedCache.Iterator(start, end)
+		parent = store.base.Iterator(start, end)
+		cache, err = isoCache.Iterator(start, end)
 	} else {
-		parent = store.parent.ReverseIterator(start, end)
-		cache, err = isoSortedCache.ReverseIterator(start, end)
+		parent = store.base.ReverseIterator(start, end)
+		cache, err = isoCache.ReverseIterator(start, end)
 	}
 	if err != nil {
 		panic(err)
@@ -210,199 +212,91 @@ func (store *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 	return internal.NewCacheMergeIterator(parent, cache, ascending)
 }
 
-func findStartIndex(strL []string, startQ string) int {
-	// Modified binary search to find the very first element in >=startQ.
-	if len(strL) == 0 {
-		return -1
-	}
+//----------------------------------------
+// etc
 
-	var left, right, mid int
-	right = len(strL) - 1
-	for left <= right {
-		mid = (left + right) >> 1
-		midStr := strL[mid]
-		if midStr == startQ {
-			// Handle condition where there might be multiple values equal to startQ.
-			// We are looking for the very first value < midStL, that i+1 will be the first
-			// element >= midStr.
-			for i := mid - 1; i >= 0; i-- {
-				if strL[i] != midStr {
-					return i + 1
-				}
-			}
-			return 0
-		}
-		if midStr < startQ {
-			left = mid + 1
-		} else { // midStrL > startQ
-			right = mid - 1
-		}
-	}
-	if left >= 0 && left < len(strL) && strL[left] >= startQ {
-		return left
-	}
-	return -1
-}
+// setDirtyValue sets a value written to the store, a nil value meaning a
+// deletion.
+func (store *Store) setDirtyValue(key, value []byte) {
+	store.sync()
 
-func findEndIndex(strL []string, endQ string) int {
-	if len(strL) == 0 {
-		return -1
-	}
+	keyStr := string(key)
+	store.cache.Set(conv.UnsafeStrToBytes(keyStr), value)
+	store.dirty[keyStr] = struct{}{}
+	store.gen.Add(1)
+}
 
-	// Modified binary search to find the very first element <endQ.
-	var left, right, mid int
-	right = len(strL) - 1
-	for left <= right {
-		mid = (left + right) >> 1
-		midStr := strL[mid]
-		if midStr == endQ {
-			// Handle condition where there might be multiple values equal to startQ.
-			// We are looking for the very first value < midStL, that i+1 will be the first
-			// element >= midStr.
-			for i := mid - 1; i >= 0; i-- {
-				if strL[i] < midStr {
-					return i + 1
-				}
-			}
-			return 0
-		}
-		if midStr < endQ {
-			left = mid + 1
-		} else { // midStrL > startQ
-			right = mid - 1
+// writeParent writes the dirty values of the branch to its parent, and leaves
+// the branch with the same content as its parent.
+func (store *Store) writeParent() {
+	parent := store.parent
+	parent.mtx.Lock()
+	defer parent.mtx.Unlock()
+
+	if parent.gen.Load() == store.parentGens[0] {
+		// The writes of the parent didn't change since the cache of the branch
+		// was built from its cache, so the cache of the branch is the cache of
+		// the parent with the dirty values of the branch.
+		parent.cache = store.cache.Copy()
+	} else {
+		for key := range store.dirty {
+			keyBz := conv.UnsafeStrToBytes(key)
+			parent.cache.Set(keyBz, store.cache.Get(keyBz))
 		}
 	}
-
-	// Binary search failed, now let's find a value less than endQ.
-	for i := right; i >= 0; i-- {
-		if strL[i] < endQ {
-			return i
-		}
+	for key := range store.dirty {
+		parent.dirty[key] = struct{}{}
 	}
+	parent.gen.Add(1)
 
-	return -1
+	store.cache = parent.cache.Copy()
+	store.parentGens = parent.gens()
+	store.dirty = make(map[string]struct{})
 }
 
-type sortState int
-
-const (
-	stateUnsorted sortState = iota
-	stateAlreadySorted
-)
-
-const minSortSize = 1024
-
-// Constructs a slice of dirty items, to use w/ memIterator.
-func (store *Store) dirtyItems(start, end []byte) {
-	startStr, endStr := conv.UnsafeBytesToStr(start), conv.UnsafeBytesToStr(end)
-	if end != nil && startStr > endStr {
-		// Nothing to do here.
+// sync rebuilds the cache of the branch from the cache of its parent if the
+// writes of one of its ancestors changed since it was last built, applying the
+// dirty values of the branch on top of it. The mutex of the store must be held.
+func (store *Store) sync() {
+	if !store.stale() {
 		return
 	}
 
-	n := len(store.unsortedCache)
-	unsorted := make([]*kv.Pair, 0)
-	// If the unsortedCache is too big, its costs too much to determine
-	// whats in the subset we are concerned about.
-	// If you are interleaving iterator calls with writes, this can easily become an
-	// O(N^2) overhead.
-	// Even without that, too many range checks eventually becomes more expensive
-	// than just not having the cache.
-	if n < minSortSize {
-		for key := range store.unsortedCache {
-			// dbm.IsKeyInDomain is nil safe and returns true iff key is greater than start
-			if dbm.IsKeyInDomain(conv.UnsafeStrToBytes(key), start, end) {
-				cacheValue := store.cache[key]
-				unsorted = append(unsorted, &kv.Pair{Key: []byte(key), Value: cacheValue.value})
-			}
-		}
-		store.clearUnsortedCacheSubset(unsorted, stateUnsorted)
-		return
-	}
-
-	// Otherwise it is large so perform a modified binary search to find
-	// the target ranges for the keys that we should be looking for.
-	strL := make([]string, 0, n)
-	for key := range store.unsortedCache {
-		strL = append(strL, key)
-	}
-	sort.Strings(strL)
-
-	// Now find the values within the domain
-	//  [start, end)
-	startIndex := findStartIndex(strL, startStr)
-	if startIndex < 0 {
-		startIndex = 0
-	}
-
-	var endIndex int
-	if end == nil {
-		endIndex = len(strL) - 1
-	} else {
-		endIndex = findEndIndex(strL, endStr)
-	}
-	if endIndex < 0 {
-		endIndex = len(strL) - 1
-	}
-
-	// Since we spent cycles to sort the values, we should process and remove a reasonable amount
-	// ensure start to end is at least minSortSize in size
-	// if below minSortSize, expand it to cover additional values
-	// this amortizes the cost of processing elements across multiple calls
-	if endIndex-startIndex < minSortSize {
-		endIndex = math.Min(startIndex+minSortSize, len(strL)-1)
-		if endIndex-startIndex < minSortSize {
-			startIndex = math.Max(endIndex-minSortSize, 0)
-		}
-	}
+	parent := store.parent
+	parent.mtx.Lock()
+	parent.sync()
+	cache := parent.cache.Copy()
+	parentGens := parent.gens()
+	parent.mtx.Unlock()
 
-	kvL := make([]*kv.Pair, 0, 1+endIndex-startIndex)
-	for i := startIndex; i <= endIndex; i++ {
-		key := strL[i]
-		cacheValue := store.cache[key]
-		kvL = append(kvL, &kv.Pair{Key: []byte(key), Value: cacheValue.value})
+	for key := range store.dirty {
+		keyBz := conv.UnsafeStrToBytes(key)
+		cache.Set(keyBz, store.cache.Get(keyBz))
 	}
 
-	// kvL was already sorted so pass it in as is.
-	store.clearUnsortedCacheSubset(kvL, stateAlreadySorted)
+	store.cache = cache
+	store.parentGens = parentGens
+	// the content of the store changed for its own branches
+	store.gen.Add(1)
 }
 
-func (store *Store) clearUnsortedCacheSubset(unsorted []*kv.Pair, sortState sortState) {
-	n := len(store.unsortedCache)
-	if len(unsorted) == n { // This pattern allows the Go compiler to emit the map clearing idiom for the entire map.
-		for key := range store.unsortedCache {
-			delete(store.unsortedCache, key)
-		}
-	} else { // Otherwise, normally delete the unsorted keys from the map.
-		for _, kv := range unsorted {
-			delete(store.unsortedCache, conv.UnsafeBytesToStr(kv.Key))
+// stale returns true if the writes of an ancestor of the store changed since
+// its cache was last built.
+func (store *Store) stale() bool {
+	i := 0
+	for ancestor := store.parent; ancestor != nil; ancestor = ancestor.parent {
+		if ancestor.gen.Load() != store.parentGens[i] {
+			return true
 		}
+		i++
 	}
 
-	if sortState == stateUnsorted {
-		sort.Slice(unsorted, func(i, j int) bool {
-			return bytes.Compare(unsorted[i].Key, unsorted[j].Key) < 0
-		})
-	}
-
-	for _, item := range unsorted {
-		// sortedCache is able to store `nil` value to represent deleted items.
-		store.sortedCache.Set(item.Key, item.Value)
-	}
+	return false
 }
 
-//----------------------------------------
-// etc
-
-// Only entrypoint to mutate store.cache.
-// A `nil` value means a deletion.
-func (store *Store) setCacheValue(key, value []byte, dirty bool) {
-	keyStr := conv.UnsafeBytesToStr(key)
-	store.cache[keyStr] = &cValue{
-		value: value,
-		dirty: dirty,
-	}
-	if dirty {
-		store.unsortedCache[keyStr] = struct{}{}
-	}
+// gens returns the generations of the store and of its ancestors.
+func (store *Store) gens() []uint64 {
+	gens := make([]uint64, 0, len(store.parentGens)+1)
+	gens = append(gens, store.gen.Load())
+	return append(gens, store.parentGens...)
 }
@@ -101,6 +101,89 @@ func TestCacheKVStoreNested(t *testing.T) {
 	require.Equal(t, valFmt(3), mem.Get(keyFmt(1)))
 }
 
+func TestCacheKVStoreBranches(t *testing.T) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	mem.Set(keyFmt(0), valFmt(0))
+	st := cachekv.NewStore(mem)
+	st.Set(keyFmt(1), valFmt(1))
+
+	st2 := cachekv.NewStore(st)
+	st3 := cachekv.NewStore(st2)
+	st3.Set(keyFmt(3), valFmt(3))
+
+	// the writes made to the ancestors after branching are visible to the branches
+	st.Set(keyFmt(2), valFmt(2))
+	st.Delete(keyFmt(0))
+	require.Equal(t, valFmt(2), st2.Get(keyFmt(2)))
+	require.Equal(t, valFmt(2), st3.Get(keyFmt(2)))
+	require.Nil(t, st3.Get(keyFmt(0)))
+	require.Equal(t, valFmt(3), st3.Get(keyFmt(3)))
+	require.Nil(t, st2.Get(keyFmt(3)))
+
+	// the branch writes take precedence over the writes made to the ancestors
+	st.Set(keyFmt(3), valFmt(4))
+	require.Equal(t, valFmt(3), st3.Get(keyFmt(3)))
+	require.Equal(t, valFmt(4), st2.Get(keyFmt(3)))
+	requireKeys(t, st3, 1, 2, 3)
+	requireKeys(t, st2, 1, 2, 3)
+
+	// a sibling branch written to the parent is visible to the other branches
+	sibling := cachekv.NewStore(st2)
+	sibling.Set(keyFmt(5), valFmt(5))
+	sibling.Write()
+	require.Equal(t, valFmt(5), st2.Get(keyFmt(5)))
+	require.Equal(t, valFmt(5), st3.Get(keyFmt(5)))
+
+	// the writes propagate down to mem
+	st3.Write()
+	require.Equal(t, valFmt(3), st2.Get(keyFmt(3)))
+	require.Equal(t, valFmt(4), st.Get(keyFmt(3)))
+	st2.Write()
+	require.Equal(t, valFmt(3), st.Get(keyFmt(3)))
+	require.Nil(t, mem.Get(keyFmt(3)))
+	st.Write()
+	require.Nil(t, mem.Get(keyFmt(0)))
+	for _, i := range []int{1, 2, 3, 5} {
+		require.Equal(t, valFmt(i), mem.Get(keyFmt(i)), i)
+	}
+	require.Equal(t, valFmt(3), st3.Get(keyFmt(3)))
+
+	// a discarded branch doesn't change its parent
+	discarded := cachekv.NewStore(st)
+	discarded.Set(keyFmt(6), valFmt(6))
+	discarded.Delete(keyFmt(1))
+	require.Nil(t, st.Get(keyFmt(6)))
+	require.Equal(t, valFmt(1), st.Get(keyFmt(1)))
+	requireKeys(t, st, 1, 2, 3, 5)
+	requireKeys(t, discarded, 2, 3, 5, 6)
+}
+
+// requireKeys requires the keys of a store to be the given ones, iterating in
+// both directions.
+func requireKeys(t *testing.T, st types.KVStore, keys ...int) {
+	t.Helper()
+	var actual, reversed []int
+	itr := st.Iterator(nil, nil)
+	for ; itr.Valid(); itr.Next() {
+		actual = append(actual, keyIndex(t, itr.Key()))
+	}
+	require.NoError(t, itr.Close())
+	itr = st.ReverseIterator(nil, nil)
+	for ; itr.Valid(); itr.Next() {
+		reversed = append([]int{keyIndex(t, itr.Key())}, reversed...)
+	}
+	require.NoError(t, itr.Close())
+	require.Equal(t, keys, actual)
+	require.Equal(t, keys, reversed)
+}
+
+func keyIndex(t *testing.T, key []byte) (i int) {
+	t.Helper()
+	_, err := fmt.Sscanf(string(key), "key%d", &i)
+	require.NoError(t, err)
+	return i
+}
+
 func TestCacheKVIteratorBounds(t *testing.T) {
 	st := newCacheKVStore()
 
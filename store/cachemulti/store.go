@@ -142,6 +142,18 @@ func (cms Store) CacheMultiStore() types.CacheMultiStore {
 	return newCacheMultiStoreFromCMS(cms)
 }
 
+// CacheMultiStoreWithWrapper branches the store like CacheMultiStore, but the
+// branch of each underlying store wraps the store returned by wrap instead of
+// the underlying store itself, e.g. to observe the operations of the branch.
+func (cms Store) CacheMultiStoreWithWrapper(wrap func(types.StoreKey, types.KVStore) types.KVStore) types.CacheMultiStore {
+	stores := make(map[types.StoreKey]types.CacheWrapper, len(cms.stores))
+	for k, v := range cms.stores {
+		stores[k] = wrap(k, v.(types.KVStore))
+	}
+
+	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext)
+}
+
 // CacheMultiStoreWithVersion implements the MultiStore interface. It will panic
 // as an already cached multi-store cannot load previous versions.
 //
@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"testing"
 
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/prefix"
 	"cosmossdk.io/store/types"
 )
 
@@ -22,3 +25,25 @@ func TestStoreGetKVStore(t *testing.T) {
 	require.PanicsWithValue(errMsg,
 		func() { s.GetKVStore(key) })
 }
+
+func TestStoreCacheMultiStoreWithWrapper(t *testing.T) {
+	key := types.NewKVStoreKey("store")
+	parent := NewStore(dbm.NewMemDB(), map[types.StoreKey]types.CacheWrapper{
+		key: dbadapter.Store{DB: dbm.NewMemDB()},
+	}, nil, nil, nil)
+
+	var wrapped []types.StoreKey
+	branch := parent.CacheMultiStoreWithWrapper(func(k types.StoreKey, store types.KVStore) types.KVStore {
+		wrapped = append(wrapped, k)
+		return prefix.NewStore(store, []byte("prefix/"))
+	})
+	require.Equal(t, []types.StoreKey{key}, wrapped)
+
+	parent.GetKVStore(key).Set([]byte("prefix/a"), []byte("1"))
+	require.Equal(t, []byte("1"), branch.GetKVStore(key).Get([]byte("a")))
+
+	branch.GetKVStore(key).Set([]byte("b"), []byte("2"))
+	require.Nil(t, parent.GetKVStore(key).Get([]byte("prefix/b")))
+	branch.Write()
+	require.Equal(t, []byte("2"), parent.GetKVStore(key).Get([]byte("prefix/b")))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 	"sync"
+	"time"
 
 	dbm "github.com/cosmos/cosmos-db"
 
@@ -27,8 +28,21 @@ type Manager struct {
 	// These are the heights that are multiples of snapshotInterval and kept for state sync snapshots.
 	// The heights are added to be pruned when a snapshot is complete.
 	pruneSnapshotHeights []int64
+	// prunedHeight is the height up to which the heights were last pruned.
+	prunedHeight int64
+	// blockTime and dbSize provide the block times of the heights and the size
+	// of the database, to the time and size strategies.
+	blockTime BlockTimeFunc
+	dbSize    SizeFunc
 }
 
+// BlockTimeFunc returns the block time of a committed height, or the zero time
+// if it is unknown.
+type BlockTimeFunc func(height int64) (time.Time, error)
+
+// SizeFunc returns the size of the database in bytes.
+type SizeFunc func() (uint64, error)
+
 // NegativeHeightsError is returned when a negative height is provided to the manager.
 type NegativeHeightsError struct {
 	Height int64
@@ -40,7 +54,10 @@ func (e *NegativeHeightsError) Error() string {
 	return fmt.Sprintf("failed to get pruned heights: %d", e.Height)
 }
 
-var pruneSnapshotHeightsKey = []byte("s/prunesnapshotheights")
+var (
+	pruneSnapshotHeightsKey = []byte("s/prunesnapshotheights")
+	prunedHeightKey         = []byte("s/prunedheight")
+)
 
 // NewManager returns a new Manager with the given db and logger.
 // The retuned manager uses a pruning strategy of "nothing" which
@@ -94,6 +111,18 @@ func (m *Manager) HandleSnapshotHeight(height int64) {
 	}
 }
 
+// SetBlockTimeFunc sets the function returning the block time of the heights,
+// required by the time strategy.
+func (m *Manager) SetBlockTimeFunc(blockTime BlockTimeFunc) {
+	m.blockTime = blockTime
+}
+
+// SetSizeFunc sets the function returning the size of the database, required
+// by the size strategy.
+func (m *Manager) SetSizeFunc(dbSize SizeFunc) {
+	m.dbSize = dbSize
+}
+
 // SetSnapshotInterval sets the interval at which the snapshots are taken.
 func (m *Manager) SetSnapshotInterval(snapshotInterval uint64) {
 	m.snapshotInterval = snapshotInterval
@@ -101,6 +130,25 @@ func (m *Manager) SetSnapshotInterval(snapshotInterval uint64) {
 
 // GetPruningHeight returns the height which can prune upto if it is able to prune at the given height.
 func (m *Manager) GetPruningHeight(height int64) int64 {
+	pruneHeight := m.getPruningHeight(height)
+	if pruneHeight <= 0 {
+		return 0
+	}
+
+	if pruneHeight > m.prunedHeight {
+		m.prunedHeight = pruneHeight
+		// flush the pruned height to disk so that the size strategy resumes from
+		// it after a restart. If it fails, the strategies resume from an earlier
+		// height, which only delays pruning.
+		if err := m.db.SetSync(prunedHeightKey, int64SliceToBytes([]int64{pruneHeight})); err != nil {
+			m.logger.Error("failed to persist the pruned height", "height", pruneHeight, "err", err)
+		}
+	}
+
+	return pruneHeight
+}
+
+func (m *Manager) getPruningHeight(height int64) int64 {
 	if m.opts.GetPruningStrategy() == types.PruningNothing {
 		return 0
 	}
@@ -112,9 +160,27 @@ func (m *Manager) GetPruningHeight(height int64) int64 {
 		return 0
 	}
 
-	// Consider the snapshot height
 	pruneHeight := height - 1 - int64(m.opts.KeepRecent) // we should keep the current height at least
 
+	switch m.opts.GetPruningStrategy() {
+	case types.PruningTime:
+		pruneHeight = m.timePruningHeight(height, pruneHeight)
+	case types.PruningSize:
+		pruneHeight = m.sizePruningHeight(pruneHeight)
+	}
+
+	// Consider the pinned heights, the heights are pruned up to the earliest one
+	// which isn't pruned yet.
+	for _, pinned := range m.opts.PinnedHeights {
+		if pinned > m.prunedHeight && pinned <= pruneHeight {
+			pruneHeight = pinned - 1
+		}
+	}
+	if pruneHeight <= 0 {
+		return 0
+	}
+
+	// Consider the snapshot height
 	m.pruneSnapshotHeightsMx.RLock()
 	defer m.pruneSnapshotHeightsMx.RUnlock()
 
@@ -136,12 +202,84 @@ func (m *Manager) GetPruningHeight(height int64) int64 {
 	return pruneHeight
 }
 
-// LoadSnapshotHeights loads the snapshot heights from the database as a crash recovery.
+// timePruningHeight returns the latest height up to maxHeight whose block time is
+// older than the keep duration, relative to the block time of the given height.
+// The block times are assumed to be increasing with the heights.
+func (m *Manager) timePruningHeight(height, maxHeight int64) int64 {
+	if m.blockTime == nil {
+		m.logger.Error("failed to prune by time, the block times are unknown")
+		return 0
+	}
+
+	latest, err := m.blockTime(height)
+	if err != nil {
+		m.logger.Error("failed to get the block time", "height", height, "err", err)
+		return 0
+	}
+	deadline := latest.Add(-m.opts.KeepDuration)
+
+	// binary search of the latest expired height, the heights up to the pruned
+	// height being expired
+	low, high := m.prunedHeight, maxHeight
+	for low < high {
+		mid := low + (high-low+1)/2
+		blockTime, err := m.blockTime(mid)
+		if err != nil {
+			m.logger.Error("failed to get the block time", "height", mid, "err", err)
+			return 0
+		}
+		if blockTime.Before(deadline) {
+			low = mid
+		} else {
+			high = mid - 1
+		}
+	}
+
+	return low
+}
+
+// sizePruningHeight returns the height up to which the oldest heights, and at
+// most maxHeight, are pruned if the database is larger than the maximum size: a
+// tenth of the heights not pruned yet, and at least an interval of heights. As
+// the size of a database may only decrease once its deleted entries are
+// compacted, the heights are pruned gradually, one interval at a time.
+func (m *Manager) sizePruningHeight(maxHeight int64) int64 {
+	if m.dbSize == nil {
+		m.logger.Error("failed to prune by size, the database size is unknown")
+		return 0
+	}
+
+	size, err := m.dbSize()
+	if err != nil {
+		m.logger.Error("failed to get the database size", "err", err)
+		return 0
+	}
+	if size <= m.opts.MaxBytes {
+		return 0
+	}
+
+	step := (maxHeight - m.prunedHeight) / 10
+	if step < int64(m.opts.Interval) {
+		step = int64(m.opts.Interval)
+	}
+	if pruneHeight := m.prunedHeight + step; pruneHeight < maxHeight {
+		return pruneHeight
+	}
+	return maxHeight
+}
+
+// LoadSnapshotHeights loads the snapshot heights, and the pruned height, from the database as a crash recovery.
 func (m *Manager) LoadSnapshotHeights(db dbm.DB) error {
 	if m.opts.GetPruningStrategy() == types.PruningNothing {
 		return nil
 	}
 
+	prunedHeight, err := loadPrunedHeight(db)
+	if err != nil {
+		return err
+	}
+	m.prunedHeight = prunedHeight
+
 	loadedPruneSnapshotHeights, err := loadPruningSnapshotHeights(db)
 	if err != nil {
 		return err
@@ -180,6 +318,22 @@ func loadPruningSnapshotHeights(db dbm.DB) ([]int64, error) {
 	return pruneSnapshotHeights, nil
 }
 
+func loadPrunedHeight(db dbm.DB) (int64, error) {
+	bz, err := db.Get(prunedHeightKey)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get pruned height: %w", err)
+	}
+	if len(bz) != 8 {
+		return 0, nil
+	}
+
+	h := int64(binary.BigEndian.Uint64(bz))
+	if h < 0 {
+		return 0, &NegativeHeightsError{Height: h}
+	}
+	return h, nil
+}
+
 func int64SliceToBytes(slice []int64) []byte {
 	bz := make([]byte, 0, len(slice)*8)
 	for _, ph := range slice {
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	db "github.com/cosmos/cosmos-db"
 	"github.com/golang/mock/gomock"
@@ -217,6 +218,21 @@ func TestHandleSnapshotHeight_DbErr_Panic(t *testing.T) {
 	manager.HandleSnapshotHeight(10)
 }
 
+func TestGetPruningHeight_DbErr(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	// Setup
+	dbMock := mock.NewMockDB(ctrl)
+
+	dbMock.EXPECT().SetSync(gomock.Any(), gomock.Any()).Return(errors.New(dbErr)).Times(1)
+
+	manager := pruning.NewManager(dbMock, log.NewNopLogger())
+	manager.SetOptions(types.NewCustomPruningOptions(10, 10))
+
+	// failing to persist the pruned height doesn't prevent pruning
+	require.Equal(t, int64(89), manager.GetPruningHeight(100))
+}
+
 func TestHandleSnapshotHeight_LoadFromDisk(t *testing.T) {
 	snapshotInterval := uint64(10)
 
@@ -301,3 +317,108 @@ func TestLoadSnapshotHeights_PruneNothing(t *testing.T) {
 
 	require.Nil(t, manager.LoadSnapshotHeights(db.NewMemDB()))
 }
+
+func TestTimeStrategy(t *testing.T) {
+	// a block every minute, keeping the heights of the last hour
+	genesis := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	manager := pruning.NewManager(db.NewMemDB(), log.NewNopLogger())
+	manager.SetOptions(types.NewTimePruningOptions(time.Hour, 10, 10))
+
+	// the block times are required
+	require.Equal(t, int64(0), manager.GetPruningHeight(100))
+
+	manager.SetBlockTimeFunc(func(height int64) (time.Time, error) {
+		if height <= 0 {
+			return time.Time{}, fmt.Errorf("invalid height %d", height)
+		}
+		return genesis.Add(time.Duration(height) * time.Minute), nil
+	})
+	for height := int64(1); height < 1000; height++ {
+		pruneHeight := manager.GetPruningHeight(height)
+		switch {
+		case height%10 != 0 || height <= 60:
+			require.Equal(t, int64(0), pruneHeight, height)
+		default:
+			// the heights strictly older than one hour are pruned
+			require.Equal(t, height-61, pruneHeight, height)
+		}
+	}
+
+	// keep recent takes precedence over the keep duration
+	manager = pruning.NewManager(db.NewMemDB(), log.NewNopLogger())
+	manager.SetOptions(types.NewTimePruningOptions(time.Minute, 100, 10))
+	manager.SetBlockTimeFunc(func(height int64) (time.Time, error) {
+		return genesis.Add(time.Duration(height) * time.Minute), nil
+	})
+	require.Equal(t, int64(899), manager.GetPruningHeight(1000))
+
+	// the heights without block time are expired
+	manager = pruning.NewManager(db.NewMemDB(), log.NewNopLogger())
+	manager.SetOptions(types.NewTimePruningOptions(time.Hour, 10, 10))
+	manager.SetBlockTimeFunc(func(height int64) (time.Time, error) {
+		if height < 500 {
+			return time.Time{}, nil
+		}
+		return genesis.Add(time.Duration(height) * 2 * time.Hour), nil
+	})
+	require.Equal(t, int64(989), manager.GetPruningHeight(1000))
+}
+
+func TestSizeStrategy(t *testing.T) {
+	manager := pruning.NewManager(db.NewMemDB(), log.NewNopLogger())
+	manager.SetOptions(types.NewSizePruningOptions(1000, 10, 10))
+
+	// the database size is required
+	require.Equal(t, int64(0), manager.GetPruningHeight(100))
+
+	size := uint64(0)
+	manager.SetSizeFunc(func() (uint64, error) { return size, nil })
+
+	// nothing is pruned below the maximum size
+	require.Equal(t, int64(0), manager.GetPruningHeight(100))
+
+	// above the maximum size, a tenth of the heights are pruned, and at least an
+	// interval of heights
+	size = 2000
+	require.Equal(t, int64(99), manager.GetPruningHeight(1010))
+	require.Equal(t, int64(190), manager.GetPruningHeight(1020))
+	require.Equal(t, int64(200), manager.GetPruningHeight(300))
+	require.Equal(t, int64(209), manager.GetPruningHeight(220))
+
+	// the pruned height is loaded back from the database
+	db := db.NewMemDB()
+	manager = pruning.NewManager(db, log.NewNopLogger())
+	manager.SetOptions(types.NewSizePruningOptions(1000, 10, 10))
+	manager.SetSizeFunc(func() (uint64, error) { return size, nil })
+	require.Equal(t, int64(99), manager.GetPruningHeight(1010))
+
+	manager = pruning.NewManager(db, log.NewNopLogger())
+	manager.SetOptions(types.NewSizePruningOptions(1000, 10, 10))
+	manager.SetSizeFunc(func() (uint64, error) { return size, nil })
+	require.NoError(t, manager.LoadSnapshotHeights(db))
+	require.Equal(t, int64(190), manager.GetPruningHeight(1020))
+
+	// the size errors prevent pruning
+	manager.SetSizeFunc(func() (uint64, error) { return 0, errors.New(dbErr) })
+	require.Equal(t, int64(0), manager.GetPruningHeight(1030))
+}
+
+func TestPinnedHeights(t *testing.T) {
+	manager := pruning.NewManager(db.NewMemDB(), log.NewNopLogger())
+	opts := types.NewCustomPruningOptions(10, 10)
+	opts.PinnedHeights = []int64{150, 50}
+	manager.SetOptions(opts)
+
+	// the heights are pruned up to the earliest pinned height not pruned yet
+	require.Equal(t, int64(29), manager.GetPruningHeight(40))
+	require.Equal(t, int64(49), manager.GetPruningHeight(100))
+	require.Equal(t, int64(49), manager.GetPruningHeight(200))
+
+	// the pinned heights which are already pruned are ignored
+	manager = pruning.NewManager(db.NewMemDB(), log.NewNopLogger())
+	manager.SetOptions(types.NewCustomPruningOptions(10, 10))
+	require.Equal(t, int64(89), manager.GetPruningHeight(100))
+	opts.PinnedHeights = []int64{50, 150}
+	manager.SetOptions(opts)
+	require.Equal(t, int64(149), manager.GetPruningHeight(200))
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"time"
 )
 
 // PruningOptions defines the pruning strategy used when determining which
@@ -16,6 +17,20 @@ type PruningOptions struct {
 
 	// Strategy defines the kind of pruning strategy. See below for more information on each.
 	Strategy PruningStrategy
+
+	// KeepDuration defines how long the heights are kept on disk, relative to the
+	// block time of the latest height, with the time strategy.
+	KeepDuration time.Duration
+
+	// MaxBytes defines the size of the database above which the oldest heights
+	// are pruned, with the size strategy.
+	MaxBytes uint64
+
+	// PinnedHeights defines heights which are never pruned, e.g. upgrade heights.
+	// As the IAVL stores delete their versions from the first one up to a given
+	// version, pruning stops below the earliest pinned height which isn't pruned
+	// yet: the heights following it are kept as well, until it is unpinned.
+	PinnedHeights []int64
 }
 
 type PruningStrategy int
@@ -26,6 +41,8 @@ const (
 	PruningOptionEverything = "everything"
 	PruningOptionNothing    = "nothing"
 	PruningOptionCustom     = "custom"
+	PruningOptionTime       = "time"
+	PruningOptionSize       = "size"
 )
 
 const (
@@ -45,6 +62,15 @@ const (
 	PruningCustom
 	// PruningUndefined defines an undefined pruning strategy. It is to be returned by stores that do not support pruning.
 	PruningUndefined
+	// PruningTime defines a pruning strategy where the heights whose block time is
+	// older than KeepDuration, relative to the block time of the latest height, are
+	// deleted, keeping at least the last KeepRecent heights. To-be pruned heights
+	// are pruned at every Interval height.
+	PruningTime
+	// PruningSize defines a pruning strategy where the oldest heights are deleted
+	// while the database is larger than MaxBytes, keeping at least the last
+	// KeepRecent heights. To-be pruned heights are pruned at every Interval height.
+	PruningSize
 )
 
 const (
@@ -56,6 +82,9 @@ var (
 	ErrPruningIntervalZero       = errors.New("'pruning-interval' must not be 0. If you want to disable pruning, select pruning = \"nothing\"")
 	ErrPruningIntervalTooSmall   = fmt.Errorf("'pruning-interval' must not be less than %d. For the most aggressive pruning, select pruning = \"everything\"", pruneEverythingInterval)
 	ErrPruningKeepRecentTooSmall = fmt.Errorf("'pruning-keep-recent' must not be less than %d. For the most aggressive pruning, select pruning = \"everything\"", pruneEverythingKeepRecent)
+	ErrPruningKeepTimeZero       = errors.New("'pruning-keep-time' must be positive with pruning = \"time\"")
+	ErrPruningMaxSizeZero        = errors.New("'pruning-max-size-mb' must be positive with pruning = \"size\"")
+	ErrPruningPinnedHeight       = errors.New("'pruning-pinned-heights' must be positive")
 )
 
 func NewPruningOptions(pruningStrategy PruningStrategy) PruningOptions {
@@ -93,6 +122,29 @@ func NewCustomPruningOptions(keepRecent, interval uint64) PruningOptions {
 	}
 }
 
+// NewTimePruningOptions returns the options of a strategy keeping the heights of
+// the last keepDuration, and at least the last keepRecent heights.
+func NewTimePruningOptions(keepDuration time.Duration, keepRecent, interval uint64) PruningOptions {
+	return PruningOptions{
+		KeepRecent:   keepRecent,
+		Interval:     interval,
+		Strategy:     PruningTime,
+		KeepDuration: keepDuration,
+	}
+}
+
+// NewSizePruningOptions returns the options of a strategy pruning the oldest
+// heights while the database is larger than maxBytes, keeping at least the last
+// keepRecent heights.
+func NewSizePruningOptions(maxBytes, keepRecent, interval uint64) PruningOptions {
+	return PruningOptions{
+		KeepRecent: keepRecent,
+		Interval:   interval,
+		Strategy:   PruningSize,
+		MaxBytes:   maxBytes,
+	}
+}
+
 func (po PruningOptions) GetPruningStrategy() PruningStrategy {
 	return po.Strategy
 }
@@ -110,6 +162,17 @@ func (po PruningOptions) Validate() error {
 	if po.KeepRecent < pruneEverythingKeepRecent {
 		return ErrPruningKeepRecentTooSmall
 	}
+	if po.Strategy == PruningTime && po.KeepDuration <= 0 {
+		return ErrPruningKeepTimeZero
+	}
+	if po.Strategy == PruningSize && po.MaxBytes == 0 {
+		return ErrPruningMaxSizeZero
+	}
+	for _, height := range po.PinnedHeights {
+		if height <= 0 {
+			return ErrPruningPinnedHeight
+		}
+	}
 	return nil
 }
 
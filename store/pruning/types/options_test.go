@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -21,6 +22,14 @@ func TestPruningOptions_Validate(t *testing.T) {
 		{NewCustomPruningOptions(2, 9), ErrPruningIntervalTooSmall},
 		{NewCustomPruningOptions(2, 0), ErrPruningIntervalZero},
 		{NewCustomPruningOptions(2, 0), ErrPruningIntervalZero},
+		{NewTimePruningOptions(time.Hour, 2, 10), nil},
+		{NewTimePruningOptions(0, 2, 10), ErrPruningKeepTimeZero},
+		{NewTimePruningOptions(time.Hour, 1, 10), ErrPruningKeepRecentTooSmall},
+		{NewSizePruningOptions(1<<30, 2, 10), nil},
+		{NewSizePruningOptions(0, 2, 10), ErrPruningMaxSizeZero},
+		{NewSizePruningOptions(1<<30, 2, 9), ErrPruningIntervalTooSmall},
+		{PruningOptions{KeepRecent: 2, Interval: 10, Strategy: PruningCustom, PinnedHeights: []int64{10, 20}}, nil},
+		{PruningOptions{KeepRecent: 2, Interval: 10, Strategy: PruningCustom, PinnedHeights: []int64{0}}, ErrPruningPinnedHeight},
 	}
 
 	for _, tc := range testCases {
@@ -39,6 +48,8 @@ func TestPruningOptions_GetStrategy(t *testing.T) {
 		{NewPruningOptions(PruningNothing), PruningNothing},
 		{NewPruningOptions(PruningCustom), PruningCustom},
 		{NewCustomPruningOptions(2, 10), PruningCustom},
+		{NewTimePruningOptions(time.Hour, 2, 10), PruningTime},
+		{NewSizePruningOptions(1<<30, 2, 10), PruningSize},
 	}
 
 	for _, tc := range testCases {
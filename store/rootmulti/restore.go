@@ -0,0 +1,254 @@
+package rootmulti
+
+import (
+	"io"
+	"math"
+	"runtime"
+	"sort"
+
+	dbm "github.com/cosmos/cosmos-db"
+	protoio "github.com/cosmos/gogoproto/io"
+	iavltree "github.com/cosmos/iavl"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"cosmossdk.io/store/types"
+)
+
+// restoreNodeBufferSize is the number of snapshot nodes buffered for each store
+// being imported, it allows decoding the snapshot stream ahead of the import.
+const restoreNodeBufferSize = 4096
+
+// storeImport imports the nodes of a store snapshot into an IAVL store in its own
+// goroutine, so that the stores of a snapshot are imported concurrently while the
+// snapshot stream is decoded. The nodes of a store are imported in the order they
+// are added.
+type storeImport struct {
+	name     string
+	importer *iavltree.Importer
+	nodes    chan *iavltree.ExportNode
+	done     chan error
+	abort    bool
+}
+
+// newStoreImport starts importing the nodes added to the returned storeImport.
+func newStoreImport(name string, importer *iavltree.Importer) *storeImport {
+	imp := &storeImport{
+		name:     name,
+		importer: importer,
+		nodes:    make(chan *iavltree.ExportNode, restoreNodeBufferSize),
+		done:     make(chan error, 1),
+	}
+	go imp.run()
+
+	return imp
+}
+
+func (imp *storeImport) run() {
+	defer close(imp.done)
+	defer imp.importer.Close()
+
+	for node := range imp.nodes {
+		if err := imp.importer.Add(node); err != nil {
+			imp.done <- errorsmod.Wrapf(err, "IAVL node import failed for store %q", imp.name)
+			// drain the remaining nodes so that add never blocks
+			for range imp.nodes {
+			}
+			return
+		}
+	}
+
+	// abort is set before the nodes channel is closed
+	if imp.abort {
+		return
+	}
+	if err := imp.importer.Commit(); err != nil {
+		imp.done <- errorsmod.Wrapf(err, "IAVL commit failed for store %q", imp.name)
+	}
+}
+
+// add queues a node for import. It returns the import error as soon as the import
+// failed.
+func (imp *storeImport) add(node *iavltree.ExportNode) error {
+	select {
+	case imp.nodes <- node:
+		return nil
+	case err := <-imp.done:
+		return err
+	}
+}
+
+// close ends the node stream of the import, which is committed once all the
+// nodes are imported.
+func (imp *storeImport) close() {
+	close(imp.nodes)
+}
+
+// wait waits for a closed import to be committed and returns its error.
+func (imp *storeImport) wait() error {
+	return <-imp.done
+}
+
+// cancel ends the node stream of the import without committing it, and waits for
+// the import to end.
+func (imp *storeImport) cancel() {
+	imp.abort = true
+	close(imp.nodes)
+	<-imp.done
+}
+
+// importSnapshot imports the IAVL stores of a snapshot with the importers returned by
+// newImporter, and returns the next snapshot item. The first item is expected to be a
+// SnapshotItem containing a SnapshotStoreItem, telling us which store to import into.
+// The following items will contain SnapshotNodeItem (i.e. ExportNode) until we reach the
+// next SnapshotStoreItem or EOF.
+//
+// Each store is imported in its own goroutine, up to concurrency stores at a time, while
+// the next items are read from the stream.
+func importSnapshot(
+	logger log.Logger, protoReader protoio.Reader, concurrency int, newImporter func(name string) (*iavltree.Importer, error),
+) (snapshottypes.SnapshotItem, error) {
+	var (
+		current      *storeImport
+		pending      []*storeImport
+		snapshotItem snapshottypes.SnapshotItem
+	)
+	// fail cancels the import in progress and waits for the other imports to end.
+	fail := func(err error) (snapshottypes.SnapshotItem, error) {
+		if current != nil {
+			current.cancel()
+		}
+		for _, imp := range pending {
+			_ = imp.wait()
+		}
+		return snapshottypes.SnapshotItem{}, err
+	}
+	// waitPending waits for the pending imports until there are less than the given number.
+	waitPending := func(max int) error {
+		for len(pending) > 0 && len(pending) >= max {
+			imp := pending[0]
+			pending = pending[1:]
+			if err := imp.wait(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+loop:
+	for {
+		snapshotItem = snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&snapshotItem)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return fail(errorsmod.Wrap(err, "invalid protobuf message"))
+		}
+
+		switch item := snapshotItem.Item.(type) {
+		case *snapshottypes.SnapshotItem_Store:
+			if current != nil {
+				current.close()
+				pending = append(pending, current)
+				current = nil
+			}
+			if err := waitPending(concurrency); err != nil {
+				return fail(err)
+			}
+
+			importer, err := newImporter(item.Store.Name)
+			if err != nil {
+				return fail(errorsmod.Wrap(err, "import failed"))
+			}
+			current = newStoreImport(item.Store.Name, importer)
+			logger.Debug("restoring snapshot", "store", item.Store.Name)
+
+		case *snapshottypes.SnapshotItem_IAVL:
+			if current == nil {
+				logger.Error("failed to restore; received IAVL node item before store item")
+				return fail(errorsmod.Wrap(types.ErrLogic, "received IAVL node item before store item"))
+			}
+			if item.IAVL.Height > math.MaxInt8 {
+				return fail(errorsmod.Wrapf(types.ErrLogic, "node height %v cannot exceed %v",
+					item.IAVL.Height, math.MaxInt8))
+			}
+			node := &iavltree.ExportNode{
+				Key:     item.IAVL.Key,
+				Value:   item.IAVL.Value,
+				Height:  int8(item.IAVL.Height),
+				Version: item.IAVL.Version,
+			}
+			// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
+			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+			if node.Key == nil {
+				node.Key = []byte{}
+			}
+			if node.Height == 0 && node.Value == nil {
+				node.Value = []byte{}
+			}
+			if err := current.add(node); err != nil {
+				return fail(err)
+			}
+
+		default:
+			break loop
+		}
+	}
+
+	if current != nil {
+		current.close()
+		pending = append(pending, current)
+		current = nil
+	}
+	if err := waitPending(1); err != nil {
+		return fail(err)
+	}
+
+	return snapshotItem, nil
+}
+
+// SnapshotCommitInfo rebuilds the IAVL tree of each store of a snapshot in a scratch
+// in-memory database, and returns the resulting commit info, whose hash is the app hash
+// of the snapshot height, along with the next snapshot item. It allows verifying a
+// snapshot against a trusted app hash without restoring it.
+//
+// Only the stores included in the snapshot, i.e. the IAVL stores, are part of the
+// returned commit info.
+func SnapshotCommitInfo(height uint64, format uint32, protoReader protoio.Reader) (*types.CommitInfo, snapshottypes.SnapshotItem, error) {
+	if format != snapshottypes.CurrentFormat {
+		return nil, snapshottypes.SnapshotItem{}, errorsmod.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+	if height == 0 || height > math.MaxInt64 {
+		return nil, snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "invalid snapshot height %v", height)
+	}
+
+	trees := make(map[string]*iavltree.MutableTree)
+	nextItem, err := importSnapshot(log.NewNopLogger(), protoReader, runtime.NumCPU(), func(name string) (*iavltree.Importer, error) {
+		if _, ok := trees[name]; ok {
+			return nil, errorsmod.Wrapf(types.ErrLogic, "duplicate store %q", name)
+		}
+		tree := iavltree.NewMutableTree(dbm.NewMemDB(), 0, true, log.NewNopLogger())
+		trees[name] = tree
+		return tree.Import(int64(height))
+	})
+	if err != nil {
+		return nil, snapshottypes.SnapshotItem{}, err
+	}
+
+	commitInfo := &types.CommitInfo{Version: int64(height)}
+	for name, tree := range trees {
+		commitInfo.StoreInfos = append(commitInfo.StoreInfos, types.StoreInfo{
+			Name: name,
+			CommitId: types.CommitID{
+				Version: int64(height),
+				Hash:    tree.Hash(),
+			},
+		})
+	}
+	sort.Slice(commitInfo.StoreInfos, func(i, j int) bool {
+		return commitInfo.StoreInfos[i].Name < commitInfo.StoreInfos[j].Name
+	})
+
+	return commitInfo, nextItem, nil
+}
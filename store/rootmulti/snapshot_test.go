@@ -1,6 +1,7 @@
 package rootmulti_test
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
@@ -11,6 +12,7 @@ import (
 	"testing"
 
 	dbm "github.com/cosmos/cosmos-db"
+	protoio "github.com/cosmos/gogoproto/io"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -304,6 +306,130 @@ func benchmarkMultistoreSnapshotRestore(b *testing.B, stores uint8, storeKeys ui
 	}
 }
 
+// snapshotChunks returns the chunks of a snapshot of the store at the given height.
+func snapshotChunks(tb testing.TB, store *rootmulti.Store, height uint64) [][]byte {
+	tb.Helper()
+	chunks := make(chan io.ReadCloser)
+	go func() {
+		streamWriter := snapshots.NewStreamWriter(chunks)
+		require.NotNil(tb, streamWriter)
+		defer streamWriter.Close()
+		require.NoError(tb, store.Snapshot(height, streamWriter))
+	}()
+
+	var bodies [][]byte
+	for chunk := range chunks {
+		body, err := io.ReadAll(chunk)
+		require.NoError(tb, err)
+		bodies = append(bodies, body)
+	}
+	return bodies
+}
+
+// restoreChunks restores the snapshot chunks taken from source into a new store
+// importing concurrency stores at a time.
+func restoreChunks(tb testing.TB, source *rootmulti.Store, chunks [][]byte, concurrency int) *rootmulti.Store {
+	tb.Helper()
+	target := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	target.SetSnapshotRestoreConcurrency(concurrency)
+	for _, key := range source.StoreKeysByName() {
+		target.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	}
+	require.NoError(tb, target.LoadLatestVersion())
+
+	ch := make(chan io.ReadCloser, len(chunks))
+	for _, chunk := range chunks {
+		ch <- io.NopCloser(bytes.NewReader(chunk))
+	}
+	close(ch)
+
+	streamReader, err := snapshots.NewStreamReader(ch)
+	require.NoError(tb, err)
+	_, err = target.Restore(uint64(source.LastCommitID().Version), snapshottypes.CurrentFormat, streamReader)
+	require.NoError(tb, err)
+
+	return target
+}
+
+func TestMultistoreSnapshotRestore_Concurrency(t *testing.T) {
+	source := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 6, 200)
+	chunks := snapshotChunks(t, source, uint64(source.LastCommitID().Version))
+
+	for _, concurrency := range []int{1, 2, 6, 0} {
+		t.Run(fmt.Sprintf("concurrency %d", concurrency), func(t *testing.T) {
+			target := restoreChunks(t, source, chunks, concurrency)
+			require.Equal(t, source.LastCommitID(), target.LastCommitID())
+			for _, key := range source.StoreKeysByName() {
+				assertStoresEqual(t, source.GetStoreByName(key.Name()).(types.CommitKVStore),
+					target.GetStoreByName(key.Name()).(types.CommitKVStore), "store %q not equal", key.Name())
+			}
+		})
+	}
+}
+
+func TestMultistoreSnapshotRestore_Errors(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+	version := uint64(source.LastCommitID().Version)
+
+	testcases := map[string][]snapshottypes.SnapshotItem{
+		"node before store": {
+			{Item: &snapshottypes.SnapshotItem_IAVL{IAVL: &snapshottypes.SnapshotIAVLItem{Key: []byte("a"), Value: []byte{1}, Version: 1}}},
+		},
+		"unknown store": {
+			{Item: &snapshottypes.SnapshotItem_Store{Store: &snapshottypes.SnapshotStoreItem{Name: "iavl1"}}},
+			{Item: &snapshottypes.SnapshotItem_Store{Store: &snapshottypes.SnapshotStoreItem{Name: "unknown"}}},
+		},
+		"invalid node in a store": {
+			{Item: &snapshottypes.SnapshotItem_Store{Store: &snapshottypes.SnapshotStoreItem{Name: "iavl1"}}},
+			{Item: &snapshottypes.SnapshotItem_Store{Store: &snapshottypes.SnapshotStoreItem{Name: "iavl2"}}},
+			{Item: &snapshottypes.SnapshotItem_IAVL{IAVL: &snapshottypes.SnapshotIAVLItem{Key: []byte("a"), Value: []byte{1}, Version: 1, Height: 1}}},
+		},
+	}
+	for name, items := range testcases {
+		t.Run(name, func(t *testing.T) {
+			chunks := make(chan io.ReadCloser, 100)
+			go func() {
+				streamWriter := snapshots.NewStreamWriter(chunks)
+				require.NotNil(t, streamWriter)
+				defer streamWriter.Close()
+				for _, item := range items {
+					item := item
+					require.NoError(t, streamWriter.WriteMsg(&item))
+				}
+			}()
+
+			target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+			streamReader, err := snapshots.NewStreamReader(chunks)
+			require.NoError(t, err)
+			_, err = target.Restore(version, snapshottypes.CurrentFormat, streamReader)
+			require.Error(t, err)
+		})
+	}
+}
+
+// BenchmarkMultistoreSnapshotRestoreConcurrency compares restoring a snapshot
+// importing a store at a time and importing all the stores concurrently.
+func BenchmarkMultistoreSnapshotRestoreConcurrency(b *testing.B) {
+	source := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 8, 5000)
+	chunks := snapshotChunks(b, source, uint64(source.LastCommitID().Version))
+
+	for _, bc := range []struct {
+		name        string
+		concurrency int
+	}{
+		{"sequential", 1},
+		{"parallel", 8},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				target := restoreChunks(b, source, chunks, bc.concurrency)
+				require.Equal(b, source.LastCommitID(), target.LastCommitID())
+			}
+		})
+	}
+}
+
 func BenchmarkMultistoreSnapshot100K(b *testing.B) {
 	benchmarkMultistoreSnapshot(b, 10, 10000)
 }
@@ -319,3 +445,139 @@ func BenchmarkMultistoreSnapshotRestore100K(b *testing.B) {
 func BenchmarkMultistoreSnapshotRestore1M(b *testing.B) {
 	benchmarkMultistoreSnapshotRestore(b, 10, 100000)
 }
+
+func TestMultistoreSnapshotRestoreDelta(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+
+	// add a few versions with random changes, including versions without any
+	// change in some stores
+	r := rand.New(rand.NewSource(4))
+	for v := 0; v < 5; v++ {
+		for _, name := range []string{"iavl1", "iavl2"} {
+			store := source.GetStoreByName(name).(types.CommitKVStore)
+			for i := 0; i < r.Intn(10); i++ {
+				key := []byte{byte(r.Intn(16))}
+				if r.Intn(4) == 0 {
+					store.Delete(key)
+				} else {
+					store.Set(key, []byte{byte(r.Intn(256))})
+				}
+			}
+		}
+		source.Commit()
+	}
+	require.EqualValues(t, 8, source.LastCommitID().Version)
+
+	writeSnapshot := func(snapshot func(protoWriter *snapshots.StreamWriter) error) <-chan io.ReadCloser {
+		chunks := make(chan io.ReadCloser, 100)
+		go func() {
+			streamWriter := snapshots.NewStreamWriter(chunks)
+			require.NotNil(t, streamWriter)
+			defer streamWriter.Close()
+			require.NoError(t, snapshot(streamWriter))
+		}()
+		return chunks
+	}
+
+	// restore a full snapshot at height 1
+	streamReader, err := snapshots.NewStreamReader(writeSnapshot(func(protoWriter *snapshots.StreamWriter) error {
+		return source.Snapshot(1, protoWriter)
+	}))
+	require.NoError(t, err)
+	_, err = target.Restore(1, snapshottypes.CurrentFormat, streamReader)
+	require.NoError(t, err)
+
+	// then the deltas from 1 to 3 and from 3 to 8
+	for _, heights := range [][2]uint64{{1, 3}, {3, 8}} {
+		streamReader, err := snapshots.NewStreamReader(writeSnapshot(func(protoWriter *snapshots.StreamWriter) error {
+			return source.SnapshotDelta(heights[0], heights[1], protoWriter)
+		}))
+		require.NoError(t, err)
+		_, err = target.RestoreDelta(heights[0], heights[1], snapshottypes.CurrentFormat, streamReader)
+		require.NoError(t, err)
+
+		sourceInfo, err := source.GetCommitInfo(int64(heights[1]))
+		require.NoError(t, err)
+		targetInfo, err := target.GetCommitInfo(int64(heights[1]))
+		require.NoError(t, err)
+		require.Equal(t, sourceInfo.Hash(), targetInfo.Hash())
+	}
+
+	// the commit infos of the intermediate heights are restored as well
+	for height := int64(2); height <= 8; height++ {
+		sourceInfo, err := source.GetCommitInfo(height)
+		require.NoError(t, err)
+		targetInfo, err := target.GetCommitInfo(height)
+		require.NoError(t, err, "height: %d", height)
+		require.Equal(t, sourceInfo.Hash(), targetInfo.Hash(), "height: %d", height)
+	}
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for _, name := range []string{"iavl1", "iavl2", "iavl3"} {
+		assertStoresEqual(t, source.GetStoreByName(name).(types.CommitKVStore), target.GetStoreByName(name).(types.CommitKVStore),
+			"store %q not equal", name)
+	}
+}
+
+func TestMultistoreSnapshotDelta_Errors(t *testing.T) {
+	store := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+
+	testcases := map[string]struct {
+		parentHeight uint64
+		height       uint64
+		expectType   error
+	}{
+		"0 parent height":     {0, 3, types.ErrLogic},
+		"parent above height": {3, 2, types.ErrLogic},
+		"future height":       {1, 4, types.ErrLogic},
+		"valid heights":       {1, 3, nil},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := store.SnapshotDelta(tc.parentHeight, tc.height, protoio.NewDelimitedWriter(io.Discard))
+			if tc.expectType == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tc.expectType)
+		})
+	}
+
+	// a delta snapshot requires the versions since its parent height
+	require.NoError(t, store.GetStoreByName("iavl1").(*iavl.Store).DeleteVersionsTo(1))
+	err := store.SnapshotDelta(1, 3, protoio.NewDelimitedWriter(io.Discard))
+	require.ErrorIs(t, err, types.ErrLogic)
+	require.ErrorContains(t, err, "version 1 is pruned")
+
+	// a delta snapshot can only be restored on top of its parent height
+	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	_, err = target.RestoreDelta(1, 3, snapshottypes.CurrentFormat, nil)
+	require.ErrorIs(t, err, types.ErrLogic)
+}
+
+func TestSnapshotCommitInfo(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+	version := uint64(source.LastCommitID().Version)
+	expected, err := source.GetCommitInfo(int64(version))
+	require.NoError(t, err)
+
+	chunks := snapshotChunks(t, source, version)
+	ch := make(chan io.ReadCloser, len(chunks))
+	for _, chunk := range chunks {
+		ch <- io.NopCloser(bytes.NewReader(chunk))
+	}
+	close(ch)
+	streamReader, err := snapshots.NewStreamReader(ch)
+	require.NoError(t, err)
+
+	commitInfo, nextItem, err := rootmulti.SnapshotCommitInfo(version, snapshottypes.CurrentFormat, streamReader)
+	require.NoError(t, err)
+	require.Nil(t, nextItem.Item)
+	require.Equal(t, expected.StoreInfos, commitInfo.StoreInfos)
+	require.Equal(t, source.LastCommitID().Hash, commitInfo.Hash())
+
+	_, _, err = rootmulti.SnapshotCommitInfo(version, 0, streamReader)
+	require.ErrorIs(t, err, snapshottypes.ErrUnknownFormat)
+}
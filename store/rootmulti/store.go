@@ -4,10 +4,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"math"
+	"runtime"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
@@ -55,24 +56,26 @@ func keysFromStoreKeyMap[V any](m map[types.StoreKey]V) []types.StoreKey {
 // cacheMultiStore which is used for branching other MultiStores. It implements
 // the CommitMultiStore interface.
 type Store struct {
-	db                  dbm.DB
-	logger              log.Logger
-	lastCommitInfo      *types.CommitInfo
-	pruningManager      *pruning.Manager
-	iavlCacheSize       int
-	iavlDisableFastNode bool
-	storesParams        map[types.StoreKey]storeParams
-	stores              map[types.StoreKey]types.CommitKVStore
-	keysByName          map[string]types.StoreKey
-	initialVersion      int64
-	removalMap          map[types.StoreKey]bool
-	traceWriter         io.Writer
-	traceContext        types.TraceContext
-	traceContextMutex   sync.Mutex
-	interBlockCache     types.MultiStorePersistentCache
-	listeners           map[types.StoreKey]*types.MemoryListener
-	metrics             metrics.StoreMetrics
-	commitHeader        cmtproto.Header
+	db                         dbm.DB
+	logger                     log.Logger
+	lastCommitInfo             *types.CommitInfo
+	pruningManager             *pruning.Manager
+	iavlCacheSize              int
+	iavlDisableFastNode        bool
+	storesParams               map[types.StoreKey]storeParams
+	stores                     map[types.StoreKey]types.CommitKVStore
+	keysByName                 map[string]types.StoreKey
+	initialVersion             int64
+	removalMap                 map[types.StoreKey]bool
+	traceWriter                io.Writer
+	traceContext               types.TraceContext
+	traceContextMutex          sync.Mutex
+	interBlockCache            types.MultiStorePersistentCache
+	listeners                  map[types.StoreKey]*types.MemoryListener
+	metrics                    metrics.StoreMetrics
+	commitHeader               cmtproto.Header
+	snapshotRestoreConcurrency int
+	commitConcurrency          int
 }
 
 var (
@@ -85,7 +88,7 @@ var (
 // a store is created, KVStores must be mounted and finally LoadLatestVersion or
 // LoadVersion must be called.
 func NewStore(db dbm.DB, logger log.Logger, metricGatherer metrics.StoreMetrics) *Store {
-	return &Store{
+	rs := &Store{
 		db:                  db,
 		logger:              logger,
 		iavlCacheSize:       iavl.DefaultIAVLCacheSize,
@@ -98,6 +101,9 @@ func NewStore(db dbm.DB, logger log.Logger, metricGatherer metrics.StoreMetrics)
 		pruningManager:      pruning.NewManager(db, logger),
 		metrics:             metricGatherer,
 	}
+	rs.pruningManager.SetBlockTimeFunc(rs.blockTime)
+
+	return rs
 }
 
 // GetPruning fetches the pruning strategy from the root store.
@@ -112,6 +118,12 @@ func (rs *Store) SetPruning(pruningOpts pruningtypes.PruningOptions) {
 	rs.pruningManager.SetOptions(pruningOpts)
 }
 
+// SetPruningSizeFunc sets the function returning the size of the database,
+// which is required by the size pruning strategy.
+func (rs *Store) SetPruningSizeFunc(dbSize pruning.SizeFunc) {
+	rs.pruningManager.SetSizeFunc(dbSize)
+}
+
 // SetMetrics sets the metrics gatherer for the store package
 func (rs *Store) SetMetrics(metrics metrics.StoreMetrics) {
 	rs.metrics = metrics
@@ -131,6 +143,28 @@ func (rs *Store) SetIAVLDisableFastNode(disableFastNode bool) {
 	rs.iavlDisableFastNode = disableFastNode
 }
 
+// SetSnapshotRestoreConcurrency sets the maximum number of stores imported
+// concurrently when restoring a snapshot. It defaults to the number of CPUs,
+// 1 imports the stores one after the other.
+func (rs *Store) SetSnapshotRestoreConcurrency(concurrency int) {
+	rs.snapshotRestoreConcurrency = concurrency
+}
+
+// SetCommitConcurrency sets the maximum number of stores committed
+// concurrently. It defaults to 1, committing the stores one after the other.
+func (rs *Store) SetCommitConcurrency(concurrency int) {
+	rs.commitConcurrency = concurrency
+}
+
+// restoreConcurrency returns the maximum number of stores imported concurrently
+// when restoring a snapshot.
+func (rs *Store) restoreConcurrency() int {
+	if rs.snapshotRestoreConcurrency <= 0 {
+		return runtime.NumCPU()
+	}
+	return rs.snapshotRestoreConcurrency
+}
+
 // GetStoreType implements Store.
 func (rs *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -467,7 +501,7 @@ func (rs *Store) Commit() types.CommitID {
 		rs.logger.Debug("commit header and version mismatch", "header_height", rs.commitHeader.Height, "version", version)
 	}
 
-	rs.lastCommitInfo = commitStores(version, rs.stores, rs.removalMap)
+	rs.lastCommitInfo = commitStores(version, rs.stores, rs.removalMap, rs.commitConcurrency)
 	rs.lastCommitInfo.Timestamp = rs.commitHeader.Time
 	defer rs.flushMetadata(rs.db, version, rs.lastCommitInfo)
 
@@ -806,28 +840,10 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 		return errorsmod.Wrapf(types.ErrLogic, "cannot snapshot future height %v", height)
 	}
 
-	// Collect stores to snapshot (only IAVL stores are supported)
-	type namedStore struct {
-		*iavl.Store
-		name string
-	}
-	stores := []namedStore{}
-	keys := keysFromStoreKeyMap(rs.stores)
-	for _, key := range keys {
-		switch store := rs.GetCommitKVStore(key).(type) {
-		case *iavl.Store:
-			stores = append(stores, namedStore{name: key.Name(), Store: store})
-		case *transient.Store, *mem.Store:
-			// Non-persisted stores shouldn't be snapshotted
-			continue
-		default:
-			return errorsmod.Wrapf(types.ErrLogic,
-				"don't know how to snapshot store %q of type %T", key.Name(), store)
-		}
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return err
 	}
-	sort.Slice(stores, func(i, j int) bool {
-		return strings.Compare(stores[i].name, stores[j].name) == -1
-	})
 
 	// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
 	// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
@@ -892,15 +908,130 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 	return nil
 }
 
-// Restore implements snapshottypes.Snapshotter.
+// namedStore is an IAVL store along with its name.
+type namedStore struct {
+	*iavl.Store
+	name string
+}
+
+// snapshotStores returns the stores to snapshot sorted by name. Only IAVL stores
+// are supported.
+func (rs *Store) snapshotStores() ([]namedStore, error) {
+	stores := []namedStore{}
+	keys := keysFromStoreKeyMap(rs.stores)
+	for _, key := range keys {
+		switch store := rs.GetCommitKVStore(key).(type) {
+		case *iavl.Store:
+			stores = append(stores, namedStore{name: key.Name(), Store: store})
+		case *transient.Store, *mem.Store:
+			// Non-persisted stores shouldn't be snapshotted
+			continue
+		default:
+			return nil, errorsmod.Wrapf(types.ErrLogic,
+				"don't know how to snapshot store %q of type %T", key.Name(), store)
+		}
+	}
+	sort.Slice(stores, func(i, j int) bool {
+		return strings.Compare(stores[i].name, stores[j].name) == -1
+	})
+
+	return stores, nil
+}
+
+// SnapshotDelta implements snapshottypes.DeltaSnapshotter. It writes the state
+// changes of each IAVL store between parentHeight (exclusive) and height
+// (inclusive), version by version, as the key/value change sets of the versions
+// rather than the nodes of the trees. All the versions from parentHeight to
+// height must still exist in every store, i.e. the pruning options must keep
+// the heights since the parent snapshot, otherwise the snapshot is rejected.
+//
+// Stores are serialized as a stream of SnapshotItem Protobuf messages. The first
+// item contains a SnapshotStore with store metadata (i.e. name), and the
+// following messages contain a SnapshotIAVLChangeItem, ordered by version and by
+// key within a version. Store changes are demarcated by new SnapshotStore items.
+func (rs *Store) SnapshotDelta(parentHeight, height uint64, protoWriter protoio.Writer) error {
+	if parentHeight == 0 || parentHeight >= height {
+		return errorsmod.Wrapf(types.ErrLogic, "invalid delta snapshot parent height %v for height %v", parentHeight, height)
+	}
+	if height > uint64(GetLatestVersion(rs.db)) {
+		return errorsmod.Wrapf(types.ErrLogic, "cannot snapshot future height %v", height)
+	}
+
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return err
+	}
+
+	for _, store := range stores {
+		for version := int64(parentHeight); version <= int64(height); version++ {
+			if !store.VersionExists(version) {
+				return errorsmod.Wrapf(types.ErrLogic,
+					"store %q doesn't have all the versions from %v to %v, version %v is pruned", store.name, parentHeight, height, version)
+			}
+		}
+	}
+
+	for _, store := range stores {
+		rs.logger.Debug("starting delta snapshot", "store", store.name, "parent_height", parentHeight, "height", height)
+		err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_Store{
+				Store: &snapshottypes.SnapshotStoreItem{
+					Name: store.name,
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		changeCount := 0
+		err = store.TraverseStateChanges(int64(parentHeight)+1, int64(height), func(version int64, changeSet *iavltree.ChangeSet) error {
+			for _, pair := range changeSet.Pairs {
+				err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+					Item: &snapshottypes.SnapshotItem_IAVLChange{
+						IAVLChange: &snapshottypes.SnapshotIAVLChangeItem{
+							Version: version,
+							Key:     pair.Key,
+							Value:   pair.Value,
+							Delete:  pair.Delete,
+						},
+					},
+				})
+				if err != nil {
+					return err
+				}
+				changeCount++
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		rs.logger.Debug("delta snapshot done", "store", store.name, "changeCount", changeCount)
+	}
+
+	return nil
+}
+
+// RestoreDelta implements snapshottypes.DeltaSnapshotter. It replays the state
+// changes of a delta snapshot on top of the current state, which must be at
+// parentHeight. Every version in between is committed in each store so that the
+// resulting IAVL trees, and hence the app hash, are identical to the ones the
+// snapshot was taken from, and the commit info of every restored height is
+// written. As the snapshot doesn't contain the block times, the commit infos of
+// the heights before height have none.
+//
 // returns next snapshot item and error.
-func (rs *Store) Restore(
-	height uint64, format uint32, protoReader protoio.Reader,
+func (rs *Store) RestoreDelta(
+	parentHeight, height uint64, format uint32, protoReader protoio.Reader,
 ) (snapshottypes.SnapshotItem, error) {
-	// Import nodes into stores. The first item is expected to be a SnapshotItem containing
-	// a SnapshotStoreItem, telling us which store to import into. The following items will contain
-	// SnapshotNodeItem (i.e. ExportNode) until we reach the next SnapshotStoreItem or EOF.
-	var importer *iavltree.Importer
+	if latest := rs.LastCommitID().Version; latest != int64(parentHeight) {
+		return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic,
+			"delta snapshot for height %v must be restored at height %v, got %v", height, parentHeight, latest)
+	}
+
+	var store *iavl.Store
 	var snapshotItem snapshottypes.SnapshotItem
 loop:
 	for {
@@ -914,64 +1045,119 @@ loop:
 
 		switch item := snapshotItem.Item.(type) {
 		case *snapshottypes.SnapshotItem_Store:
-			if importer != nil {
-				err = importer.Commit()
-				if err != nil {
-					return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "IAVL commit failed")
-				}
-				importer.Close()
+			if store != nil {
+				commitStoreVersionsTo(store, int64(height))
 			}
-			store, ok := rs.GetStoreByName(item.Store.Name).(*iavl.Store)
+			var ok bool
+			store, ok = rs.GetStoreByName(item.Store.Name).(*iavl.Store)
 			if !ok || store == nil {
 				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "cannot import into non-IAVL store %q", item.Store.Name)
 			}
-			importer, err = store.Import(int64(height))
-			if err != nil {
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "import failed")
-			}
-			defer importer.Close()
-			// Importer height must reflect the node height (which usually matches the block height, but not always)
-			rs.logger.Debug("restoring snapshot", "store", item.Store.Name)
-
-		case *snapshottypes.SnapshotItem_IAVL:
-			if importer == nil {
-				rs.logger.Error("failed to restore; received IAVL node item before store item")
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(types.ErrLogic, "received IAVL node item before store item")
-			}
-			if item.IAVL.Height > math.MaxInt8 {
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "node height %v cannot exceed %v",
-					item.IAVL.Height, math.MaxInt8)
+			if version := store.LastCommitID().Version; version != int64(parentHeight) {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic,
+					"store %q is at version %v, expected %v", item.Store.Name, version, parentHeight)
 			}
-			node := &iavltree.ExportNode{
-				Key:     item.IAVL.Key,
-				Value:   item.IAVL.Value,
-				Height:  int8(item.IAVL.Height),
-				Version: item.IAVL.Version,
+			rs.logger.Debug("restoring delta snapshot", "store", item.Store.Name)
+
+		case *snapshottypes.SnapshotItem_IAVLChange:
+			if store == nil {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(types.ErrLogic, "received IAVL change item before store item")
 			}
-			// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
-			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
-			if node.Key == nil {
-				node.Key = []byte{}
+			change := item.IAVLChange
+			if change.Version <= store.LastCommitID().Version || change.Version > int64(height) {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic,
+					"invalid IAVL change version %v", change.Version)
 			}
-			if node.Height == 0 && node.Value == nil {
-				node.Value = []byte{}
+			// commit the versions preceding the one of the change
+			commitStoreVersionsTo(store, change.Version-1)
+
+			if change.Delete {
+				store.Delete(change.Key)
+				continue
 			}
-			err := importer.Add(node)
-			if err != nil {
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "IAVL node import failed")
+
+			// Protobuf does not differentiate between []byte{} as nil, but IAVL does not
+			// allow nil values, so we can always set them to empty.
+			if change.Value == nil {
+				change.Value = []byte{}
 			}
+			store.Set(change.Key, change.Value)
 
 		default:
 			break loop
 		}
 	}
 
-	if importer != nil {
-		err := importer.Commit()
+	if store != nil {
+		commitStoreVersionsTo(store, int64(height))
+	}
+
+	if err := rs.flushCommitInfos(int64(parentHeight)+1, int64(height)-1); err != nil {
+		return snapshottypes.SnapshotItem{}, err
+	}
+	rs.flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)))
+	return snapshotItem, rs.LoadLatestVersion()
+}
+
+// flushCommitInfos writes the commit infos of the committed versions from
+// fromVersion to toVersion, built from the versions of the IAVL stores.
+func (rs *Store) flushCommitInfos(fromVersion, toVersion int64) error {
+	batch := rs.db.NewBatch()
+	defer func() {
+		_ = batch.Close()
+	}()
+
+	for version := fromVersion; version <= toVersion; version++ {
+		cInfo, err := rs.buildCommitInfoAt(version)
 		if err != nil {
-			return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "IAVL commit failed")
+			return err
 		}
-		importer.Close()
+		flushCommitInfo(batch, version, cInfo)
+	}
+
+	return batch.WriteSync()
+}
+
+// buildCommitInfoAt builds the commit info of a committed version, which may
+// not be the latest one, from the versions of the IAVL stores.
+func (rs *Store) buildCommitInfoAt(version int64) (*types.CommitInfo, error) {
+	cInfo := rs.buildCommitInfo(version)
+	for i, storeInfo := range cInfo.StoreInfos {
+		store, ok := rs.GetStoreByName(storeInfo.Name).(*iavl.Store)
+		if !ok {
+			continue
+		}
+		immutable, err := store.GetImmutable(version)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to get version %v of store %q", version, storeInfo.Name)
+		}
+		cInfo.StoreInfos[i].CommitId = immutable.LastCommitID()
+	}
+	return cInfo, nil
+}
+
+// commitStoreVersionsTo commits the store until it reaches the given version.
+func commitStoreVersionsTo(store *iavl.Store, version int64) {
+	for store.LastCommitID().Version < version {
+		store.Commit()
+	}
+}
+
+// Restore implements snapshottypes.Snapshotter.
+// returns next snapshot item and error.
+func (rs *Store) Restore(
+	height uint64, format uint32, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	snapshotItem, err := importSnapshot(rs.logger, protoReader, rs.restoreConcurrency(), func(name string) (*iavltree.Importer, error) {
+		store, ok := rs.GetStoreByName(name).(*iavl.Store)
+		if !ok || store == nil {
+			return nil, errorsmod.Wrapf(types.ErrLogic, "cannot import into non-IAVL store %q", name)
+		}
+		// Importer height must reflect the node height (which usually matches the block height, but not always)
+		return store.Import(int64(height))
+	})
+	if err != nil {
+		return snapshottypes.SnapshotItem{}, err
 	}
 
 	rs.flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)))
@@ -1107,6 +1293,28 @@ func (rs *Store) GetCommitInfo(ver int64) (*types.CommitInfo, error) {
 	return cInfo, nil
 }
 
+// blockTime returns the block time of a committed version, read from its commit
+// info, or the zero time if it has no commit info.
+func (rs *Store) blockTime(version int64) (time.Time, error) {
+	if rs.lastCommitInfo != nil && rs.lastCommitInfo.Version == version {
+		return rs.lastCommitInfo.Timestamp, nil
+	}
+
+	bz, err := rs.db.Get([]byte(fmt.Sprintf(commitInfoKeyFmt, version)))
+	if err != nil {
+		return time.Time{}, errorsmod.Wrap(err, "failed to get commit info")
+	} else if bz == nil {
+		return time.Time{}, nil
+	}
+
+	cInfo := &types.CommitInfo{}
+	if err = cInfo.Unmarshal(bz); err != nil {
+		return time.Time{}, errorsmod.Wrap(err, "failed unmarshal commit info")
+	}
+
+	return cInfo.Timestamp, nil
+}
+
 func (rs *Store) flushMetadata(db dbm.DB, version int64, cInfo *types.CommitInfo) {
 	rs.logger.Debug("flushing metadata", "height", version)
 	batch := db.NewBatch()
@@ -1161,27 +1369,66 @@ func GetLatestVersion(db dbm.DB) int64 {
 	return latestVersion
 }
 
-// Commits each store and returns a new commitInfo.
-func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore, removalMap map[types.StoreKey]bool) *types.CommitInfo {
-	storeInfos := make([]types.StoreInfo, 0, len(storeMap))
+// Commits each store and returns a new commitInfo. The stores are independent
+// from each other, they are committed concurrently, up to concurrency stores at
+// a time, or one after the other if concurrency is lower than 2. The commit info
+// is built afterwards in the order of the store names, so that its hash doesn't
+// depend on the order of the commits.
+func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore, removalMap map[types.StoreKey]bool, concurrency int) *types.CommitInfo {
 	storeKeys := keysFromStoreKeyMap(storeMap)
+	commitIDs := make([]types.CommitID, len(storeKeys))
 
-	for _, key := range storeKeys {
-		store := storeMap[key]
+	commitStore := func(i int) {
+		store := storeMap[storeKeys[i]]
 		last := store.LastCommitID()
 
 		// If a commit event execution is interrupted, a new iavl store's version
 		// will be larger than the RMS's metadata, when the block is replayed, we
 		// should avoid committing that iavl store again.
-		var commitID types.CommitID
 		if last.Version >= version {
 			last.Version = version
-			commitID = last
+			commitIDs[i] = last
 		} else {
-			commitID = store.Commit()
+			commitIDs[i] = store.Commit()
 		}
+	}
 
-		storeType := store.GetStoreType()
+	if concurrency <= 1 {
+		for i := range storeKeys {
+			commitStore(i)
+		}
+	} else {
+		var (
+			wg        sync.WaitGroup
+			panicOnce sync.Once
+			panicErr  any
+		)
+		sem := make(chan struct{}, concurrency)
+		for i := range storeKeys {
+			sem <- struct{}{}
+			wg.Add(1)
+			go func(i int) {
+				defer func() {
+					// a store failing to commit panics, the panic is propagated
+					// to the caller once all the commits have ended
+					if r := recover(); r != nil {
+						panicOnce.Do(func() { panicErr = r })
+					}
+					<-sem
+					wg.Done()
+				}()
+				commitStore(i)
+			}(i)
+		}
+		wg.Wait()
+		if panicErr != nil {
+			panic(panicErr)
+		}
+	}
+
+	storeInfos := make([]types.StoreInfo, 0, len(storeMap))
+	for i, key := range storeKeys {
+		storeType := storeMap[key].GetStoreType()
 		if storeType == types.StoreTypeTransient || storeType == types.StoreTypeMemory {
 			continue
 		}
@@ -1189,7 +1436,7 @@ func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore
 		if !removalMap[key] {
 			si := types.StoreInfo{}
 			si.Name = key.Name()
-			si.CommitId = commitID
+			si.CommitId = commitIDs[i]
 			storeInfos = append(storeInfos, si)
 		}
 	}
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
@@ -618,6 +619,54 @@ func TestMultiStore_PruningRestart(t *testing.T) {
 
 // TestUnevenStoresHeightCheck tests if loading root store correctly errors when
 // there's any module store with the wrong height
+func TestMultiStore_TimePruning(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewTimePruningOptions(10*time.Minute, 2, 10))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	// a block every minute, the heights of the last 10 minutes being kept
+	genesis := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	for height := int64(1); height <= 30; height++ {
+		ms.SetCommitHeader(cmtproto.Header{Height: height, Time: genesis.Add(time.Duration(height) * time.Minute)})
+		ms.Commit()
+	}
+
+	for v := int64(1); v <= 30; v++ {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		if v < 20 {
+			require.Error(t, err, "expected error when loading height: %d", v)
+		} else {
+			require.NoError(t, err, "expected no error when loading height: %d", v)
+		}
+	}
+}
+
+func TestMultiStore_SizePruning(t *testing.T) {
+	db := dbm.NewMemDB()
+	opts := pruningtypes.NewSizePruningOptions(1, 2, 10)
+	opts.PinnedHeights = []int64{25}
+	ms := newMultiStoreWithMounts(db, opts)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	// the database is always too large, an interval of heights is pruned at
+	// every interval, keeping the recent heights and up to the pinned height
+	ms.SetPruningSizeFunc(func() (uint64, error) { return 2, nil })
+	for height := int64(1); height <= 40; height++ {
+		ms.Commit()
+		if height == 20 {
+			for v := int64(1); v <= height; v++ {
+				_, err := ms.CacheMultiStoreWithVersion(v)
+				require.Equal(t, v <= 17, err != nil, "height: %d", v)
+			}
+		}
+	}
+
+	for v := int64(1); v <= 40; v++ {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.Equal(t, v < 25, err != nil, "height: %d", v)
+	}
+}
+
 func TestUnevenStoresHeightCheck(t *testing.T) {
 	var db dbm.DB = dbm.NewMemDB()
 	store := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
@@ -962,22 +1011,91 @@ func TestCommitStores(t *testing.T) {
 		},
 	}
 	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			storeMap, err := prepareStoreMap()
-			require.NoError(t, err)
-			store := storeMap[testStoreKey1].(*commitKVStoreStub)
-			for i := tc.committed; i > 0; i-- {
-				store.Commit()
-			}
-			store.Committed = 0
-			var version int64 = 1
-			removalMap := map[types.StoreKey]bool{}
-			res := commitStores(version, storeMap, removalMap)
-			for _, s := range res.StoreInfos {
-				require.Equal(t, version, s.CommitId.Version)
+		for _, concurrency := range []int{1, 4} {
+			t.Run(fmt.Sprintf("%s with concurrency %d", tc.name, concurrency), func(t *testing.T) {
+				storeMap, err := prepareStoreMap()
+				require.NoError(t, err)
+				store := storeMap[testStoreKey1].(*commitKVStoreStub)
+				for i := tc.committed; i > 0; i-- {
+					store.Commit()
+				}
+				store.Committed = 0
+				var version int64 = 1
+				removalMap := map[types.StoreKey]bool{}
+				res := commitStores(version, storeMap, removalMap, concurrency)
+				for _, s := range res.StoreInfos {
+					require.Equal(t, version, s.CommitId.Version)
+				}
+				require.Equal(t, version, res.Version)
+				require.Equal(t, tc.exptectCommit, store.Committed)
+			})
+		}
+	}
+}
+
+type panickingCommitStoreStub struct {
+	types.CommitKVStore
+}
+
+func (stub panickingCommitStoreStub) Commit() types.CommitID {
+	panic("commit failed")
+}
+
+func TestCommitStores_Panic(t *testing.T) {
+	storeMap, err := prepareStoreMap()
+	require.NoError(t, err)
+	storeMap[testStoreKey2] = panickingCommitStoreStub{storeMap[testStoreKey2]}
+
+	// the panic of a store commit is propagated once the other commits ended
+	require.PanicsWithValue(t, "commit failed", func() {
+		commitStores(1, storeMap, map[types.StoreKey]bool{}, 4)
+	})
+	require.Equal(t, 1, storeMap[testStoreKey1].(*commitKVStoreStub).Committed)
+}
+
+func TestCommitConcurrency(t *testing.T) {
+	newStore := func(db dbm.DB, concurrency int) *Store {
+		store := NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+		store.SetCommitConcurrency(concurrency)
+		for i := 0; i < 16; i++ {
+			store.MountStoreWithDB(types.NewKVStoreKey(fmt.Sprintf("iavl%d", i)), types.StoreTypeIAVL, nil)
+		}
+		store.MountStoreWithDB(types.NewTransientStoreKey("transient"), types.StoreTypeTransient, nil)
+		store.MountStoreWithDB(types.NewMemoryStoreKey("memory"), types.StoreTypeMemory, nil)
+		require.NoError(t, store.LoadLatestVersion())
+		return store
+	}
+
+	sequentialDB, concurrentDB := dbm.NewMemDB(), dbm.NewMemDB()
+	// the stores are committed sequentially by default
+	sequential, concurrent := newStore(sequentialDB, 0), newStore(concurrentDB, 8)
+
+	for version := int64(1); version <= 10; version++ {
+		for _, multi := range []*Store{sequential, concurrent} {
+			for i := 0; i < 16; i++ {
+				// each version writes to a different subset of the stores
+				if (i+int(version))%3 == 0 {
+					continue
+				}
+				store := multi.GetStoreByName(fmt.Sprintf("iavl%d", i)).(types.KVStore)
+				for j := int64(0); j < version; j++ {
+					store.Set([]byte(fmt.Sprintf("key%d-%d", version, j)), []byte(fmt.Sprintf("value%d", i)))
+				}
+				store.Delete([]byte(fmt.Sprintf("key%d-0", version-1)))
 			}
-			require.Equal(t, version, res.Version)
-			require.Equal(t, tc.exptectCommit, store.Committed)
-		})
+			multi.GetStoreByName("transient").(types.KVStore).Set([]byte("key"), []byte("value"))
+			multi.GetStoreByName("memory").(types.KVStore).Set([]byte("key"), []byte("value"))
+		}
+
+		// the stores committed concurrently have the same app hash as the stores
+		// committed sequentially
+		commitID := sequential.Commit()
+		require.Equal(t, commitID, concurrent.Commit())
+		require.Equal(t, sequential.lastCommitInfo, concurrent.lastCommitInfo)
+		require.Equal(t, version, commitID.Version)
 	}
+
+	// and so do the reloaded stores
+	require.Equal(t, sequential.LastCommitID(), newStore(concurrentDB, 8).LastCommitID())
+	require.Equal(t, newStore(sequentialDB, 1).LastCommitID(), sequential.LastCommitID())
 }
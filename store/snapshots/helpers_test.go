@@ -171,6 +171,63 @@ func (m *mockSnapshotter) SetSnapshotInterval(snapshotInterval uint64) {
 	m.snapshotInterval = snapshotInterval
 }
 
+// mockDeltaSnapshotter is a mockSnapshotter supporting delta snapshots, the items
+// of the delta snapshot at a given height being the ones in deltaItems.
+type mockDeltaSnapshotter struct {
+	mockSnapshotter
+	deltaItems      map[uint64][][]byte
+	deltaErr        error
+	restoredHeights []uint64
+}
+
+var _ snapshottypes.DeltaSnapshotter = (*mockDeltaSnapshotter)(nil)
+
+func (m *mockDeltaSnapshotter) Restore(
+	height uint64, format uint32, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	m.restoredHeights = append(m.restoredHeights, height)
+	return m.mockSnapshotter.Restore(height, format, protoReader)
+}
+
+func (m *mockDeltaSnapshotter) SnapshotDelta(parentHeight, height uint64, protoWriter protoio.Writer) error {
+	if m.deltaErr != nil {
+		return m.deltaErr
+	}
+	for _, item := range m.deltaItems[height] {
+		if err := snapshottypes.WriteExtensionPayload(protoWriter, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockDeltaSnapshotter) RestoreDelta(
+	parentHeight, height uint64, format uint32, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	if len(m.restoredHeights) == 0 || m.restoredHeights[len(m.restoredHeights)-1] != parentHeight {
+		return snapshottypes.SnapshotItem{}, errors.New("delta restored on top of the wrong height")
+	}
+	m.restoredHeights = append(m.restoredHeights, height)
+
+	var item snapshottypes.SnapshotItem
+	for {
+		item.Reset()
+		err := protoReader.ReadMsg(&item)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "invalid protobuf message")
+		}
+		payload := item.GetExtensionPayload()
+		if payload == nil {
+			break
+		}
+		m.items = append(m.items, payload.Payload)
+	}
+
+	return item, nil
+}
+
 type mockErrorSnapshotter struct{}
 
 var _ snapshottypes.Snapshotter = (*mockErrorSnapshotter)(nil)
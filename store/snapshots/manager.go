@@ -8,6 +8,7 @@ import (
 	"io"
 	"math"
 	"os"
+	"runtime"
 	"sort"
 	"sync"
 
@@ -181,6 +182,22 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
+	if parent, err := m.deltaParent(latest); err != nil {
+		return nil, err
+	} else if parent != nil {
+		snapshot, err := m.createDelta(parent.Height, height)
+		if err == nil {
+			return snapshot, nil
+		}
+
+		// the state changes since the parent snapshot may not be available anymore, for
+		// instance because the heights in between were pruned.
+		m.logger.Error("failed to create delta snapshot, creating a full snapshot", "height", height, "parent_height", parent.Height, "err", err)
+		if err := m.store.Delete(height, types.CurrentFormat); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to delete delta snapshot")
+		}
+	}
+
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
 	go m.createSnapshot(height, ch)
@@ -188,9 +205,71 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 	return m.store.Save(height, types.CurrentFormat, ch)
 }
 
+// deltaParent returns the snapshot a new snapshot must be a delta of, or nil if a full snapshot
+// must be taken. A delta snapshot is taken on top of the latest snapshot as long as there are
+// less than MaxDeltas delta snapshots since the last full snapshot.
+func (m *Manager) deltaParent(latest *types.Snapshot) (*types.Snapshot, error) {
+	if m.opts.MaxDeltas == 0 || latest == nil || latest.Format != types.CurrentFormat {
+		return nil, nil
+	}
+	if _, ok := m.multistore.(types.DeltaSnapshotter); !ok {
+		return nil, nil
+	}
+
+	chain, err := m.snapshotChain(latest)
+	if err != nil {
+		return nil, err
+	}
+	// the chain contains the full snapshot and its deltas
+	if uint32(len(chain)) > m.opts.MaxDeltas {
+		return nil, nil
+	}
+
+	return latest, nil
+}
+
+// createDelta creates a delta snapshot of the state changes since the snapshot at parentHeight.
+func (m *Manager) createDelta(parentHeight, height uint64) (*types.Snapshot, error) {
+	ch := make(chan io.ReadCloser)
+	go m.createSnapshotWith(height, ch, func(streamWriter *StreamWriter) error {
+		return m.multistore.(types.DeltaSnapshotter).SnapshotDelta(parentHeight, height, streamWriter)
+	})
+
+	return m.store.SaveDelta(parentHeight, height, types.CurrentFormat, ch)
+}
+
+// snapshotChain returns the snapshots needed to restore the given snapshot, starting with a full
+// snapshot and followed by delta snapshots, the last one being the given snapshot.
+func (m *Manager) snapshotChain(snapshot *types.Snapshot) ([]*types.Snapshot, error) {
+	chain := []*types.Snapshot{snapshot}
+	for snapshot.IsDelta() {
+		parent, err := m.store.Get(snapshot.Metadata.ParentHeight, snapshot.Format)
+		if err != nil {
+			return nil, err
+		}
+		if parent == nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidMetadata, "parent snapshot at height %v of snapshot at height %v doesn't exist",
+				snapshot.Metadata.ParentHeight, snapshot.Height)
+		}
+
+		chain = append([]*types.Snapshot{parent}, chain...)
+		snapshot = parent
+	}
+
+	return chain, nil
+}
+
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
 // the produced chunks are written to the channel.
 func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
+	m.createSnapshotWith(height, ch, func(streamWriter *StreamWriter) error {
+		return m.multistore.Snapshot(height, streamWriter)
+	})
+}
+
+// createSnapshotWith writes the multistore snapshot items with the given function, followed by
+// the extension snapshots. The produced chunks are written to the channel.
+func (m *Manager) createSnapshotWith(height uint64, ch chan<- io.ReadCloser, snapshotMultistore func(*StreamWriter) error) {
 	streamWriter := NewStreamWriter(ch)
 	if streamWriter == nil {
 		return
@@ -201,7 +280,7 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 		}
 	}()
 
-	if err := m.multistore.Snapshot(height, streamWriter); err != nil {
+	if err := snapshotMultistore(streamWriter); err != nil {
 		streamWriter.CloseWithError(err)
 		return
 	}
@@ -278,6 +357,10 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	if snapshot.Format != types.CurrentFormat {
 		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
+	// a delta snapshot can only be restored on top of its parent, see RestoreLocalSnapshot
+	if snapshot.IsDelta() {
+		return errorsmod.Wrapf(types.ErrInvalidMetadata, "cannot restore delta snapshot at height %v on its own", snapshot.Height)
+	}
 	if snapshot.Height == 0 {
 		return errorsmod.Wrap(storetypes.ErrLogic, "cannot restore snapshot at height 0")
 	}
@@ -336,6 +419,81 @@ func (m *Manager) loadChunkStream(height uint64, format uint32, chunkIDs <-chan
 	return chunks
 }
 
+// verifiedChunk is a chunk loaded from disk along with the result of its hash verification.
+type verifiedChunk struct {
+	body []byte
+	err  error
+}
+
+// loadVerifiedChunks loads the chunks of a local snapshot, verifying their hashes concurrently
+// while preserving their order, so that the restore doesn't wait for the verification. A chunk
+// failing the verification ends the stream with an ErrChunkHashMismatch error.
+func (m *Manager) loadVerifiedChunks(snapshot *types.Snapshot) <-chan io.ReadCloser {
+	// results queues the verification of the chunks in order, bounding the number of chunks
+	// loaded in memory
+	results := make(chan chan verifiedChunk, runtime.NumCPU())
+	go func() {
+		defer close(results)
+		for i := uint32(0); i < snapshot.Chunks; i++ {
+			result := make(chan verifiedChunk, 1)
+			results <- result
+			go func(index uint32) {
+				result <- m.loadVerifiedChunk(snapshot, index)
+			}(i)
+		}
+	}()
+
+	chunks := make(chan io.ReadCloser, chunkBufferSize)
+	go func() {
+		defer close(chunks)
+		failed := false
+		for result := range results {
+			chunk := <-result
+			// keep consuming the results on failure so that the verification goroutines end
+			if failed {
+				continue
+			}
+			if chunk.err != nil {
+				failed = true
+				pr, pw := io.Pipe()
+				_ = pw.CloseWithError(chunk.err)
+				chunks <- pr
+				continue
+			}
+			chunks <- io.NopCloser(bytes.NewReader(chunk.body))
+		}
+	}()
+
+	return chunks
+}
+
+// loadVerifiedChunk loads a chunk of a local snapshot and verifies its hash.
+func (m *Manager) loadVerifiedChunk(snapshot *types.Snapshot, index uint32) verifiedChunk {
+	if int(index) >= len(snapshot.Metadata.ChunkHashes) {
+		return verifiedChunk{err: errorsmod.Wrapf(types.ErrInvalidMetadata, "no hash for chunk %d", index)}
+	}
+
+	file, err := m.store.loadChunkFile(snapshot.Height, snapshot.Format, index)
+	if err != nil {
+		return verifiedChunk{err: errorsmod.Wrapf(err, "load chunk %d", index)}
+	}
+	defer file.Close()
+
+	body, err := io.ReadAll(file)
+	if err != nil {
+		return verifiedChunk{err: errorsmod.Wrapf(err, "read chunk %d", index)}
+	}
+
+	hash := sha256.Sum256(body)
+	expected := snapshot.Metadata.ChunkHashes[index]
+	if !bytes.Equal(hash[:], expected) {
+		return verifiedChunk{err: errorsmod.Wrapf(types.ErrChunkHashMismatch,
+			"chunk %d: expected %x, got %x", index, expected, hash)}
+	}
+
+	return verifiedChunk{body: body}
+}
+
 // doRestoreSnapshot do the heavy work of snapshot restoration after preliminary checks on request have passed.
 func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
 	dir := m.store.pathSnapshot(snapshot.Height, snapshot.Format)
@@ -363,7 +521,15 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		return payload.Payload, nil
 	}
 
-	nextItem, err = m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
+	if snapshot.IsDelta() {
+		deltaSnapshotter, ok := m.multistore.(types.DeltaSnapshotter)
+		if !ok {
+			return errorsmod.Wrap(storetypes.ErrLogic, "multistore doesn't support delta snapshots")
+		}
+		nextItem, err = deltaSnapshotter.RestoreDelta(snapshot.Metadata.ParentHeight, snapshot.Height, snapshot.Format, streamReader)
+	} else {
+		nextItem, err = m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
+	}
 	if err != nil {
 		return errorsmod.Wrap(err, "multistore restore")
 	}
@@ -397,7 +563,9 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 }
 
 // RestoreChunk adds a chunk to an active snapshot restoration, mirroring ABCI ApplySnapshotChunk.
-// Chunks must be given until the restore is complete, returning true, or a chunk errors.
+// Chunks must be given until the restore is complete, returning true, or a chunk errors. The
+// hash of the chunk is verified before returning, unlike RestoreLocalSnapshot which verifies the
+// chunks concurrently, as the chunks are received one at a time.
 func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
@@ -425,7 +593,7 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	expected := m.restoreSnapshot.Metadata.ChunkHashes[m.restoreChunkIndex]
 	if !bytes.Equal(hash[:], expected) {
 		return false, errorsmod.Wrapf(types.ErrChunkHashMismatch,
-			"expected %x, got %x", hash, expected)
+			"expected %x, got %x", expected, hash)
 	}
 
 	if err := m.store.saveChunkContent(chunk, m.restoreChunkIndex, m.restoreSnapshot); err != nil {
@@ -460,9 +628,11 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	return false, nil
 }
 
-// RestoreLocalSnapshot restores app state from a local snapshot.
+// RestoreLocalSnapshot restores app state from a local snapshot. A delta snapshot is restored by
+// restoring the full snapshot it is based on, followed by all the delta snapshots up to it. The
+// chunk hashes are verified concurrently with the restore.
 func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
-	snapshot, ch, err := m.store.Load(height, format)
+	snapshot, err := m.store.Get(height, format)
 	if err != nil {
 		return err
 	}
@@ -471,6 +641,11 @@ func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 		return fmt.Errorf("snapshot doesn't exist, height: %d, format: %d", height, format)
 	}
 
+	chain, err := m.snapshotChain(snapshot)
+	if err != nil {
+		return err
+	}
+
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
@@ -480,7 +655,15 @@ func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 	}
 	defer m.endLocked()
 
-	return m.doRestoreSnapshot(*snapshot, ch)
+	for _, snapshot := range chain {
+		ch := m.loadVerifiedChunks(snapshot)
+		if err := m.doRestoreSnapshot(*snapshot, ch); err != nil {
+			DrainChunks(ch)
+			return errorsmod.Wrapf(err, "restore snapshot at height %v", snapshot.Height)
+		}
+	}
+
+	return nil
 }
 
 // sortedExtensionNames sort extension names for deterministic iteration.
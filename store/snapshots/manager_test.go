@@ -2,6 +2,7 @@ package snapshots_test
 
 import (
 	"errors"
+	"os"
 	"testing"
 
 	db "github.com/cosmos/cosmos-db"
@@ -247,6 +248,75 @@ func TestManager_Restore(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestManager_TakeDelta(t *testing.T) {
+	store, err := snapshots.NewStore(db.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	snapshotter := &mockDeltaSnapshotter{
+		mockSnapshotter: mockSnapshotter{
+			items:         [][]byte{{1, 2, 3}},
+			prunedHeights: make(map[int64]struct{}),
+		},
+		deltaItems: map[uint64][][]byte{
+			2: {{4, 5, 6}},
+			3: {{7, 8, 9}},
+		},
+	}
+	deltaOpts := types.NewSnapshotOptions(1500, 2)
+	deltaOpts.MaxDeltas = 2
+	manager := snapshots.NewManager(store, deltaOpts, snapshotter, nil, log.NewNopLogger())
+
+	// the first snapshot is a full one, the next ones are deltas of the previous one
+	// until there are MaxDeltas deltas since the full snapshot
+	for _, tc := range []struct{ height, parentHeight uint64 }{{1, 0}, {2, 1}, {3, 2}, {4, 0}} {
+		snapshot, err := manager.Create(tc.height)
+		require.NoError(t, err)
+		require.Equal(t, tc.parentHeight, snapshot.Metadata.ParentHeight, "height %v", tc.height)
+	}
+
+	// a full snapshot is created if the delta snapshot fails
+	snapshotter.deltaErr = errors.New("versions pruned")
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+	require.False(t, snapshot.IsDelta())
+
+	// a delta snapshot cannot be restored through state sync
+	delta, err := store.Get(3, types.CurrentFormat)
+	require.NoError(t, err)
+	require.True(t, delta.IsDelta())
+	target := &mockDeltaSnapshotter{mockSnapshotter: mockSnapshotter{prunedHeights: make(map[int64]struct{})}}
+	manager = snapshots.NewManager(store, deltaOpts, target, nil, log.NewNopLogger())
+	require.ErrorIs(t, manager.Restore(*delta), types.ErrInvalidMetadata)
+
+	// but it can be restored locally on top of its parents
+	require.NoError(t, manager.RestoreLocalSnapshot(3, types.CurrentFormat))
+	require.Equal(t, []uint64{1, 2, 3}, target.restoredHeights)
+	require.Equal(t, [][]byte{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}, target.items)
+}
+
+func TestManager_RestoreLocalSnapshot(t *testing.T) {
+	store, err := snapshots.NewStore(db.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	items := [][]byte{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+	source := &mockSnapshotter{items: items, prunedHeights: make(map[int64]struct{})}
+	snapshot, err := snapshots.NewManager(store, opts, source, nil, log.NewNopLogger()).Create(1)
+	require.NoError(t, err)
+
+	target := &mockSnapshotter{prunedHeights: make(map[int64]struct{})}
+	manager := snapshots.NewManager(store, opts, target, nil, log.NewNopLogger())
+	require.NoError(t, manager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format))
+	require.Equal(t, items, target.items)
+
+	// a corrupted chunk fails the restore
+	require.NoError(t, os.WriteFile(store.PathChunk(snapshot.Height, snapshot.Format, 0), []byte{1}, 0o600))
+	target.items = nil
+	err = manager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format)
+	require.ErrorIs(t, err, types.ErrChunkHashMismatch)
+
+	// the restore operation ended
+	_, err = manager.Prune(1)
+	require.NoError(t, err)
+}
+
 func TestManager_TakeError(t *testing.T) {
 	snapshotter := &mockErrorSnapshotter{}
 	store, err := snapshots.NewStore(db.NewMemDB(), GetTempDir(t))
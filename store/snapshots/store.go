@@ -192,13 +192,30 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 	pruned := uint64(0)
 	prunedHeights := make(map[uint64]bool)
 	skip := make(map[uint64]bool)
+	// the parents of the retained delta snapshots are retained too, by height and format
+	parents := make(map[uint64]map[uint32]bool)
+	retained := make(map[uint64]bool)
 	for ; iter.Valid(); iter.Next() {
 		height, format, err := decodeKey(iter.Key())
 		if err != nil {
 			return 0, errors.Wrap(err, "failed to prune snapshots")
 		}
-		if skip[height] || uint32(len(skip)) < retain {
-			skip[height] = true
+		if skip[height] || uint32(len(skip)) < retain || parents[height][format] {
+			if !parents[height][format] {
+				skip[height] = true
+			}
+			retained[height] = true
+
+			snapshot := &types.Snapshot{}
+			if err := proto.Unmarshal(iter.Value(), snapshot); err != nil {
+				return 0, errors.Wrap(err, "failed to decode snapshot info")
+			}
+			if snapshot.IsDelta() {
+				if parents[snapshot.Metadata.ParentHeight] == nil {
+					parents[snapshot.Metadata.ParentHeight] = make(map[uint32]bool)
+				}
+				parents[snapshot.Metadata.ParentHeight][format] = true
+			}
 			continue
 		}
 		err = s.Delete(height, format)
@@ -209,9 +226,9 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 		prunedHeights[height] = true
 	}
 	// Since Delete() deletes a specific format, while we want to prune a height, we clean up
-	// the height directory as well
+	// the height directory as well, unless a snapshot of another format is retained at that height
 	for height, ok := range prunedHeights {
-		if ok {
+		if ok && !retained[height] {
 			err = os.Remove(s.pathHeight(height))
 			if err != nil {
 				return 0, errors.Wrapf(err, "failed to remove snapshot directory for height %v", height)
@@ -224,6 +241,25 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 // Save saves a snapshot to disk, returning it.
 func (s *Store) Save(
 	height uint64, format uint32, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	return s.save(height, format, 0, chunks)
+}
+
+// SaveDelta saves a delta snapshot based on the snapshot at parentHeight to disk, returning it.
+func (s *Store) SaveDelta(
+	parentHeight, height uint64, format uint32, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	if parentHeight == 0 || parentHeight >= height {
+		DrainChunks(chunks)
+		return nil, errors.Wrapf(storetypes.ErrLogic, "invalid delta snapshot parent height %v for height %v", parentHeight, height)
+	}
+
+	return s.save(height, format, parentHeight, chunks)
+}
+
+// save saves a snapshot to disk, returning it. The parent height is zero for a full snapshot.
+func (s *Store) save(
+	height uint64, format uint32, parentHeight uint64, chunks <-chan io.ReadCloser,
 ) (*types.Snapshot, error) {
 	defer DrainChunks(chunks)
 	if height == 0 {
@@ -256,6 +292,9 @@ func (s *Store) Save(
 	snapshot := &types.Snapshot{
 		Height: height,
 		Format: format,
+		Metadata: types.Metadata{
+			ParentHeight: parentHeight,
+		},
 	}
 
 	dirCreated := false
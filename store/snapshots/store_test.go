@@ -331,3 +331,37 @@ func TestStore_Save(t *testing.T) {
 	require.NoError(t, err)
 	close(ch)
 }
+
+func TestStore_PruneDelta(t *testing.T) {
+	store, err := snapshots.NewStore(db.NewMemDB(), GetTempDir(t))
+	require.NoError(t, err)
+
+	_, err = store.Save(1, 1, makeChunks([][]byte{{1, 1, 0}}))
+	require.NoError(t, err)
+	_, err = store.Save(2, 1, makeChunks([][]byte{{2, 1, 0}}))
+	require.NoError(t, err)
+	_, err = store.Save(2, 2, makeChunks([][]byte{{2, 2, 0}}))
+	require.NoError(t, err)
+	_, err = store.SaveDelta(2, 3, 1, makeChunks([][]byte{{3, 1, 0}}))
+	require.NoError(t, err)
+	_, err = store.SaveDelta(3, 4, 1, makeChunks([][]byte{{4, 1, 0}}))
+	require.NoError(t, err)
+
+	// a delta snapshot must have a lower parent height
+	_, err = store.SaveDelta(4, 4, 1, makeChunks([][]byte{{4, 1, 0}}))
+	require.Error(t, err)
+
+	// the parents of the retained delta snapshot are retained, whatever the retained heights
+	pruned, err := store.Prune(1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, pruned)
+
+	snapshots, err := store.List()
+	require.NoError(t, err)
+	heights := [][2]uint64{}
+	for _, snapshot := range snapshots {
+		heights = append(heights, [2]uint64{snapshot.Height, snapshot.Metadata.ParentHeight})
+	}
+	require.Equal(t, [][2]uint64{{4, 3}, {3, 2}, {2, 0}}, heights)
+	require.EqualValues(t, 1, snapshots[2].Format)
+}
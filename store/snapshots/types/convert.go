@@ -37,3 +37,9 @@ func (s Snapshot) ToABCI() (abci.Snapshot, error) {
 	}
 	return out, nil
 }
+
+// IsDelta returns true if the snapshot is a delta snapshot, i.e. it only contains the state
+// changes since its parent snapshot.
+func (s Snapshot) IsDelta() bool {
+	return s.Metadata.ParentHeight != 0
+}
@@ -8,6 +8,12 @@ type SnapshotOptions struct {
 
 	// KeepRecent defines how many snapshots to keep in heights.
 	KeepRecent uint32
+
+	// MaxDeltas defines how many delta snapshots can be taken in a row on top of a full
+	// snapshot. A delta snapshot only contains the state changes since the previous snapshot,
+	// and requires the store versions in between to not be pruned. Zero disables delta
+	// snapshots.
+	MaxDeltas uint32
 }
 
 func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
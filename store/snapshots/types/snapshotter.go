@@ -26,6 +26,20 @@ type Snapshotter interface {
 	Restore(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
 }
 
+// DeltaSnapshotter is a Snapshotter that can also create and restore delta snapshots, which only
+// contain the state changes since a parent snapshot.
+type DeltaSnapshotter interface {
+	Snapshotter
+
+	// SnapshotDelta writes the state changes between parentHeight and height into the protobuf
+	// writer.
+	SnapshotDelta(parentHeight, height uint64, protoWriter protoio.Writer) error
+
+	// RestoreDelta restores a delta snapshot on top of the state at parentHeight, taking the
+	// reader of protobuf message stream as input.
+	RestoreDelta(parentHeight, height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
+}
+
 // ExtensionPayloadReader read extension payloads,
 // it returns io.EOF when reached either end of stream or the extension boundaries.
 type ExtensionPayloadReader = func() ([]byte, error)
@@ -0,0 +1,650 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/store/streaming/abci/changes.proto
+
+package abci
+
+import (
+	context "context"
+	types "cosmossdk.io/store/types"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StreamChangesRequest is the request type for the StreamChanges RPC method.
+//
+// Since: cosmos-sdk 0.51
+type StreamChangesRequest struct {
+	// from_height is the first height to stream, the earliest retained height is used if it is 0.
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+}
+
+func (m *StreamChangesRequest) Reset()         { *m = StreamChangesRequest{} }
+func (m *StreamChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamChangesRequest) ProtoMessage()    {}
+func (*StreamChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cd902a4df7c9f4b, []int{0}
+}
+func (m *StreamChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamChangesRequest.Merge(m, src)
+}
+func (m *StreamChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamChangesRequest proto.InternalMessageInfo
+
+func (m *StreamChangesRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+// StreamChangesResponse is the response type for the StreamChanges RPC method, it contains the
+// change set of a height.
+//
+// Since: cosmos-sdk 0.51
+type StreamChangesResponse struct {
+	BlockHeight int64                `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	ChangeSet   []*types.StoreKVPair `protobuf:"bytes,2,rep,name=change_set,json=changeSet,proto3" json:"change_set,omitempty"`
+}
+
+func (m *StreamChangesResponse) Reset()         { *m = StreamChangesResponse{} }
+func (m *StreamChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamChangesResponse) ProtoMessage()    {}
+func (*StreamChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cd902a4df7c9f4b, []int{1}
+}
+func (m *StreamChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamChangesResponse.Merge(m, src)
+}
+func (m *StreamChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamChangesResponse proto.InternalMessageInfo
+
+func (m *StreamChangesResponse) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *StreamChangesResponse) GetChangeSet() []*types.StoreKVPair {
+	if m != nil {
+		return m.ChangeSet
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*StreamChangesRequest)(nil), "cosmos.store.streaming.abci.StreamChangesRequest")
+	proto.RegisterType((*StreamChangesResponse)(nil), "cosmos.store.streaming.abci.StreamChangesResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/store/streaming/abci/changes.proto", fileDescriptor_4cd902a4df7c9f4b)
+}
+
+var fileDescriptor_4cd902a4df7c9f4b = []byte{
+	// 299 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x31, 0x4b, 0xc3, 0x40,
+	0x1c, 0xc5, 0x7b, 0x16, 0x04, 0xaf, 0xba, 0x1c, 0x0a, 0xa5, 0xc2, 0xd9, 0x14, 0x87, 0xba, 0x5c,
+	0x4c, 0x1c, 0x1c, 0x5c, 0xc4, 0x2e, 0x82, 0x8b, 0x24, 0xe0, 0xe0, 0x52, 0x2e, 0xf1, 0x6f, 0x72,
+	0xb4, 0xc9, 0xd5, 0xbb, 0xb3, 0x38, 0xf8, 0x19, 0xc4, 0x8f, 0xe5, 0xd8, 0xd1, 0x51, 0x92, 0x2f,
+	0x22, 0xb9, 0x2b, 0x42, 0xa4, 0x08, 0x8e, 0xf7, 0x78, 0xbf, 0xc7, 0xff, 0xdd, 0xc3, 0x27, 0xa9,
+	0xd4, 0x85, 0xd4, 0xbe, 0x36, 0x52, 0x81, 0xaf, 0x8d, 0x02, 0x5e, 0x88, 0x32, 0xf3, 0x79, 0x92,
+	0x0a, 0x3f, 0xcd, 0x79, 0x99, 0x81, 0x66, 0x0b, 0x25, 0x8d, 0x24, 0x87, 0xce, 0xca, 0xac, 0x95,
+	0xfd, 0x58, 0x59, 0x63, 0x1d, 0x1c, 0xb7, 0x72, 0x96, 0x41, 0x02, 0x86, 0x07, 0xfe, 0x5c, 0x68,
+	0x03, 0x65, 0x63, 0xb2, 0x11, 0xa3, 0x73, 0xbc, 0x1f, 0x5b, 0x6e, 0xe2, 0x92, 0x23, 0x78, 0x7a,
+	0x06, 0x6d, 0xc8, 0x11, 0xee, 0x3d, 0x2a, 0x59, 0x4c, 0x73, 0x10, 0x59, 0x6e, 0xfa, 0x68, 0x88,
+	0xc6, 0xdd, 0x08, 0x37, 0xd2, 0xb5, 0x55, 0x46, 0xaf, 0xf8, 0xe0, 0x17, 0xa8, 0x17, 0xb2, 0xd4,
+	0x40, 0x3c, 0xbc, 0x9b, 0xcc, 0x65, 0x3a, 0x6b, 0xa3, 0x3d, 0xab, 0x39, 0x96, 0x5c, 0x62, 0xec,
+	0x8a, 0x4c, 0x35, 0x98, 0xfe, 0xd6, 0xb0, 0x3b, 0xee, 0x85, 0x1e, 0x6b, 0x95, 0x59, 0xdf, 0xcb,
+	0xe2, 0xe6, 0x75, 0x73, 0x77, 0xcb, 0x85, 0x8a, 0x76, 0x1c, 0x14, 0x83, 0x09, 0xdf, 0x10, 0x26,
+	0xb1, 0xe1, 0x06, 0x26, 0x6b, 0x49, 0x2d, 0x45, 0x0a, 0xe4, 0x05, 0xef, 0xb5, 0x8e, 0x22, 0x01,
+	0xfb, 0xe3, 0x8b, 0xd8, 0xa6, 0xe6, 0x83, 0xf0, 0x3f, 0x88, 0xeb, 0x7c, 0x8a, 0xae, 0x2e, 0x3e,
+	0x2a, 0x8a, 0x56, 0x15, 0x45, 0x5f, 0x15, 0x45, 0xef, 0x35, 0xed, 0xac, 0x6a, 0xda, 0xf9, 0xac,
+	0x69, 0xe7, 0xde, 0x73, 0x71, 0xfa, 0x61, 0xc6, 0x84, 0xdc, 0xb8, 0x6a, 0xb2, 0x6d, 0xb7, 0x38,
+	0xfb, 0x1e, 0x00, 0x9f, 0xb5, 0x7b, 0xfd, 0xfb, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// StateChangeServiceClient is the client API for StateChangeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StateChangeServiceClient interface {
+	// StreamChanges streams the change sets of the retained heights starting at from_height,
+	// then the change sets of the new heights as they are committed.
+	StreamChanges(ctx context.Context, in *StreamChangesRequest, opts ...grpc.CallOption) (StateChangeService_StreamChangesClient, error)
+}
+
+type stateChangeServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewStateChangeServiceClient(cc grpc1.ClientConn) StateChangeServiceClient {
+	return &stateChangeServiceClient{cc}
+}
+
+func (c *stateChangeServiceClient) StreamChanges(ctx context.Context, in *StreamChangesRequest, opts ...grpc.CallOption) (StateChangeService_StreamChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_StateChangeService_serviceDesc.Streams[0], "/cosmos.store.streaming.abci.StateChangeService/StreamChanges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &stateChangeServiceStreamChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StateChangeService_StreamChangesClient interface {
+	Recv() (*StreamChangesResponse, error)
+	grpc.ClientStream
+}
+
+type stateChangeServiceStreamChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *stateChangeServiceStreamChangesClient) Recv() (*StreamChangesResponse, error) {
+	m := new(StreamChangesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StateChangeServiceServer is the server API for StateChangeService service.
+type StateChangeServiceServer interface {
+	// StreamChanges streams the change sets of the retained heights starting at from_height,
+	// then the change sets of the new heights as they are committed.
+	StreamChanges(*StreamChangesRequest, StateChangeService_StreamChangesServer) error
+}
+
+// UnimplementedStateChangeServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedStateChangeServiceServer struct {
+}
+
+func (*UnimplementedStateChangeServiceServer) StreamChanges(req *StreamChangesRequest, srv StateChangeService_StreamChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamChanges not implemented")
+}
+
+func RegisterStateChangeServiceServer(s grpc1.Server, srv StateChangeServiceServer) {
+	s.RegisterService(&_StateChangeService_serviceDesc, srv)
+}
+
+func _StateChangeService_StreamChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StateChangeServiceServer).StreamChanges(m, &stateChangeServiceStreamChangesServer{stream})
+}
+
+type StateChangeService_StreamChangesServer interface {
+	Send(*StreamChangesResponse) error
+	grpc.ServerStream
+}
+
+type stateChangeServiceStreamChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *stateChangeServiceStreamChangesServer) Send(m *StreamChangesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _StateChangeService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.store.streaming.abci.StateChangeService",
+	HandlerType: (*StateChangeServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamChanges",
+			Handler:       _StateChangeService_StreamChanges_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cosmos/store/streaming/abci/changes.proto",
+}
+
+func (m *StreamChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		i = encodeVarintChanges(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChangeSet) > 0 {
+		for iNdEx := len(m.ChangeSet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChangeSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChanges(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintChanges(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintChanges(dAtA []byte, offset int, v uint64) int {
+	offset -= sovChanges(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StreamChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovChanges(uint64(m.FromHeight))
+	}
+	return n
+}
+
+func (m *StreamChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovChanges(uint64(m.BlockHeight))
+	}
+	if len(m.ChangeSet) > 0 {
+		for _, e := range m.ChangeSet {
+			l = e.Size()
+			n += 1 + l + sovChanges(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovChanges(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozChanges(x uint64) (n int) {
+	return sovChanges(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StreamChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChanges
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChanges
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChanges(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChanges
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamChangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChanges
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamChangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChanges
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChanges
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChanges
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChanges
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangeSet = append(m.ChangeSet, &types.StoreKVPair{})
+			if err := m.ChangeSet[len(m.ChangeSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChanges(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChanges
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipChanges(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowChanges
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChanges
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChanges
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthChanges
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupChanges
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthChanges
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthChanges        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowChanges          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupChanges = fmt.Errorf("proto: unexpected end of group")
+)
@@ -218,11 +218,101 @@ func (m *ListenCommitResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ListenCommitResponse proto.InternalMessageInfo
 
+// StreamRecord is a record of the ABCI events streamed by the in-process listeners of the
+// streaming package, e.g. a length-prefixed message of a streaming file.
+//
+// Since: cosmos-sdk 0.51
+type StreamRecord struct {
+	// Types that are valid to be assigned to Event:
+	//	*StreamRecord_FinalizeBlock
+	//	*StreamRecord_Commit
+	Event isStreamRecord_Event `protobuf_oneof:"event"`
+}
+
+func (m *StreamRecord) Reset()         { *m = StreamRecord{} }
+func (m *StreamRecord) String() string { return proto.CompactTextString(m) }
+func (*StreamRecord) ProtoMessage()    {}
+func (*StreamRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b98083eb9315fb6, []int{4}
+}
+func (m *StreamRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamRecord.Merge(m, src)
+}
+func (m *StreamRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamRecord proto.InternalMessageInfo
+
+type isStreamRecord_Event interface {
+	isStreamRecord_Event()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type StreamRecord_FinalizeBlock struct {
+	FinalizeBlock *ListenFinalizeBlockRequest `protobuf:"bytes,1,opt,name=finalize_block,json=finalizeBlock,proto3,oneof" json:"finalize_block,omitempty"`
+}
+type StreamRecord_Commit struct {
+	Commit *ListenCommitRequest `protobuf:"bytes,2,opt,name=commit,proto3,oneof" json:"commit,omitempty"`
+}
+
+func (*StreamRecord_FinalizeBlock) isStreamRecord_Event() {}
+func (*StreamRecord_Commit) isStreamRecord_Event()        {}
+
+func (m *StreamRecord) GetEvent() isStreamRecord_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *StreamRecord) GetFinalizeBlock() *ListenFinalizeBlockRequest {
+	if x, ok := m.GetEvent().(*StreamRecord_FinalizeBlock); ok {
+		return x.FinalizeBlock
+	}
+	return nil
+}
+
+func (m *StreamRecord) GetCommit() *ListenCommitRequest {
+	if x, ok := m.GetEvent().(*StreamRecord_Commit); ok {
+		return x.Commit
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*StreamRecord) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*StreamRecord_FinalizeBlock)(nil),
+		(*StreamRecord_Commit)(nil),
+	}
+}
+
 func init() {
 	proto.RegisterType((*ListenFinalizeBlockRequest)(nil), "cosmos.store.streaming.abci.ListenFinalizeBlockRequest")
 	proto.RegisterType((*ListenFinalizeBlockResponse)(nil), "cosmos.store.streaming.abci.ListenFinalizeBlockResponse")
 	proto.RegisterType((*ListenCommitRequest)(nil), "cosmos.store.streaming.abci.ListenCommitRequest")
 	proto.RegisterType((*ListenCommitResponse)(nil), "cosmos.store.streaming.abci.ListenCommitResponse")
+	proto.RegisterType((*StreamRecord)(nil), "cosmos.store.streaming.abci.StreamRecord")
 }
 
 func init() {
@@ -230,33 +320,36 @@ func init() {
 }
 
 var fileDescriptor_7b98083eb9315fb6 = []byte{
-	// 409 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x31, 0x6f, 0xda, 0x40,
-	0x14, 0xc7, 0x31, 0x96, 0x2a, 0xf5, 0x60, 0x3a, 0xaa, 0x0a, 0x19, 0xd5, 0x05, 0xab, 0x45, 0x4c,
-	0xe7, 0x9a, 0x0e, 0x20, 0x75, 0x69, 0x41, 0xaa, 0x5a, 0xb5, 0x43, 0x05, 0x52, 0x87, 0x2c, 0xc8,
-	0x36, 0x4f, 0xe6, 0x04, 0xf6, 0x99, 0xbb, 0x0b, 0x52, 0xf2, 0x09, 0xb2, 0x25, 0x4b, 0x3e, 0x46,
-	0xbe, 0x47, 0x46, 0xc6, 0x8c, 0x11, 0x7c, 0x91, 0xc8, 0x77, 0x84, 0x60, 0x05, 0xa2, 0x30, 0xf2,
-	0xee, 0xff, 0x7b, 0xef, 0x77, 0xbc, 0x33, 0x6a, 0x86, 0x4c, 0xc4, 0x4c, 0xb8, 0x42, 0x32, 0x0e,
-	0xae, 0x90, 0x1c, 0xfc, 0x98, 0x26, 0x91, 0xeb, 0x07, 0x21, 0x75, 0x23, 0x9e, 0x86, 0x24, 0xe5,
-	0x4c, 0x32, 0x5c, 0xd3, 0x39, 0xa2, 0x72, 0x64, 0x9b, 0x23, 0x59, 0xce, 0xaa, 0x49, 0x48, 0xc6,
-	0xc0, 0x63, 0x9a, 0x48, 0x0d, 0xca, 0xb3, 0x14, 0x84, 0x26, 0xad, 0x4f, 0xb9, 0x09, 0x0b, 0x2f,
-	0x00, 0xe9, 0x7b, 0xee, 0x8c, 0x0a, 0x09, 0x49, 0xd6, 0x41, 0xa5, 0x9c, 0x4b, 0x03, 0x59, 0x7f,
-	0x55, 0xed, 0x27, 0x4d, 0xfc, 0x19, 0x3d, 0x87, 0xde, 0x8c, 0x85, 0xd3, 0x01, 0xcc, 0x4f, 0x41,
-	0x48, 0xdc, 0x41, 0x26, 0x87, 0x79, 0xd5, 0xa8, 0x1b, 0xad, 0x52, 0xfb, 0x33, 0x79, 0x9a, 0xa7,
-	0x04, 0xc8, 0x26, 0x96, 0x47, 0x33, 0x02, 0x77, 0x33, 0x50, 0x54, 0x8b, 0x0a, 0x6c, 0xee, 0x01,
-	0x45, 0xca, 0x12, 0x01, 0xcf, 0x48, 0xe1, 0x7c, 0x40, 0xb5, 0xbd, 0x42, 0x1a, 0x70, 0x6e, 0x0c,
-	0x54, 0xd1, 0xe7, 0x7d, 0x16, 0xc7, 0x54, 0x3e, 0x9a, 0x36, 0x50, 0x39, 0xc8, 0x82, 0xa3, 0x09,
-	0xd0, 0x68, 0x22, 0x95, 0xb2, 0x39, 0x28, 0xa9, 0xda, 0x2f, 0x55, 0xc2, 0xde, 0xae, 0xd3, 0xc7,
-	0x83, 0x4e, 0x9b, 0xbe, 0x59, 0x16, 0x7f, 0x47, 0x28, 0x9c, 0xf8, 0x49, 0x04, 0x23, 0x01, 0xb2,
-	0x6a, 0xd6, 0xcd, 0x56, 0xa9, 0xdd, 0x20, 0xb9, 0x9d, 0x6c, 0xfe, 0x59, 0x32, 0xcc, 0x7e, 0xfd,
-	0xf9, 0xff, 0xcf, 0xa7, 0x7c, 0xf0, 0x56, 0x43, 0x43, 0x90, 0xce, 0x7b, 0xf4, 0x2e, 0xaf, 0xab,
-	0x87, 0xb4, 0xaf, 0x8b, 0xa8, 0xf2, 0xa3, 0xd7, 0xff, 0xad, 0x0f, 0x81, 0x0f, 0x81, 0x2f, 0x68,
-	0x08, 0xf8, 0x62, 0x7b, 0xbf, 0xdc, 0xfd, 0x71, 0x87, 0xbc, 0xf0, 0x12, 0xc8, 0xe1, 0x15, 0x5a,
-	0xdd, 0xe3, 0x41, 0xad, 0x88, 0x05, 0x2a, 0xef, 0xaa, 0xe3, 0x2f, 0xaf, 0xe8, 0x94, 0x5b, 0x8a,
-	0xe5, 0x1d, 0x41, 0xe8, 0xa1, 0xbd, 0x6f, 0xb7, 0x2b, 0xdb, 0x58, 0xae, 0x6c, 0xe3, 0x7e, 0x65,
-	0x1b, 0x57, 0x6b, 0xbb, 0xb0, 0x5c, 0xdb, 0x85, 0xbb, 0xb5, 0x5d, 0x38, 0x69, 0xe8, 0x5e, 0x62,
-	0x3c, 0x25, 0x94, 0xed, 0xfd, 0x70, 0x82, 0x37, 0xea, 0x51, 0x7f, 0x7d, 0x08, 0x00, 0x00, 0xff,
-	0xff, 0xa8, 0x04, 0x3e, 0xdb, 0x5e, 0x03, 0x00, 0x00,
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xbd, 0x8d, 0x28, 0x62, 0x13, 0x38, 0x6c, 0x11, 0x8a, 0x1c, 0x61, 0x9a, 0x08, 0xaa,
+	0x9e, 0xd6, 0x24, 0x1c, 0x5a, 0x89, 0x0b, 0xa4, 0x12, 0x0a, 0x7f, 0x0e, 0xc8, 0x91, 0x38, 0x70,
+	0x09, 0xce, 0x66, 0xea, 0xac, 0x1a, 0xef, 0xba, 0xbb, 0x4b, 0x24, 0x78, 0x02, 0x6e, 0x70, 0xe1,
+	0x31, 0x78, 0x0c, 0x24, 0x8e, 0x3d, 0x72, 0x44, 0xc9, 0x8b, 0x20, 0xef, 0x2e, 0x25, 0x16, 0x09,
+	0x22, 0x1c, 0x33, 0xf9, 0x7e, 0x33, 0xdf, 0x7c, 0x63, 0x1b, 0x1f, 0x30, 0xa9, 0x73, 0xa9, 0x63,
+	0x6d, 0xa4, 0x82, 0x58, 0x1b, 0x05, 0x69, 0xce, 0x45, 0x16, 0xa7, 0x63, 0xc6, 0xe3, 0x4c, 0x15,
+	0x8c, 0x16, 0x4a, 0x1a, 0x49, 0x5a, 0x4e, 0x47, 0xad, 0x8e, 0x5e, 0xea, 0x68, 0xa9, 0x0b, 0x5b,
+	0x06, 0xc4, 0x04, 0x54, 0xce, 0x85, 0x71, 0xa0, 0x79, 0x57, 0x80, 0x76, 0x64, 0x78, 0xb7, 0x32,
+	0x61, 0xde, 0x1d, 0x83, 0x49, 0xbb, 0xf1, 0x8c, 0x6b, 0x03, 0xa2, 0xec, 0x60, 0x55, 0x9d, 0x8f,
+	0x08, 0x87, 0x2f, 0x6c, 0xed, 0x09, 0x17, 0xe9, 0x8c, 0xbf, 0x87, 0xfe, 0x4c, 0xb2, 0xb3, 0x04,
+	0xce, 0xdf, 0x82, 0x36, 0xe4, 0x08, 0xd7, 0x14, 0x9c, 0x37, 0xd1, 0x3e, 0x3a, 0xac, 0xf7, 0xee,
+	0xd1, 0xdf, 0xf3, 0xac, 0x01, 0xea, 0x65, 0x55, 0xb4, 0x24, 0xc8, 0x71, 0x09, 0xea, 0xe6, 0x8e,
+	0x05, 0x0f, 0xd6, 0x80, 0xba, 0x90, 0x42, 0xc3, 0x1f, 0xa4, 0xee, 0xdc, 0xc6, 0xad, 0xb5, 0x86,
+	0x1c, 0xd0, 0xf9, 0x82, 0xf0, 0x9e, 0xfb, 0xff, 0x44, 0xe6, 0x39, 0x37, 0xbf, 0x9c, 0xb6, 0x71,
+	0x63, 0x5c, 0x0a, 0x47, 0x53, 0xe0, 0xd9, 0xd4, 0x58, 0xcb, 0xb5, 0xa4, 0x6e, 0x6b, 0x03, 0x5b,
+	0x22, 0xdd, 0x55, 0x4f, 0x77, 0x36, 0x7a, 0xf2, 0x7d, 0x4b, 0x2d, 0x79, 0x84, 0x31, 0x9b, 0xa6,
+	0x22, 0x83, 0x91, 0x06, 0xd3, 0xac, 0xed, 0xd7, 0x0e, 0xeb, 0xbd, 0x36, 0xad, 0xdc, 0xc4, 0x27,
+	0x4b, 0x87, 0xe5, 0xaf, 0xe7, 0xaf, 0x5e, 0xa6, 0x5c, 0x25, 0xd7, 0x1c, 0x34, 0x04, 0xd3, 0xb9,
+	0x85, 0x6f, 0x56, 0xed, 0xfa, 0x3d, 0xbe, 0x22, 0xdc, 0x18, 0xda, 0x73, 0x26, 0xc0, 0xa4, 0x9a,
+	0x90, 0x37, 0xf8, 0xc6, 0xa9, 0xdf, 0x78, 0x64, 0x5d, 0xfb, 0xd4, 0x8f, 0xe8, 0x5f, 0x1e, 0x01,
+	0xba, 0xf9, 0x76, 0x83, 0x20, 0xb9, 0x7e, 0xba, 0x5a, 0x27, 0xcf, 0xf0, 0x2e, 0xb3, 0x26, 0x7c,
+	0x04, 0xf7, 0xff, 0xa1, 0x73, 0x25, 0xe4, 0x41, 0x90, 0xf8, 0x0e, 0xfd, 0xab, 0xf8, 0x0a, 0xcc,
+	0x41, 0x98, 0xde, 0xe7, 0x1d, 0xbc, 0xf7, 0xb8, 0x7f, 0xf2, 0xd4, 0xc9, 0x41, 0x0d, 0x41, 0xcd,
+	0x39, 0x03, 0xf2, 0xe1, 0xf2, 0x4e, 0x15, 0x73, 0xe4, 0x7f, 0xd7, 0x09, 0x8f, 0xb7, 0x07, 0x5d,
+	0xd4, 0x44, 0xe3, 0xc6, 0xea, 0x32, 0x64, 0xeb, 0xbd, 0xc3, 0xee, 0x16, 0x84, 0x1b, 0xda, 0x7f,
+	0xf8, 0x6d, 0x11, 0xa1, 0x8b, 0x45, 0x84, 0x7e, 0x2c, 0x22, 0xf4, 0x69, 0x19, 0x05, 0x17, 0xcb,
+	0x28, 0xf8, 0xbe, 0x8c, 0x82, 0xd7, 0x6d, 0xd7, 0x4b, 0x4f, 0xce, 0x28, 0x97, 0x6b, 0x3f, 0x00,
+	0xe3, 0x5d, 0xfb, 0x72, 0x3e, 0xf8, 0x39, 0x00, 0x4e, 0x9f, 0x03, 0x5f, 0x26, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -526,6 +619,80 @@ func (m *ListenCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StreamRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Event != nil {
+		{
+			size := m.Event.Size()
+			i -= size
+			if _, err := m.Event.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamRecord_FinalizeBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamRecord_FinalizeBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.FinalizeBlock != nil {
+		{
+			size, err := m.FinalizeBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamRecord_Commit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamRecord_Commit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
 func encodeVarintGrpc(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGrpc(v)
 	base := offset
@@ -594,6 +761,43 @@ func (m *ListenCommitResponse) Size() (n int) {
 	return n
 }
 
+func (m *StreamRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Event != nil {
+		n += m.Event.Size()
+	}
+	return n
+}
+
+func (m *StreamRecord_FinalizeBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FinalizeBlock != nil {
+		l = m.FinalizeBlock.Size()
+		n += 1 + l + sovGrpc(uint64(l))
+	}
+	return n
+}
+func (m *StreamRecord_Commit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovGrpc(uint64(l))
+	}
+	return n
+}
+
 func sovGrpc(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -961,6 +1165,126 @@ func (m *ListenCommitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *StreamRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizeBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ListenFinalizeBlockRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StreamRecord_FinalizeBlock{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ListenCommitRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StreamRecord_Commit{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGrpc(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,49 @@
+package streaming
+
+import (
+	"strconv"
+)
+
+var _ Sink = (*BrokerSink)(nil)
+
+// DefaultBrokerTopic is the default topic the records of a BrokerSink are
+// published to.
+const DefaultBrokerTopic = "cosmos-sdk-abci"
+
+// Producer publishes messages to the topics of a message broker, e.g. a Kafka
+// producer. Produce must only return once the message is acknowledged by the
+// broker, so that a SinkListener can apply back-pressure and handle its errors.
+type Producer interface {
+	// Produce publishes a message with the given key to a topic.
+	Produce(topic string, key, value []byte) error
+	// Close flushes the pending messages and closes the producer.
+	Close() error
+}
+
+// BrokerSink is a Sink publishing each record as a message of a message broker
+// topic. The key of the messages is the decimal block height of their record,
+// so that the records of a block are stored in the same partition.
+type BrokerSink struct {
+	producer Producer
+	topic    string
+}
+
+// NewBrokerSink returns a BrokerSink publishing to a topic with the given
+// producer, or to DefaultBrokerTopic if the topic is empty.
+func NewBrokerSink(producer Producer, topic string) *BrokerSink {
+	if topic == "" {
+		topic = DefaultBrokerTopic
+	}
+
+	return &BrokerSink{producer: producer, topic: topic}
+}
+
+// Write implements Sink.
+func (s *BrokerSink) Write(height int64, record []byte) error {
+	return s.producer.Produce(s.topic, []byte(strconv.FormatInt(height, 10)), record)
+}
+
+// Close implements Sink.
+func (s *BrokerSink) Close() error {
+	return s.producer.Close()
+}
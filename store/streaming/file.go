@@ -0,0 +1,102 @@
+package streaming
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+var _ Sink = (*FileSink)(nil)
+
+// DefaultFileMaxBytes is the default maximum size of a file written by a FileSink.
+const DefaultFileMaxBytes = 100 << 20
+
+// FileSink is a Sink writing the records as length-prefixed protobuf messages,
+// i.e. each message is preceded by its uvarint encoded length, to a directory
+// of files rotated by size. Each file is named after the height of its first
+// record, e.g. abci-00000000000000000042.pb, and can be read with a gogoproto
+// delimited reader.
+type FileSink struct {
+	dir      string
+	maxBytes int64
+
+	file *os.File
+	size int64
+}
+
+// NewFileSink returns a FileSink writing to the given directory, which is
+// created if it doesn't exist. A file is rotated before it exceeds maxBytes,
+// unless it would only contain a single record. DefaultFileMaxBytes is used if
+// maxBytes is not positive.
+func NewFileSink(dir string, maxBytes int64) (*FileSink, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	if maxBytes <= 0 {
+		maxBytes = DefaultFileMaxBytes
+	}
+
+	return &FileSink{dir: dir, maxBytes: maxBytes}, nil
+}
+
+// Write implements Sink.
+func (s *FileSink) Write(height int64, record []byte) error {
+	data := binary.AppendUvarint(make([]byte, 0, binary.MaxVarintLen64+len(record)), uint64(len(record)))
+	data = append(data, record...)
+
+	if s.file != nil && s.size+int64(len(data)) > s.maxBytes {
+		if err := s.closeFile(); err != nil {
+			return err
+		}
+	}
+	if s.file == nil {
+		if err := s.openFile(height); err != nil {
+			return err
+		}
+	}
+
+	n, err := s.file.Write(data)
+	s.size += int64(n)
+	return err
+}
+
+// Close implements Sink.
+func (s *FileSink) Close() error {
+	if s.file == nil {
+		return nil
+	}
+
+	return s.closeFile()
+}
+
+// openFile opens the file starting at the given height, appending to it if it
+// already exists, e.g. when the node restarts at this height.
+func (s *FileSink) openFile(height int64) error {
+	path := filepath.Join(s.dir, fmt.Sprintf("abci-%020d.pb", height))
+	file, err := os.OpenFile(filepath.Clean(path), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	s.file, s.size = file, info.Size()
+	return nil
+}
+
+func (s *FileSink) closeFile() error {
+	file := s.file
+	s.file, s.size = nil, 0
+
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
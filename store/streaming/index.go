@@ -0,0 +1,256 @@
+package streaming
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	storetypes "cosmossdk.io/store/types"
+)
+
+var (
+	_ storetypes.ABCIListener                = (*ChangeIndex)(nil)
+	_ streamingabci.StateChangeServiceServer = (*ChangeIndex)(nil)
+)
+
+// DefaultRetainHeights is the default number of recent heights whose change
+// sets are retained by a ChangeIndex.
+const DefaultRetainHeights = 10000
+
+// ChangeIndex is an ABCIListener persisting the change set of each committed
+// height in a database, bounded to a number of recent heights. It implements
+// the StateChangeService, streaming the retained change sets then the new ones
+// as they are committed, so that external indexers can reconnect and resume
+// from any retained height instead of resyncing from genesis.
+type ChangeIndex struct {
+	db            dbm.DB
+	retainHeights int64
+
+	mtx sync.RWMutex
+	// earliest and latest are the retained heights, 0 if there are none.
+	earliest int64
+	latest   int64
+	// committed is closed, and replaced, when the change set of a new height
+	// is indexed.
+	committed chan struct{}
+}
+
+// NewChangeIndex returns a ChangeIndex persisting the change sets to the given
+// database, and retaining the change sets of retainHeights recent heights.
+// DefaultRetainHeights is used if retainHeights is not positive.
+func NewChangeIndex(db dbm.DB, retainHeights int64) (*ChangeIndex, error) {
+	if retainHeights <= 0 {
+		retainHeights = DefaultRetainHeights
+	}
+
+	index := &ChangeIndex{db: db, retainHeights: retainHeights, committed: make(chan struct{})}
+
+	var err error
+	if index.earliest, err = index.boundHeight(false); err != nil {
+		return nil, err
+	}
+	if index.latest, err = index.boundHeight(true); err != nil {
+		return nil, err
+	}
+
+	return index, nil
+}
+
+// Heights returns the earliest and the latest retained heights, which are 0 if
+// no height is retained.
+func (idx *ChangeIndex) Heights() (earliest, latest int64) {
+	idx.mtx.RLock()
+	defer idx.mtx.RUnlock()
+
+	return idx.earliest, idx.latest
+}
+
+// Changes returns the change set of a retained height, or nil if the height is
+// not retained.
+func (idx *ChangeIndex) Changes(height int64) (*streamingabci.StreamChangesResponse, error) {
+	bz, err := idx.db.Get(changeIndexKey(height))
+	if err != nil || bz == nil {
+		return nil, err
+	}
+
+	changes := &streamingabci.StreamChangesResponse{}
+	if err := changes.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener, the FinalizeBlock
+// events are not indexed.
+func (idx *ChangeIndex) ListenFinalizeBlock(context.Context, abci.RequestFinalizeBlock, abci.ResponseFinalizeBlock) error {
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener, indexing the change set of
+// the committed height and pruning the heights which are no longer retained.
+func (idx *ChangeIndex) ListenCommit(ctx context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	height := contextBlockHeight(ctx)
+	if height <= 0 {
+		return fmt.Errorf("invalid change set height %d", height)
+	}
+
+	bz, err := (&streamingabci.StreamChangesResponse{BlockHeight: height, ChangeSet: changeSet}).Marshal()
+	if err != nil {
+		return err
+	}
+
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+
+	if err := batch.Set(changeIndexKey(height), bz); err != nil {
+		return err
+	}
+
+	// prune the heights below the retained ones
+	earliest := height - idx.retainHeights + 1
+	if err := idx.deleteBelow(batch, earliest); err != nil {
+		return err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	if idx.earliest == 0 || idx.earliest > height {
+		idx.earliest = height
+	}
+	if idx.earliest < earliest {
+		idx.earliest = earliest
+	}
+	if height > idx.latest {
+		idx.latest = height
+	}
+	close(idx.committed)
+	idx.committed = make(chan struct{})
+
+	return nil
+}
+
+// StreamChanges implements streamingabci.StateChangeServiceServer.
+func (idx *ChangeIndex) StreamChanges(req *streamingabci.StreamChangesRequest, stream streamingabci.StateChangeService_StreamChangesServer) error {
+	height := req.FromHeight
+	if height < 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid height %d", height)
+	}
+
+	for {
+		idx.mtx.RLock()
+		earliest, latest, committed := idx.earliest, idx.latest, idx.committed
+		idx.mtx.RUnlock()
+
+		if height == 0 {
+			height = earliest
+		}
+		if height != 0 && height < earliest {
+			return status.Errorf(codes.OutOfRange, "height %d is not retained, the earliest retained height is %d", height, earliest)
+		}
+
+		if height != 0 && height <= latest {
+			if err := idx.sendChanges(stream, height, latest); err != nil {
+				return err
+			}
+			height = latest + 1
+		}
+
+		select {
+		case <-committed:
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// Close closes the database of the index.
+func (idx *ChangeIndex) Close() error {
+	return idx.db.Close()
+}
+
+// sendChanges sends the change sets of the retained heights between start and
+// end, both inclusive.
+func (idx *ChangeIndex) sendChanges(stream streamingabci.StateChangeService_StreamChangesServer, start, end int64) error {
+	it, err := idx.db.Iterator(changeIndexKey(start), changeIndexKey(end+1))
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		changes := &streamingabci.StreamChangesResponse{}
+		if err := changes.Unmarshal(it.Value()); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		if err := stream.Send(changes); err != nil {
+			return err
+		}
+	}
+
+	if err := it.Error(); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// deleteBelow deletes the change sets of the retained heights below the given
+// one.
+func (idx *ChangeIndex) deleteBelow(batch dbm.Batch, height int64) error {
+	if height <= 1 {
+		return nil
+	}
+
+	it, err := idx.db.Iterator(changeIndexKey(idx.earliest), changeIndexKey(height))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if err := batch.Delete(it.Key()); err != nil {
+			return err
+		}
+	}
+
+	return it.Error()
+}
+
+// boundHeight returns the latest or the earliest height of the database, or 0
+// if it is empty.
+func (idx *ChangeIndex) boundHeight(latest bool) (int64, error) {
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if latest {
+		it, err = idx.db.ReverseIterator(nil, nil)
+	} else {
+		it, err = idx.db.Iterator(nil, nil)
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	if !it.Valid() {
+		return 0, it.Error()
+	}
+
+	return int64(binary.BigEndian.Uint64(it.Key())), nil
+}
+
+func changeIndexKey(height int64) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(height))
+}
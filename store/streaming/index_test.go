@@ -0,0 +1,131 @@
+package streaming
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/log"
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// mockChangesStream is a StreamChanges server stream sending the change sets
+// to a channel.
+type mockChangesStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	changes chan *streamingabci.StreamChangesResponse
+}
+
+func (s *mockChangesStream) Context() context.Context { return s.ctx }
+
+func (s *mockChangesStream) Send(changes *streamingabci.StreamChangesResponse) error {
+	s.changes <- changes
+	return nil
+}
+
+func indexChanges(t *testing.T, index *ChangeIndex, from, to int64) {
+	t.Helper()
+	for height := from; height <= to; height++ {
+		ctx := NewMockContext(tmproto.Header{Height: height}, log.NewNopLogger(), storetypes.StreamingManager{})
+		require.NoError(t, index.ListenCommit(ctx, abci.ResponseCommit{}, testChangeSet(height)))
+	}
+}
+
+func testChangeSet(height int64) []*storetypes.StoreKVPair {
+	return []*storetypes.StoreKVPair{{StoreKey: "store", Key: []byte{byte(height)}, Value: []byte("value")}}
+}
+
+func TestChangeIndex(t *testing.T) {
+	db := dbm.NewMemDB()
+	index, err := NewChangeIndex(db, 3)
+	require.NoError(t, err)
+
+	earliest, latest := index.Heights()
+	require.Zero(t, earliest)
+	require.Zero(t, latest)
+
+	indexChanges(t, index, 1, 5)
+	earliest, latest = index.Heights()
+	require.Equal(t, int64(3), earliest)
+	require.Equal(t, int64(5), latest)
+
+	changes, err := index.Changes(2)
+	require.NoError(t, err)
+	require.Nil(t, changes)
+	changes, err = index.Changes(4)
+	require.NoError(t, err)
+	require.Equal(t, &streamingabci.StreamChangesResponse{BlockHeight: 4, ChangeSet: testChangeSet(4)}, changes)
+
+	// the retained heights are loaded from the database
+	index, err = NewChangeIndex(db, 3)
+	require.NoError(t, err)
+	earliest, latest = index.Heights()
+	require.Equal(t, int64(3), earliest)
+	require.Equal(t, int64(5), latest)
+
+	// the height must be in the context
+	require.Error(t, index.ListenCommit(context.Background(), abci.ResponseCommit{}, nil))
+}
+
+func TestChangeIndex_StreamChanges(t *testing.T) {
+	index, err := NewChangeIndex(dbm.NewMemDB(), 3)
+	require.NoError(t, err)
+	indexChanges(t, index, 1, 5)
+
+	streamChanges := func(fromHeight int64) (*mockChangesStream, context.CancelFunc, chan error) {
+		ctx, cancel := context.WithCancel(context.Background())
+		stream := &mockChangesStream{ctx: ctx, changes: make(chan *streamingabci.StreamChangesResponse)}
+		done := make(chan error, 1)
+		go func() {
+			done <- index.StreamChanges(&streamingabci.StreamChangesRequest{FromHeight: fromHeight}, stream)
+		}()
+		return stream, cancel, done
+	}
+	requireHeights := func(stream *mockChangesStream, from, to int64) {
+		for height := from; height <= to; height++ {
+			select {
+			case changes := <-stream.changes:
+				require.Equal(t, height, changes.BlockHeight)
+				require.Equal(t, testChangeSet(height), changes.ChangeSet)
+			case <-time.After(time.Second):
+				t.Fatalf("no change set of height %d", height)
+			}
+		}
+	}
+
+	// the retained heights are streamed, then the new heights as they are indexed
+	stream, cancel, done := streamChanges(4)
+	requireHeights(stream, 4, 5)
+	indexChanges(t, index, 6, 7)
+	requireHeights(stream, 6, 7)
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+
+	// streaming starts at the earliest retained height by default
+	stream, cancel, done = streamChanges(0)
+	requireHeights(stream, 5, 7)
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+
+	// streaming from a height which isn't indexed yet waits for it
+	stream, cancel, done = streamChanges(9)
+	indexChanges(t, index, 8, 9)
+	requireHeights(stream, 9, 9)
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+
+	// the pruned heights cannot be streamed
+	_, cancel, done = streamChanges(2)
+	require.Equal(t, codes.OutOfRange, status.Code(<-done))
+	cancel()
+}
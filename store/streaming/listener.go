@@ -0,0 +1,228 @@
+package streaming
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/log"
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	storetypes "cosmossdk.io/store/types"
+)
+
+var _ storetypes.ABCIListener = (*SinkListener)(nil)
+
+// ErrListenerClosed is returned when streaming an event to a closed SinkListener.
+var ErrListenerClosed = errors.New("streaming listener is closed")
+
+// ErrorMode defines how a SinkListener handles the errors of its sink.
+type ErrorMode int
+
+const (
+	// ErrorModeHalt stops writing records after the first error of the sink.
+	// ListenCommit waits for the records of the block to be written, and returns
+	// the error of the sink so that the node is halted before the commit of the
+	// block returns. The records buffered after the failing one are logged, not
+	// written.
+	ErrorModeHalt ErrorMode = iota
+	// ErrorModeDrop logs the errors of the sink and drops the records which could
+	// not be written, the following records being written as usual.
+	ErrorModeDrop
+)
+
+// DefaultBufferSize is the default number of records buffered by a SinkListener.
+const DefaultBufferSize = 1000
+
+// Sink writes the records streamed by a SinkListener to an external system.
+type Sink interface {
+	// Write writes a record of the given block height, which is an encoded
+	// streamingabci.StreamRecord.
+	Write(height int64, record []byte) error
+	// Close flushes and closes the sink.
+	Close() error
+}
+
+// SinkListenerOptions defines the options of a SinkListener.
+type SinkListenerOptions struct {
+	// BufferSize is the maximum number of records waiting to be written to the
+	// sink. Streaming an event blocks while the buffer is full, applying
+	// back-pressure to the node. DefaultBufferSize is used if it is not positive.
+	BufferSize int
+	// ErrorMode defines how the errors of the sink are handled.
+	ErrorMode ErrorMode
+}
+
+// SinkListener is an in-process storetypes.ABCIListener which encodes the
+// streamed events as streamingabci.StreamRecord messages, and writes them to a
+// Sink from a background goroutine.
+type SinkListener struct {
+	sink   Sink
+	mode   ErrorMode
+	logger log.Logger
+
+	records chan sinkRecord
+	// failed is closed, and err is set, when the sink fails in halt mode.
+	failed chan struct{}
+	err    error
+	// done is closed when all the records have been handled.
+	done chan struct{}
+
+	mtx    sync.Mutex
+	closed bool
+}
+
+type sinkRecord struct {
+	height int64
+	data   []byte
+	// written, if not nil, is closed once the record is handled.
+	written chan struct{}
+}
+
+// NewSinkListener returns a SinkListener writing to the given sink. The
+// listener must be closed to flush its buffered records and close the sink.
+func NewSinkListener(sink Sink, opts SinkListenerOptions, logger log.Logger) *SinkListener {
+	bufferSize := opts.BufferSize
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+
+	l := &SinkListener{
+		sink:    sink,
+		mode:    opts.ErrorMode,
+		logger:  logger,
+		records: make(chan sinkRecord, bufferSize),
+		failed:  make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go l.run()
+
+	return l
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener.
+func (l *SinkListener) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	return l.enqueue(req.Height, &streamingabci.StreamRecord{
+		Event: &streamingabci.StreamRecord_FinalizeBlock{
+			FinalizeBlock: &streamingabci.ListenFinalizeBlockRequest{Req: &req, Res: &res},
+		},
+	}, nil)
+}
+
+// ListenCommit implements storetypes.ABCIListener. In halt mode, it waits for
+// the records up to the commit to be written to the sink.
+func (l *SinkListener) ListenCommit(ctx context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	height := contextBlockHeight(ctx)
+	record := &streamingabci.StreamRecord{
+		Event: &streamingabci.StreamRecord_Commit{
+			Commit: &streamingabci.ListenCommitRequest{BlockHeight: height, Res: &res, ChangeSet: changeSet},
+		},
+	}
+	if l.mode != ErrorModeHalt {
+		return l.enqueue(height, record, nil)
+	}
+
+	// the records are written in order, the previous records are written once
+	// the commit is
+	written := make(chan struct{})
+	if err := l.enqueue(height, record, written); err != nil {
+		return err
+	}
+	<-written
+
+	select {
+	case <-l.failed:
+		return l.err
+	default:
+		return nil
+	}
+}
+
+// Close writes the buffered records, then closes the sink.
+func (l *SinkListener) Close() error {
+	l.mtx.Lock()
+	if l.closed {
+		l.mtx.Unlock()
+		return nil
+	}
+	l.closed = true
+	close(l.records)
+	l.mtx.Unlock()
+
+	<-l.done
+	return l.sink.Close()
+}
+
+// enqueue encodes the record and adds it to the buffer, waiting for the buffer
+// to have room for it. written, if not nil, is closed once the record is handled.
+func (l *SinkListener) enqueue(height int64, record *streamingabci.StreamRecord, written chan struct{}) error {
+	data, err := record.Marshal()
+	if err != nil {
+		return err
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	if l.closed {
+		return ErrListenerClosed
+	}
+
+	select {
+	case <-l.failed:
+		return l.err
+	default:
+	}
+
+	select {
+	case l.records <- sinkRecord{height: height, data: data, written: written}:
+		return nil
+	case <-l.failed:
+		return l.err
+	}
+}
+
+func (l *SinkListener) run() {
+	defer close(l.done)
+
+	for record := range l.records {
+		l.write(record)
+		if record.written != nil {
+			close(record.written)
+		}
+	}
+}
+
+// write writes a record to the sink, handling its error according to the error
+// mode.
+func (l *SinkListener) write(record sinkRecord) {
+	if l.err != nil {
+		// the sink failed in halt mode, the records buffered since are not written
+		l.logger.Error("streaming record not written, the sink failed", "height", record.height, "err", l.err)
+		return
+	}
+
+	err := l.sink.Write(record.height, record.data)
+	if err == nil {
+		return
+	}
+
+	if l.mode == ErrorModeDrop {
+		l.logger.Error("failed to write streaming record, dropping it", "height", record.height, "err", err)
+		return
+	}
+
+	l.err = fmt.Errorf("failed to write streaming record of height %d: %w", record.height, err)
+	close(l.failed)
+}
+
+// contextBlockHeight returns the block height of a types.Context, such as the
+// sdk.Context passed by the BaseApp, and 0 for any other context.
+func contextBlockHeight(ctx context.Context) int64 {
+	if c, ok := ctx.(storetypes.Context); ok {
+		return c.BlockHeight()
+	}
+
+	return 0
+}
@@ -0,0 +1,227 @@
+package streaming
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// memProducer is an in-memory stand-in of a message broker producer.
+type memProducer struct {
+	mtx      sync.Mutex
+	messages map[string][][2][]byte
+	err      error
+	closed   bool
+}
+
+func (p *memProducer) Produce(topic string, key, value []byte) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if p.err != nil {
+		return p.err
+	}
+	if p.messages == nil {
+		p.messages = make(map[string][][2][]byte)
+	}
+	p.messages[topic] = append(p.messages[topic], [2][]byte{key, value})
+	return nil
+}
+
+func (p *memProducer) Close() error {
+	p.closed = true
+	return nil
+}
+
+// blockingSink is a sink failing the records of some heights, which waits for
+// each write to be released if release is not nil.
+type blockingSink struct {
+	release chan struct{}
+	failing map[int64]bool
+	heights []int64
+}
+
+func (s *blockingSink) Write(height int64, _ []byte) error {
+	if s.release != nil {
+		<-s.release
+	}
+	if s.failing[height] {
+		return errors.New("sink failure")
+	}
+	s.heights = append(s.heights, height)
+	return nil
+}
+
+func (s *blockingSink) Close() error { return nil }
+
+func streamBlocks(t *testing.T, listener storetypes.ABCIListener, from, to int64) {
+	t.Helper()
+	for height := from; height <= to; height++ {
+		require.NoError(t, streamBlock(listener, height))
+	}
+}
+
+func streamBlock(listener storetypes.ABCIListener, height int64) error {
+	ctx := NewMockContext(tmproto.Header{Height: height}, log.NewNopLogger(), storetypes.StreamingManager{})
+	req := abci.RequestFinalizeBlock{Height: height, Txs: [][]byte{{byte(height)}}}
+	res := abci.ResponseFinalizeBlock{AppHash: []byte{byte(height)}}
+	if err := listener.ListenFinalizeBlock(ctx, req, res); err != nil {
+		return err
+	}
+
+	changeSet := []*storetypes.StoreKVPair{{StoreKey: "store", Key: []byte{byte(height)}, Value: []byte("value")}}
+	return listener.ListenCommit(ctx, abci.ResponseCommit{}, changeSet)
+}
+
+func requireRecords(t *testing.T, records []*streamingabci.StreamRecord, from, to int64) {
+	t.Helper()
+	require.Len(t, records, 2*int(to-from+1))
+	for i, height := 0, from; height <= to; i, height = i+2, height+1 {
+		finalizeBlock := records[i].GetFinalizeBlock()
+		require.NotNil(t, finalizeBlock)
+		require.Equal(t, height, finalizeBlock.Req.Height)
+		require.Equal(t, []byte{byte(height)}, finalizeBlock.Res.AppHash)
+
+		commit := records[i+1].GetCommit()
+		require.NotNil(t, commit)
+		require.Equal(t, height, commit.BlockHeight)
+		require.Equal(t, []byte{byte(height)}, commit.ChangeSet[0].Key)
+	}
+}
+
+func TestSinkListener_FileSink(t *testing.T) {
+	dir := t.TempDir()
+	sink, err := NewFileSink(dir, 200)
+	require.NoError(t, err)
+
+	listener := NewSinkListener(sink, SinkListenerOptions{BufferSize: 4}, log.NewNopLogger())
+	streamBlocks(t, listener, 1, 10)
+	require.NoError(t, listener.Close())
+	require.ErrorIs(t, streamBlock(listener, 11), ErrListenerClosed)
+
+	// restarting at a height appends to the file of the height
+	sink, err = NewFileSink(dir, 1<<20)
+	require.NoError(t, err)
+	listener = NewSinkListener(sink, SinkListenerOptions{}, log.NewNopLogger())
+	streamBlocks(t, listener, 11, 12)
+	require.NoError(t, listener.Close())
+
+	files, err := filepath.Glob(filepath.Join(dir, "abci-*.pb"))
+	require.NoError(t, err)
+	require.Greater(t, len(files), 1, "files should be rotated")
+	require.Equal(t, filepath.Join(dir, "abci-00000000000000000001.pb"), files[0])
+
+	var records []*streamingabci.StreamRecord
+	for _, file := range files {
+		info, err := os.Stat(file)
+		require.NoError(t, err)
+		if file != files[len(files)-1] {
+			require.LessOrEqual(t, info.Size(), int64(200))
+		}
+
+		f, err := os.Open(file)
+		require.NoError(t, err)
+		reader := protoio.NewDelimitedReader(f, 1<<20)
+		for {
+			record := &streamingabci.StreamRecord{}
+			if err := reader.ReadMsg(record); err != nil {
+				break
+			}
+			records = append(records, record)
+		}
+		require.NoError(t, reader.Close())
+	}
+	requireRecords(t, records, 1, 12)
+}
+
+func TestSinkListener_BrokerSink(t *testing.T) {
+	producer := &memProducer{}
+	listener := NewSinkListener(NewBrokerSink(producer, ""), SinkListenerOptions{}, log.NewNopLogger())
+	streamBlocks(t, listener, 1, 5)
+	require.NoError(t, listener.Close())
+	require.True(t, producer.closed)
+
+	messages := producer.messages[DefaultBrokerTopic]
+	records := make([]*streamingabci.StreamRecord, len(messages))
+	for i, message := range messages {
+		require.Equal(t, []byte{byte('1' + i/2)}, message[0])
+		records[i] = &streamingabci.StreamRecord{}
+		require.NoError(t, records[i].Unmarshal(message[1]))
+	}
+	requireRecords(t, records, 1, 5)
+}
+
+func TestSinkListener_ErrorMode(t *testing.T) {
+	// in halt mode, the commit of a block returns the error of the sink writing
+	// its records, the listener stopping writing records after it
+	sink := &blockingSink{failing: map[int64]bool{3: true}}
+	listener := NewSinkListener(sink, SinkListenerOptions{ErrorMode: ErrorModeHalt}, log.NewNopLogger())
+	streamBlocks(t, listener, 1, 2)
+	err := streamBlock(listener, 3)
+	require.ErrorContains(t, err, "failed to write streaming record of height 3: sink failure")
+	err = streamBlock(listener, 4)
+	require.ErrorContains(t, err, "failed to write streaming record of height 3: sink failure")
+	require.NoError(t, listener.Close())
+	require.Equal(t, []int64{1, 1, 2, 2}, sink.heights)
+
+	// in halt mode, the commit of a block waits for its records to be written
+	sink = &blockingSink{release: make(chan struct{})}
+	listener = NewSinkListener(sink, SinkListenerOptions{ErrorMode: ErrorModeHalt}, log.NewNopLogger())
+	streamed := make(chan error)
+	go func() { streamed <- streamBlock(listener, 1) }()
+	select {
+	case <-streamed:
+		t.Fatal("the commit should wait for the records to be written")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(sink.release)
+	require.NoError(t, <-streamed)
+	require.Equal(t, []int64{1, 1}, sink.heights)
+	require.NoError(t, listener.Close())
+
+	// in drop mode, the records failing to be written are dropped
+	sink = &blockingSink{failing: map[int64]bool{3: true}}
+	listener = NewSinkListener(sink, SinkListenerOptions{ErrorMode: ErrorModeDrop}, log.NewNopLogger())
+	streamBlocks(t, listener, 1, 5)
+	require.NoError(t, listener.Close())
+	require.Equal(t, []int64{1, 1, 2, 2, 4, 4, 5, 5}, sink.heights)
+
+	// the broker errors are handled the same way
+	producer := &memProducer{err: errors.New("broker unavailable")}
+	listener = NewSinkListener(NewBrokerSink(producer, "topic"), SinkListenerOptions{}, log.NewNopLogger())
+	require.ErrorIs(t, streamBlock(listener, 1), producer.err)
+	require.NoError(t, listener.Close())
+}
+
+func TestSinkListener_BackPressure(t *testing.T) {
+	sink := &blockingSink{release: make(chan struct{})}
+	listener := NewSinkListener(sink, SinkListenerOptions{BufferSize: 2, ErrorMode: ErrorModeDrop}, log.NewNopLogger())
+
+	// the records of the first block fit in the buffer
+	require.NoError(t, streamBlock(listener, 1))
+
+	// the records of the second block wait for the buffer to have room for them
+	streamed := make(chan error)
+	go func() { streamed <- streamBlock(listener, 2) }()
+	select {
+	case <-streamed:
+		t.Fatal("streaming should block while the buffer is full")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(sink.release)
+	require.NoError(t, <-streamed)
+	require.NoError(t, listener.Close())
+	require.Equal(t, []int64{1, 1, 2, 2}, sink.heights)
+}
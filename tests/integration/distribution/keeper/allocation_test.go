@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	pooltypes "cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestCommunityTaxContinuousFunds(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+	ctx := f.sdkCtx.WithBlockHeight(2)
+
+	require.NoError(t, f.distrKeeper.Params.Set(ctx, distrtypes.DefaultParams()))
+	require.NoError(t, f.distrKeeper.FeePool.Set(ctx, distrtypes.InitialFeePool()))
+
+	// the validator of the last commit
+	validator, err := stakingtypes.NewValidator(f.valAddr.String(), PKS[0], stakingtypes.Description{})
+	require.NoError(t, err)
+	validator, err = validator.SetInitialCommission(stakingtypes.NewCommission(math.LegacyZeroDec(), math.LegacyOneDec(), math.LegacyOneDec()))
+	require.NoError(t, err)
+	require.NoError(t, f.stakingKeeper.SetValidator(ctx, validator))
+	require.NoError(t, f.stakingKeeper.SetValidatorByConsAddr(ctx, validator))
+
+	// a continuous fund receiving half of the community pool inflows
+	recipient := sdk.AccAddress(PKS[1].Address())
+	require.NoError(t, f.poolKeeper.ContinuousFund.Set(ctx, recipient, pooltypes.ContinuousFund{
+		Recipient:  recipient.String(),
+		Percentage: math.LegacyNewDecWithPrec(5, 1),
+	}))
+
+	// the fees collected in the previous block, the distribution module being
+	// the fee collector of the fixture
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.NoError(t, f.bankKeeper.MintCoins(ctx, distrtypes.ModuleName, fees))
+
+	require.NoError(t, distribution.BeginBlocker(ctx, f.distrKeeper))
+
+	// the 2% community tax is sent to the protocol pool, and half of it is paid
+	// to the continuous fund
+	feePool, err := f.distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.True(t, feePool.CommunityPool.IsZero())
+
+	poolAcc := f.accountKeeper.GetModuleAccount(ctx, pooltypes.ModuleName)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)), f.bankKeeper.GetAllBalances(ctx, poolAcc.GetAddress()))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)), f.bankKeeper.GetAllBalances(ctx, recipient))
+}
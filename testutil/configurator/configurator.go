@@ -101,6 +101,7 @@ func defaultConfig() *Config {
 			"upgrade",
 			"vesting",
 			"circuit",
+			"protocolpool",
 		},
 		setInitGenesis: true,
 	}
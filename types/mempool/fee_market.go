@@ -0,0 +1,420 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/huandu/skiplist"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+var _ Mempool = (*FeeMarketMempool[int64])(nil)
+
+const (
+	// DefaultFeeMarketMaxTx is the default maximum number of transactions of the
+	// FeeMarketMempool.
+	DefaultFeeMarketMaxTx = 5000
+
+	// DefaultFeeMarketMaxTxPerSender is the default maximum number of
+	// transactions a single sender can have in the FeeMarketMempool.
+	DefaultFeeMarketMaxTxPerSender = 64
+
+	// DefaultFeeMarketTTL is the default number of blocks after which a
+	// transaction is expired from the FeeMarketMempool.
+	DefaultFeeMarketTTL = 100
+
+	// DefaultFeeBumpPercent is the default minimum fee increase, in percent, a
+	// transaction must pay to replace a transaction with the same sender and
+	// nonce.
+	DefaultFeeBumpPercent = 10
+)
+
+type (
+	// FeeMarketMempoolConfig defines the configuration used to configure the
+	// FeeMarketMempool.
+	FeeMarketMempoolConfig[C comparable] struct {
+		// TxPriority defines the transaction priority and comparator. The
+		// priority is expected to be the effective tip of the transaction, i.e.
+		// the fee it pays per unit of gas. It is used both to order and to evict
+		// transactions.
+		TxPriority TxPriority[C]
+
+		// TxReplacement is a callback to be called when a transaction with the
+		// same sender and nonce as an existing transaction is inserted. The new
+		// transaction is rejected when it returns false. When nil, the new
+		// transaction always replaces the existing one.
+		TxReplacement func(op, np C, oTx, nTx sdk.Tx) bool
+
+		// TxEncoder is used to compute the size of the transactions. It must be
+		// set when MaxBytes is positive.
+		TxEncoder sdk.TxEncoder
+
+		// MaxTx sets the maximum number of transactions allowed in the mempool
+		// with the semantics:
+		// - if MaxTx == 0, there is no cap on the number of transactions in the mempool
+		// - if MaxTx > 0, the mempool will cap the number of transactions it stores,
+		//   evicting lower priority transactions to make room for higher priority ones
+		// - if MaxTx < 0, `Insert` is a no-op.
+		MaxTx int
+
+		// MaxBytes sets the maximum total size in bytes of the transactions in the
+		// mempool. There is no cap when MaxBytes is zero.
+		MaxBytes int64
+
+		// MaxTxPerSender sets the maximum number of transactions a single sender
+		// can have in the mempool. There is no cap when MaxTxPerSender is zero.
+		MaxTxPerSender int
+
+		// TTL sets the number of blocks after which a transaction is removed from
+		// the mempool. Transactions never expire when TTL is zero.
+		TTL int64
+	}
+
+	// FeeMarketMempool is a mempool implementation bounded by a number of
+	// transactions and a total size in bytes. Transactions are selected in the
+	// same order as the PriorityNonceMempool, which is used internally.
+	//
+	// When the mempool is full, a new transaction evicts the transactions with
+	// the lowest priority, as long as their priority is strictly lower than the
+	// one of the new transaction. Only the transaction with the highest nonce of
+	// each sender is a candidate for eviction, so that eviction never leaves a
+	// nonce gap, and a sender never evicts its own transactions. The number of
+	// transactions per sender is bounded so that a single account cannot fill
+	// the mempool, and transactions expire after a number of blocks.
+	FeeMarketMempool[C comparable] struct {
+		mtx        sync.Mutex
+		pool       *PriorityNonceMempool[C]
+		txs        map[txKey]*feeMarketTx[C]
+		senders    map[string]*skiplist.SkipList
+		tails      *skiplist.SkipList
+		bytes      int64
+		lastHeight int64
+		cfg        FeeMarketMempoolConfig[C]
+	}
+
+	// feeMarketTx stores a transaction along with the metadata used to bound the
+	// mempool.
+	feeMarketTx[C comparable] struct {
+		tx       sdk.Tx
+		key      txKey
+		priority C
+		size     int64
+		height   int64
+	}
+)
+
+// DefaultFeeMarketMempoolConfig returns the default configuration of the
+// FeeMarketMempool. It uses ctx.Priority as the transaction priority and
+// requires a 10% fee bump to replace a transaction. The total size of the
+// mempool is not bounded as no TxEncoder is set.
+func DefaultFeeMarketMempoolConfig() FeeMarketMempoolConfig[int64] {
+	txPriority := NewDefaultTxPriority()
+	return FeeMarketMempoolConfig[int64]{
+		TxPriority:     txPriority,
+		TxReplacement:  NewFeeBumpTxReplacement(txPriority, DefaultFeeBumpPercent),
+		MaxTx:          DefaultFeeMarketMaxTx,
+		MaxTxPerSender: DefaultFeeMarketMaxTxPerSender,
+		TTL:            DefaultFeeMarketTTL,
+	}
+}
+
+// NewFeeBumpTxReplacement returns a transaction replacement rule accepting a new
+// transaction only if its priority is not lower than the one of the existing
+// transaction, and if its fee is at least bumpPercent higher than the fee of
+// the existing transaction for each denom of that fee. Both transactions must
+// implement sdk.FeeTx.
+func NewFeeBumpTxReplacement[C comparable](txPriority TxPriority[C], bumpPercent uint64) func(op, np C, oTx, nTx sdk.Tx) bool {
+	return func(op, np C, oTx, nTx sdk.Tx) bool {
+		if txPriority.Compare(np, op) < 0 {
+			return false
+		}
+
+		oFeeTx, ok := oTx.(sdk.FeeTx)
+		if !ok {
+			return false
+		}
+		nFeeTx, ok := nTx.(sdk.FeeTx)
+		if !ok {
+			return false
+		}
+
+		newFee := nFeeTx.GetFee()
+		for _, c := range oFeeTx.GetFee() {
+			// newAmount * 100 >= oldAmount * (100 + bumpPercent)
+			if newFee.AmountOf(c.Denom).MulRaw(100).LT(c.Amount.MulRaw(int64(100 + bumpPercent))) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// NewFeeMarketMempool returns a new FeeMarketMempool with the given
+// configuration.
+func NewFeeMarketMempool[C comparable](cfg FeeMarketMempoolConfig[C]) *FeeMarketMempool[C] {
+	if cfg.MaxBytes > 0 && cfg.TxEncoder == nil {
+		panic("fee market mempool: a tx encoder is required to bound the mempool size in bytes")
+	}
+
+	return &FeeMarketMempool[C]{
+		pool:    NewPriorityMempool(PriorityNonceMempoolConfig[C]{TxPriority: cfg.TxPriority}),
+		txs:     make(map[txKey]*feeMarketTx[C]),
+		senders: make(map[string]*skiplist.SkipList),
+		tails:   skiplist.New(skiplistComparable(cfg.TxPriority)),
+		cfg:     cfg,
+	}
+}
+
+// Insert attempts to insert a Tx into the mempool, returning an error if
+// unsuccessful. Sender and nonce are derived from the transaction's first
+// signature.
+//
+// Inserting a transaction with the same sender and nonce as an existing one
+// replaces it if it satisfies the TxReplacement rule. When the mempool is full,
+// lower priority transactions are evicted to make room for the new one, and
+// ErrMempoolTxMaxCapacity is returned if there is not enough of them.
+func (mp *FeeMarketMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	if mp.cfg.MaxTx < 0 {
+		return nil
+	}
+
+	sigs, err := tx.(signing.SigVerifiableTx).GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+	if len(sigs) == 0 {
+		return fmt.Errorf("tx must have at least one signer")
+	}
+
+	sig := sigs[0]
+	sender := sdk.AccAddress(sig.PubKey.Address()).String()
+	key := txKey{address: sender, nonce: sig.Sequence}
+
+	height := blockHeight(ctx)
+	mp.removeExpired(height)
+
+	var size int64
+	if mp.cfg.TxEncoder != nil {
+		bz, err := mp.cfg.TxEncoder(tx)
+		if err != nil {
+			return err
+		}
+		size = int64(len(bz))
+	}
+	if mp.cfg.MaxBytes > 0 && size > mp.cfg.MaxBytes {
+		return fmt.Errorf("%w: tx size %d exceeds the mempool max bytes %d", ErrMempoolTxMaxCapacity, size, mp.cfg.MaxBytes)
+	}
+
+	priority := mp.cfg.TxPriority.GetTxPriority(ctx, tx)
+	count, bytes := len(mp.txs)+1, mp.bytes+size
+
+	old, replacing := mp.txs[key]
+	if replacing {
+		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(old.priority, priority, old.tx, tx) {
+			return fmt.Errorf(
+				"tx doesn't fit the replacement rule, oldPriority: %v, newPriority: %v, oldTx: %v, newTx: %v",
+				old.priority,
+				priority,
+				old.tx,
+				tx,
+			)
+		}
+
+		count, bytes = count-1, bytes-old.size
+	} else if senderTxs, ok := mp.senders[sender]; ok && mp.cfg.MaxTxPerSender > 0 && senderTxs.Len() >= mp.cfg.MaxTxPerSender {
+		return ErrMempoolSenderMaxCapacity
+	}
+
+	// collect the transactions to evict before modifying the mempool, so that a
+	// rejected transaction leaves the mempool untouched.
+	var evicted []*feeMarketTx[C]
+	for node := mp.tails.Back(); node != nil && mp.isFull(count, bytes); node = node.Prev() {
+		tail := node.Key().(txMeta[C])
+		if tail.sender == sender {
+			continue
+		}
+
+		// tails are iterated from the lowest priority, there are no more
+		// eviction candidates.
+		if mp.cfg.TxPriority.Compare(tail.priority, priority) >= 0 {
+			break
+		}
+
+		e := mp.txs[txKey{address: tail.sender, nonce: tail.nonce}]
+		evicted = append(evicted, e)
+		count, bytes = count-1, bytes-e.size
+	}
+	if mp.isFull(count, bytes) {
+		return ErrMempoolTxMaxCapacity
+	}
+
+	for _, e := range evicted {
+		if err := mp.remove(e); err != nil {
+			return err
+		}
+	}
+	if replacing {
+		if err := mp.remove(old); err != nil {
+			return err
+		}
+	}
+
+	return mp.insert(ctx, &feeMarketTx[C]{tx: tx, key: key, priority: priority, size: size, height: height})
+}
+
+// Select returns an iterator over the transactions of the mempool, ordered by
+// priority and sender-nonce as in the PriorityNonceMempool. Expired transactions
+// are removed first. The passed in list of transactions are ignored.
+//
+// NOTE: It is not safe to use this iterator while removing transactions from
+// the underlying mempool.
+func (mp *FeeMarketMempool[C]) Select(ctx context.Context, txs [][]byte) Iterator {
+	mp.mtx.Lock()
+	mp.removeExpired(blockHeight(ctx))
+	mp.mtx.Unlock()
+
+	return mp.pool.Select(ctx, txs)
+}
+
+// CountTx returns the number of transactions in the mempool.
+func (mp *FeeMarketMempool[C]) CountTx() int {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	return len(mp.txs)
+}
+
+// Bytes returns the total size in bytes of the transactions in the mempool.
+func (mp *FeeMarketMempool[C]) Bytes() int64 {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	return mp.bytes
+}
+
+// Remove removes a transaction from the mempool, returning an error if
+// unsuccessful.
+func (mp *FeeMarketMempool[C]) Remove(tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	sigs, err := tx.(signing.SigVerifiableTx).GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+	if len(sigs) == 0 {
+		return fmt.Errorf("attempted to remove a tx with no signatures")
+	}
+
+	sig := sigs[0]
+	e, ok := mp.txs[txKey{address: sdk.AccAddress(sig.PubKey.Address()).String(), nonce: sig.Sequence}]
+	if !ok {
+		return ErrTxNotFound
+	}
+
+	return mp.remove(e)
+}
+
+func (mp *FeeMarketMempool[C]) isFull(count int, bytes int64) bool {
+	return (mp.cfg.MaxTx > 0 && count > mp.cfg.MaxTx) || (mp.cfg.MaxBytes > 0 && bytes > mp.cfg.MaxBytes)
+}
+
+func (mp *FeeMarketMempool[C]) insert(ctx context.Context, e *feeMarketTx[C]) error {
+	if err := mp.pool.Insert(ctx, e.tx); err != nil {
+		return err
+	}
+
+	senderTxs, ok := mp.senders[e.key.address]
+	if !ok {
+		senderTxs = skiplist.New(skiplist.Uint64)
+		mp.senders[e.key.address] = senderTxs
+	}
+
+	mp.untrackTail(senderTxs)
+	senderTxs.Set(e.key.nonce, e)
+	mp.trackTail(senderTxs)
+
+	mp.txs[e.key] = e
+	mp.bytes += e.size
+
+	return nil
+}
+
+func (mp *FeeMarketMempool[C]) remove(e *feeMarketTx[C]) error {
+	if err := mp.pool.Remove(e.tx); err != nil {
+		return err
+	}
+
+	senderTxs := mp.senders[e.key.address]
+	mp.untrackTail(senderTxs)
+	senderTxs.Remove(e.key.nonce)
+	if senderTxs.Len() == 0 {
+		delete(mp.senders, e.key.address)
+	} else {
+		mp.trackTail(senderTxs)
+	}
+
+	delete(mp.txs, e.key)
+	mp.bytes -= e.size
+
+	return nil
+}
+
+// untrackTail removes the highest nonce transaction of a sender from the
+// eviction candidates.
+func (mp *FeeMarketMempool[C]) untrackTail(senderTxs *skiplist.SkipList) {
+	if back := senderTxs.Back(); back != nil {
+		mp.tails.Remove(back.Value.(*feeMarketTx[C]).meta())
+	}
+}
+
+// trackTail adds the highest nonce transaction of a sender to the eviction
+// candidates.
+func (mp *FeeMarketMempool[C]) trackTail(senderTxs *skiplist.SkipList) {
+	if back := senderTxs.Back(); back != nil {
+		e := back.Value.(*feeMarketTx[C])
+		mp.tails.Set(e.meta(), e)
+	}
+}
+
+// removeExpired removes the transactions inserted TTL blocks or more before
+// the given height. It is a no-op when the height did not increase since the
+// last call.
+func (mp *FeeMarketMempool[C]) removeExpired(height int64) {
+	if mp.cfg.TTL <= 0 || height <= mp.lastHeight {
+		return
+	}
+	mp.lastHeight = height
+
+	var expired []*feeMarketTx[C]
+	for _, e := range mp.txs {
+		if height-e.height >= mp.cfg.TTL {
+			expired = append(expired, e)
+		}
+	}
+
+	for _, e := range expired {
+		// the transaction is known to be in the mempool
+		_ = mp.remove(e)
+	}
+}
+
+func (e *feeMarketTx[C]) meta() txMeta[C] {
+	return txMeta[C]{nonce: e.key.nonce, priority: e.priority, sender: e.key.address}
+}
+
+// blockHeight returns the block height of an SDK context, or zero when the
+// context does not wrap one.
+func blockHeight(ctx context.Context) int64 {
+	if sdkCtx, ok := ctx.(sdk.Context); ok {
+		return sdkCtx.BlockHeight()
+	}
+	if sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context); ok {
+		return sdkCtx.BlockHeight()
+	}
+
+	return 0
+}
@@ -0,0 +1,244 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// testFeeTx is a testTx paying a fee and with a given encoded size.
+type testFeeTx struct {
+	testTx
+	fee  sdk.Coins
+	size int
+}
+
+var _ sdk.FeeTx = testFeeTx{}
+
+func (tx testFeeTx) GetGas() uint64 { return 0 }
+
+func (tx testFeeTx) GetFee() sdk.Coins { return tx.fee }
+
+func (tx testFeeTx) FeePayer() []byte { return tx.address }
+
+func (tx testFeeTx) FeeGranter() []byte { return nil }
+
+func testFeeTxEncoder(tx sdk.Tx) ([]byte, error) {
+	return make([]byte, tx.(testFeeTx).size), nil
+}
+
+func newTestFeeTx(address sdk.AccAddress, nonce uint64, priority int64, size int) testFeeTx {
+	return testFeeTx{
+		testTx: testTx{priority: priority, nonce: nonce, address: address},
+		fee:    sdk.NewCoins(sdk.NewInt64Coin("stake", priority*100)),
+		size:   size,
+	}
+}
+
+func newTestFeeMarketMempool(cfg mempool.FeeMarketMempoolConfig[int64]) *mempool.FeeMarketMempool[int64] {
+	cfg.TxPriority = mempool.NewDefaultTxPriority()
+	cfg.TxEncoder = testFeeTxEncoder
+	return mempool.NewFeeMarketMempool(cfg)
+}
+
+func selectTxs(mp mempool.Mempool, ctx sdk.Context) []sdk.Tx {
+	return fetchTxs(mp.Select(ctx, nil), 1000)
+}
+
+func TestFeeMarketMempool_MaxTxEviction(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 4)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa, sb, sc, sd := accounts[0].Address, accounts[1].Address, accounts[2].Address, accounts[3].Address
+
+	mp := newTestFeeMarketMempool(mempool.FeeMarketMempoolConfig[int64]{MaxTx: 3})
+
+	txA1 := newTestFeeTx(sa, 1, 10, 1)
+	txA2 := newTestFeeTx(sa, 2, 30, 1)
+	txB1 := newTestFeeTx(sb, 1, 20, 1)
+	for _, tx := range []testFeeTx{txA1, txA2, txB1} {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+	require.Equal(t, 3, mp.CountTx())
+
+	// a tx with a priority not greater than any eviction candidate is rejected,
+	// txA1 has the lowest priority but is not the last tx of sa.
+	txC1 := newTestFeeTx(sc, 1, 20, 1)
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(txC1.priority), txC1), mempool.ErrMempoolTxMaxCapacity)
+	require.Equal(t, 3, mp.CountTx())
+
+	// txB1 is the lowest priority eviction candidate
+	txC1 = newTestFeeTx(sc, 1, 25, 1)
+	require.NoError(t, mp.Insert(ctx.WithPriority(txC1.priority), txC1))
+	require.Equal(t, 3, mp.CountTx())
+	require.ErrorIs(t, mp.Remove(txB1), mempool.ErrTxNotFound)
+
+	// txC1 has a lower priority than txA2 but a sender never evicts its own txs
+	txC2 := newTestFeeTx(sc, 2, 100, 1)
+	require.NoError(t, mp.Insert(ctx.WithPriority(txC2.priority), txC2))
+	require.Equal(t, []sdk.Tx{txC1, txC2, txA1}, selectTxs(mp, ctx))
+
+	// txA1 is now the last tx of sa
+	txD1 := newTestFeeTx(sd, 1, 15, 1)
+	require.NoError(t, mp.Insert(ctx.WithPriority(txD1.priority), txD1))
+	require.Equal(t, []sdk.Tx{txC1, txC2, txD1}, selectTxs(mp, ctx))
+
+	// the only eviction candidates are txs of the sender
+	mp = newTestFeeMarketMempool(mempool.FeeMarketMempoolConfig[int64]{MaxTx: 2})
+	require.NoError(t, mp.Insert(ctx.WithPriority(txA1.priority), txA1))
+	require.NoError(t, mp.Insert(ctx.WithPriority(txA2.priority), txA2))
+	txA3 := newTestFeeTx(sa, 3, 100, 1)
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(txA3.priority), txA3), mempool.ErrMempoolTxMaxCapacity)
+}
+
+func TestFeeMarketMempool_MaxBytesEviction(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 4)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa, sb, sc, sd := accounts[0].Address, accounts[1].Address, accounts[2].Address, accounts[3].Address
+
+	mp := newTestFeeMarketMempool(mempool.FeeMarketMempoolConfig[int64]{MaxBytes: 100})
+
+	txA1 := newTestFeeTx(sa, 1, 10, 40)
+	txB1 := newTestFeeTx(sb, 1, 20, 40)
+	for _, tx := range []testFeeTx{txA1, txB1} {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+	require.Equal(t, int64(80), mp.Bytes())
+
+	// a tx larger than the mempool is always rejected
+	tooLarge := newTestFeeTx(sc, 1, 100, 101)
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(tooLarge.priority), tooLarge), mempool.ErrMempoolTxMaxCapacity)
+
+	// evicting txA1 is not enough, nothing is evicted
+	txC1 := newTestFeeTx(sc, 1, 15, 90)
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(txC1.priority), txC1), mempool.ErrMempoolTxMaxCapacity)
+	require.Equal(t, 2, mp.CountTx())
+	require.Equal(t, int64(80), mp.Bytes())
+
+	// both txs are evicted
+	txC1 = newTestFeeTx(sc, 1, 25, 90)
+	require.NoError(t, mp.Insert(ctx.WithPriority(txC1.priority), txC1))
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, int64(90), mp.Bytes())
+
+	txD1 := newTestFeeTx(sd, 1, 30, 10)
+	require.NoError(t, mp.Insert(ctx.WithPriority(txD1.priority), txD1))
+	require.Equal(t, int64(100), mp.Bytes())
+
+	require.NoError(t, mp.Remove(txC1))
+	require.Equal(t, int64(10), mp.Bytes())
+}
+
+func TestFeeMarketMempool_MaxTxPerSender(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	mp := newTestFeeMarketMempool(mempool.FeeMarketMempoolConfig[int64]{MaxTxPerSender: 2})
+
+	for nonce := uint64(1); nonce <= 2; nonce++ {
+		tx := newTestFeeTx(sa, nonce, 10, 1)
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+
+	tx := newTestFeeTx(sa, 3, 10, 1)
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(tx.priority), tx), mempool.ErrMempoolSenderMaxCapacity)
+
+	// replacing a tx does not count towards the limit
+	tx = newTestFeeTx(sa, 2, 20, 1)
+	require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+
+	tx = newTestFeeTx(sb, 1, 10, 1)
+	require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func TestFeeMarketMempool_TTL(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	mp := newTestFeeMarketMempool(mempool.FeeMarketMempoolConfig[int64]{TTL: 5})
+
+	txA1 := newTestFeeTx(sa, 1, 10, 1)
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(10).WithPriority(txA1.priority), txA1))
+	txB1 := newTestFeeTx(sb, 1, 10, 1)
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(12).WithPriority(txB1.priority), txB1))
+
+	require.Len(t, selectTxs(mp, ctx.WithBlockHeight(14)), 2)
+	require.Equal(t, []sdk.Tx{txB1}, selectTxs(mp, ctx.WithBlockHeight(15)))
+	require.Equal(t, 1, mp.CountTx())
+
+	// expired txs are removed on insert too
+	txA2 := newTestFeeTx(sa, 2, 10, 1)
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(17).WithPriority(txA2.priority), txA2))
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, []sdk.Tx{txA2}, selectTxs(mp, ctx.WithBlockHeight(17)))
+}
+
+func TestFeeMarketMempool_FeeBumpReplacement(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa := accounts[0].Address
+
+	cfg := mempool.DefaultFeeMarketMempoolConfig()
+	cfg.TxEncoder = testFeeTxEncoder
+	mp := mempool.NewFeeMarketMempool(cfg)
+
+	tx := newTestFeeTx(sa, 1, 100, 10)
+	require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+
+	testCases := []struct {
+		name     string
+		tx       testFeeTx
+		replaces bool
+	}{
+		{"lower priority", newTestFeeTx(sa, 1, 99, 10), false},
+		{"fee bump below 10%", newTestFeeTx(sa, 1, 109, 10), false},
+		{
+			"fee bump in another denom",
+			testFeeTx{testTx: testTx{priority: 200, nonce: 1, address: sa}, fee: sdk.NewCoins(sdk.NewInt64Coin("atom", 100000)), size: 10},
+			false,
+		},
+		{"fee bump of 10%", newTestFeeTx(sa, 1, 110, 20), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := mp.Insert(ctx.WithPriority(tc.tx.priority), tc.tx)
+			if !tc.replaces {
+				require.Error(t, err)
+				require.Equal(t, []sdk.Tx{tx}, selectTxs(mp, ctx))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, 1, mp.CountTx())
+			require.Equal(t, int64(tc.tx.size), mp.Bytes())
+			require.Equal(t, []sdk.Tx{tc.tx}, selectTxs(mp, ctx))
+		})
+	}
+}
+
+func TestFeeMarketMempool_RandomTxs(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	mp := newTestFeeMarketMempool(mempool.FeeMarketMempoolConfig[int64]{MaxTx: 100, MaxTxPerSender: 5})
+
+	for _, tx := range genRandomTxs(0, 1000, 50) {
+		_ = mp.Insert(ctx.WithPriority(tx.priority), testFeeTx{testTx: tx, size: 1})
+		require.LessOrEqual(t, mp.CountTx(), 100)
+	}
+
+	var txs []sdk.Tx
+	for _, tx := range selectTxs(mp, ctx) {
+		txs = append(txs, tx.(testFeeTx).testTx)
+	}
+	require.Len(t, txs, mp.CountTx())
+	require.NoError(t, validateOrder(txs))
+}
@@ -0,0 +1,175 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ Mempool  = (*LaneMempool)(nil)
+	_ Iterator = (*laneIterator)(nil)
+)
+
+type (
+	// Lane defines a named partition of a LaneMempool. Transactions are routed
+	// to the first lane they match and are stored in the mempool of that lane.
+	Lane struct {
+		// Name is the unique name of the lane.
+		Name string
+
+		// Match returns true if the transaction belongs to the lane. It must only
+		// depend on the transaction, as it is used both when inserting and when
+		// removing transactions, and when building proposals. A nil Match matches
+		// every transaction.
+		Match func(tx sdk.Tx) bool
+
+		// Mempool stores and orders the transactions of the lane.
+		Mempool Mempool
+
+		// MaxBlockSpace is the maximum share of the block bytes and gas the
+		// transactions of the lane can use in a proposal. A nil or zero value
+		// means the lane can use the whole block.
+		MaxBlockSpace math.LegacyDec
+	}
+
+	// LaneMempool is a mempool composed of lanes, each one with its own
+	// mempool. Lanes are ordered by priority: Select iterates over all the
+	// transactions of the first lane, then over the transactions of the second
+	// lane, and so on. This allows transactions such as oracle or IBC relayer
+	// ones to not compete for block space with all the other transactions.
+	//
+	// The block space share of each lane is enforced in PrepareProposal by the
+	// baseapp.DefaultProposalHandler, which uses a lane aware TxSelector when its
+	// mempool is a LaneMempool.
+	LaneMempool struct {
+		lanes []Lane
+	}
+
+	// laneIterator iterates over the transactions of the lanes of a LaneMempool,
+	// selecting the transactions of a lane only once the previous lanes are
+	// exhausted.
+	laneIterator struct {
+		ctx     context.Context
+		txs     [][]byte
+		mempool *LaneMempool
+		lane    int
+		cursor  Iterator
+	}
+)
+
+// NewLaneMempool returns a LaneMempool with the given lanes, ordered by
+// priority. It panics if a lane has no name or mempool, if two lanes have the
+// same name, or if a lane has a block space share outside of [0, 1].
+func NewLaneMempool(lanes ...Lane) *LaneMempool {
+	names := make(map[string]bool, len(lanes))
+	for _, lane := range lanes {
+		if lane.Name == "" {
+			panic("lane mempool: lane name cannot be empty")
+		}
+		if names[lane.Name] {
+			panic(fmt.Sprintf("lane mempool: duplicate lane %s", lane.Name))
+		}
+		names[lane.Name] = true
+
+		if lane.Mempool == nil {
+			panic(fmt.Sprintf("lane mempool: lane %s has no mempool", lane.Name))
+		}
+		if !lane.MaxBlockSpace.IsNil() && (lane.MaxBlockSpace.IsNegative() || lane.MaxBlockSpace.GT(math.LegacyOneDec())) {
+			panic(fmt.Sprintf("lane mempool: lane %s max block space must be between 0 and 1, got %s", lane.Name, lane.MaxBlockSpace))
+		}
+	}
+
+	return &LaneMempool{lanes: lanes}
+}
+
+// Lanes returns the lanes of the mempool, ordered by priority.
+func (mp *LaneMempool) Lanes() []Lane {
+	return mp.lanes
+}
+
+// LaneOf returns the first lane matched by the transaction, and false if the
+// transaction matches no lane.
+func (mp *LaneMempool) LaneOf(tx sdk.Tx) (Lane, bool) {
+	for _, lane := range mp.lanes {
+		if lane.Match == nil || lane.Match(tx) {
+			return lane, true
+		}
+	}
+
+	return Lane{}, false
+}
+
+// Insert inserts the transaction in the mempool of the first lane it matches,
+// returning an error if it matches no lane.
+func (mp *LaneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	lane, ok := mp.LaneOf(tx)
+	if !ok {
+		return fmt.Errorf("tx does not match any mempool lane")
+	}
+
+	return lane.Mempool.Insert(ctx, tx)
+}
+
+// Select returns an iterator over the transactions of all the lanes, in lane
+// priority order. The transactions of a lane are ordered by the mempool of
+// the lane.
+func (mp *LaneMempool) Select(ctx context.Context, txs [][]byte) Iterator {
+	iterator := &laneIterator{
+		ctx:     ctx,
+		txs:     txs,
+		mempool: mp,
+		lane:    -1,
+	}
+
+	return iterator.nextLane()
+}
+
+// CountTx returns the number of transactions of all the lanes.
+func (mp *LaneMempool) CountTx() int {
+	count := 0
+	for _, lane := range mp.lanes {
+		count += lane.Mempool.CountTx()
+	}
+
+	return count
+}
+
+// Remove removes the transaction from the mempool of the first lane it
+// matches.
+func (mp *LaneMempool) Remove(tx sdk.Tx) error {
+	lane, ok := mp.LaneOf(tx)
+	if !ok {
+		return ErrTxNotFound
+	}
+
+	return lane.Mempool.Remove(tx)
+}
+
+// nextLane moves the iterator to the first transaction of the next non-empty
+// lane, returning nil when all the lanes are exhausted.
+func (i *laneIterator) nextLane() Iterator {
+	for i.lane++; i.lane < len(i.mempool.lanes); i.lane++ {
+		i.cursor = i.mempool.lanes[i.lane].Mempool.Select(i.ctx, i.txs)
+		if i.cursor != nil {
+			return i
+		}
+	}
+
+	return nil
+}
+
+func (i *laneIterator) Next() Iterator {
+	if i.cursor = i.cursor.Next(); i.cursor != nil {
+		return i
+	}
+
+	return i.nextLane()
+}
+
+func (i *laneIterator) Tx() sdk.Tx {
+	return i.cursor.Tx()
+}
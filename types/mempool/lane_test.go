@@ -0,0 +1,91 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func matchSender(address sdk.AccAddress) func(tx sdk.Tx) bool {
+	return func(tx sdk.Tx) bool {
+		return tx.(testTx).address.Equals(address)
+	}
+}
+
+func TestLaneMempool(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa, sb, sc := accounts[0].Address, accounts[1].Address, accounts[2].Address
+
+	mp := mempool.NewLaneMempool(
+		mempool.Lane{Name: "oracle", Match: matchSender(sa), Mempool: mempool.DefaultPriorityMempool(), MaxBlockSpace: math.LegacyNewDecWithPrec(1, 1)},
+		mempool.Lane{Name: "default", Mempool: mempool.DefaultPriorityMempool()},
+	)
+	require.Nil(t, mp.Select(ctx, nil))
+
+	txs := []testTx{
+		{priority: 1, nonce: 1, address: sa},
+		{priority: 100, nonce: 1, address: sb},
+		{priority: 2, nonce: 2, address: sa},
+		{priority: 50, nonce: 1, address: sc},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+	require.Equal(t, 4, mp.CountTx())
+	require.Equal(t, 2, mp.Lanes()[0].Mempool.CountTx())
+
+	lane, ok := mp.LaneOf(txs[1])
+	require.True(t, ok)
+	require.Equal(t, "default", lane.Name)
+
+	// the txs of the oracle lane come first, whatever their priority
+	require.Equal(t, []sdk.Tx{txs[0], txs[2], txs[1], txs[3]}, fetchTxs(mp.Select(ctx, nil), 1000))
+
+	require.NoError(t, mp.Remove(txs[0]))
+	require.NoError(t, mp.Remove(txs[1]))
+	require.ErrorIs(t, mp.Remove(txs[1]), mempool.ErrTxNotFound)
+	require.Equal(t, []sdk.Tx{txs[2], txs[3]}, fetchTxs(mp.Select(ctx, nil), 1000))
+
+	require.NoError(t, mp.Remove(txs[2]))
+	require.Equal(t, []sdk.Tx{txs[3]}, fetchTxs(mp.Select(ctx, nil), 1000))
+}
+
+func TestLaneMempool_NoMatch(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	mp := mempool.NewLaneMempool(
+		mempool.Lane{Name: "oracle", Match: matchSender(sa), Mempool: mempool.DefaultPriorityMempool()},
+	)
+
+	tx := testTx{priority: 1, nonce: 1, address: sb}
+	require.ErrorContains(t, mp.Insert(ctx, tx), "tx does not match any mempool lane")
+	require.ErrorIs(t, mp.Remove(tx), mempool.ErrTxNotFound)
+	require.Equal(t, 0, mp.CountTx())
+}
+
+func TestNewLaneMempool(t *testing.T) {
+	testCases := map[string][]mempool.Lane{
+		"empty name":        {{Mempool: mempool.DefaultPriorityMempool()}},
+		"no mempool":        {{Name: "default"}},
+		"duplicate lane":    {{Name: "default", Mempool: mempool.DefaultPriorityMempool()}, {Name: "default", Mempool: mempool.DefaultPriorityMempool()}},
+		"negative share":    {{Name: "default", Mempool: mempool.DefaultPriorityMempool(), MaxBlockSpace: math.LegacyNewDec(-1)}},
+		"share exceeding 1": {{Name: "default", Mempool: mempool.DefaultPriorityMempool(), MaxBlockSpace: math.LegacyNewDecWithPrec(11, 1)}},
+	}
+
+	for name, lanes := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Panics(t, func() { mempool.NewLaneMempool(lanes...) })
+		})
+	}
+}
@@ -2,23 +2,29 @@ package accounts
 
 import (
 	"context"
+	"errors"
 	"strconv"
 
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
+	authenticationv1 "cosmossdk.io/api/cosmos/accounts/interfaces/authentication/v1"
 	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	"cosmossdk.io/collections"
+	"cosmossdk.io/x/accounts/accountstd"
 	"cosmossdk.io/x/accounts/internal/implementation"
 )
 
-var _ implementation.Account = (*TestAccount)(nil)
+var (
+	_ implementation.Account       = (*TestAccount)(nil)
+	_ implementation.Authenticator = (*TestAccount)(nil)
+)
 
-func NewTestAccount(sb *collections.SchemaBuilder) *TestAccount {
+func NewTestAccount(d accountstd.Dependencies) (*TestAccount, error) {
 	return &TestAccount{
-		Counter: collections.NewSequence(sb, collections.NewPrefix(0), "counter"),
-	}
+		Counter: collections.NewSequence(d.SchemaBuilder, collections.NewPrefix(0), "counter"),
+	}, nil
 }
 
 type TestAccount struct {
@@ -76,6 +82,11 @@ func (t TestAccount) RegisterExecuteHandlers(builder *implementation.ExecuteBuil
 	implementation.RegisterExecuteHandler(builder, func(ctx context.Context, req *wrapperspb.UInt64Value) (*emptypb.Empty, error) {
 		return new(emptypb.Empty), t.Counter.Set(ctx, req.Value)
 	})
+
+	// account to account execution testing, we set the counter of the target account.
+	implementation.RegisterExecuteHandler(builder, func(ctx context.Context, req *wrapperspb.BytesValue) (*emptypb.Empty, error) {
+		return implementation.ExecAccount[emptypb.Empty](ctx, req.Value, &wrapperspb.UInt64Value{Value: 42})
+	})
 }
 
 func (t TestAccount) RegisterQueryHandlers(builder *implementation.QueryBuilder) {
@@ -115,3 +126,69 @@ func (t TestAccount) RegisterQueryHandlers(builder *implementation.QueryBuilder)
 		return &wrapperspb.UInt64Value{Value: v}, nil
 	})
 }
+
+func (t TestAccount) RegisterAuthenticateHandler(builder *implementation.AuthenticateBuilder) {
+	// authentication testing, only accepts requests whose sequence is 1.
+	implementation.RegisterAuthenticateHandler(builder, func(_ context.Context, req *authenticationv1.MsgAuthenticate) (*authenticationv1.MsgAuthenticateResponse, error) {
+		if req.Signature.Sequence != 1 {
+			return nil, errors.New("invalid sequence")
+		}
+		return &authenticationv1.MsgAuthenticateResponse{}, nil
+	})
+}
+
+var _ implementation.Migrator = (*TestMigratedAccount)(nil)
+
+// NewTestMigratedAccount creates an account which TestAccount accounts can be migrated to.
+func NewTestMigratedAccount(d accountstd.Dependencies) (*TestMigratedAccount, error) {
+	return &TestMigratedAccount{
+		Value: collections.NewItem(d.SchemaBuilder, collections.NewPrefix(0), "value", collections.StringValue),
+	}, nil
+}
+
+type TestMigratedAccount struct {
+	Value collections.Item[string]
+}
+
+func (t TestMigratedAccount) RegisterInitHandler(builder *implementation.InitBuilder) {
+	implementation.RegisterInitHandler(builder, func(_ context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+		return &emptypb.Empty{}, nil
+	})
+}
+
+func (t TestMigratedAccount) RegisterExecuteHandlers(_ *implementation.ExecuteBuilder) {}
+
+func (t TestMigratedAccount) RegisterQueryHandlers(builder *implementation.QueryBuilder) {
+	implementation.RegisterQueryHandler(builder, func(ctx context.Context, _ *emptypb.Empty) (*wrapperspb.StringValue, error) {
+		v, err := t.Value.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return wrapperspb.String(v), nil
+	})
+}
+
+func (t TestMigratedAccount) RegisterMigrateHandler(builder *implementation.MigrateBuilder) {
+	// the old counter is read and stored as a string with the given suffix, the
+	// migration fails after storing it if the suffix is "fail".
+	implementation.RegisterMigrateHandler(builder, func(ctx context.Context, fromAccountType string, req *wrapperspb.StringValue) (*wrapperspb.UInt64Value, error) {
+		if fromAccountType != "test" {
+			return nil, errors.New("unsupported account type")
+		}
+		oldCounter := collections.NewSequence(
+			collections.NewSchemaBuilderFromAccessor(implementation.OpenOldKVStore),
+			collections.NewPrefix(0), "counter",
+		)
+		counter, err := oldCounter.Peek(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := t.Value.Set(ctx, strconv.FormatUint(counter, 10)+req.Value); err != nil {
+			return nil, err
+		}
+		if req.Value == "fail" {
+			return nil, errors.New("migration failure")
+		}
+		return wrapperspb.UInt64(counter), nil
+	})
+}
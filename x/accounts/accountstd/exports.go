@@ -0,0 +1,142 @@
+// Package accountstd exports the types and functions that are used by developers to implement smart accounts.
+package accountstd
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	authenticationv1 "cosmossdk.io/api/cosmos/accounts/interfaces/authentication/v1"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/accounts/internal/implementation"
+)
+
+// Interface is the exported interface of an Account.
+type Interface = implementation.Account
+
+// ExecuteBuilder is the exported type of ExecuteBuilder.
+type ExecuteBuilder = implementation.ExecuteBuilder
+
+// QueryBuilder is the exported type of QueryBuilder.
+type QueryBuilder = implementation.QueryBuilder
+
+// InitBuilder is the exported type of InitBuilder.
+type InitBuilder = implementation.InitBuilder
+
+// AuthenticateBuilder is the exported type of AuthenticateBuilder.
+type AuthenticateBuilder = implementation.AuthenticateBuilder
+
+// Authenticator is the exported interface of an Account which can authenticate transactions.
+type Authenticator = implementation.Authenticator
+
+// MigrateBuilder is the exported type of MigrateBuilder.
+type MigrateBuilder = implementation.MigrateBuilder
+
+// Migrator is the exported interface of an Account which accepts migrations.
+type Migrator = implementation.Migrator
+
+// Dependencies are the dependencies passed to an account constructor.
+type Dependencies = implementation.Dependencies
+
+// AccountCreatorFunc is the exported type of AccountCreatorFunc.
+type AccountCreatorFunc = implementation.AccountCreatorFunc
+
+// AddAccount is a helper function to add a smart account to the list of smart accounts.
+func AddAccount[A Interface](name string, constructor func(deps Dependencies) (A, error)) AccountCreatorFunc {
+	return func(deps Dependencies) (string, Interface, error) {
+		acc, err := constructor(deps)
+		return name, acc, err
+	}
+}
+
+// RegisterExecuteHandler registers an execution handler for a smart account that uses protobuf.
+func RegisterExecuteHandler[
+	Req any, ProtoReq implementation.ProtoMsg[Req], Resp any, ProtoResp implementation.ProtoMsg[Resp],
+](router *ExecuteBuilder, handler func(ctx context.Context, req ProtoReq) (ProtoResp, error),
+) {
+	implementation.RegisterExecuteHandler(router, handler)
+}
+
+// RegisterQueryHandler registers a query handler for a smart account that uses protobuf.
+func RegisterQueryHandler[
+	Req any, ProtoReq implementation.ProtoMsg[Req], Resp any, ProtoResp implementation.ProtoMsg[Resp],
+](router *QueryBuilder, handler func(ctx context.Context, req ProtoReq) (ProtoResp, error),
+) {
+	implementation.RegisterQueryHandler(router, handler)
+}
+
+// RegisterInitHandler registers an initialisation handler for a smart account that uses protobuf.
+func RegisterInitHandler[
+	Req any, ProtoReq implementation.ProtoMsg[Req], Resp any, ProtoResp implementation.ProtoMsg[Resp],
+](router *InitBuilder, handler func(ctx context.Context, req ProtoReq) (ProtoResp, error),
+) {
+	implementation.RegisterInitHandler(router, handler)
+}
+
+// RegisterMigrateHandler registers a migration handler for a smart account that uses protobuf.
+// The handler is called when an account of type fromAccountType is migrated to the smart account.
+func RegisterMigrateHandler[
+	Req any, ProtoReq implementation.ProtoMsg[Req], Resp any, ProtoResp implementation.ProtoMsg[Resp],
+](router *MigrateBuilder, handler func(ctx context.Context, fromAccountType string, req ProtoReq) (ProtoResp, error),
+) {
+	implementation.RegisterMigrateHandler(router, handler)
+}
+
+// OpenOldKVStore returns the store containing the state of the account before the migration,
+// it can only be used within a migration handler. It can be used as a collections.SchemaBuilder
+// accessor in order to read the old state using the collections of the previous account type.
+func OpenOldKVStore(ctx context.Context) store.KVStore {
+	return implementation.OpenOldKVStore(ctx)
+}
+
+// RegisterAuthenticateHandler registers the authentication handler for a smart account.
+func RegisterAuthenticateHandler(
+	router *AuthenticateBuilder,
+	handler func(ctx context.Context, req *authenticationv1.MsgAuthenticate) (*authenticationv1.MsgAuthenticateResponse, error),
+) {
+	implementation.RegisterAuthenticateHandler(router, handler)
+}
+
+// Whoami returns the address of the account being invoked.
+func Whoami(ctx context.Context) []byte {
+	return implementation.Whoami(ctx)
+}
+
+// Sender returns the sender of the execution request.
+func Sender(ctx context.Context) []byte {
+	return implementation.Sender(ctx)
+}
+
+// ExecModule can be used to execute a message towards a module.
+func ExecModule[Resp any, RespProto implementation.ProtoMsg[Resp], Req any, ReqProto implementation.ProtoMsg[Req]](ctx context.Context, msg ReqProto) (RespProto, error) {
+	return implementation.ExecModule[Resp, RespProto, Req, ReqProto](ctx, msg)
+}
+
+// ExecModuleUntyped can be used to execute a message towards a module, when the response type is unknown.
+func ExecModuleUntyped(ctx context.Context, msg proto.Message) (proto.Message, error) {
+	return implementation.ExecModuleUntyped(ctx, msg)
+}
+
+// ExecAccount can be used by an account to execute a message on another account,
+// the invoking account is the sender of the message.
+func ExecAccount[Resp any, RespProto implementation.ProtoMsg[Resp], Req any, ReqProto implementation.ProtoMsg[Req]](ctx context.Context, target []byte, msg ReqProto) (RespProto, error) {
+	return implementation.ExecAccount[Resp, RespProto, Req, ReqProto](ctx, target, msg)
+}
+
+// ExecAccountUntyped can be used by an account to execute a message on another account,
+// when the response type is unknown.
+func ExecAccountUntyped(ctx context.Context, target []byte, msg proto.Message) (proto.Message, error) {
+	return implementation.ExecAccountUntyped(ctx, target, msg)
+}
+
+// QueryModule can be used by an account to execute a module query.
+func QueryModule[Resp any, RespProto implementation.ProtoMsg[Resp], Req any, ReqProto implementation.ProtoMsg[Req]](ctx context.Context, req ReqProto) (RespProto, error) {
+	return implementation.QueryModule[Resp, RespProto, Req, ReqProto](ctx, req)
+}
+
+// CollValue returns a collections.ValueCodec for a protobuf message, it can be used
+// by accounts to store protobuf messages in their state.
+func CollValue[T any, PT implementation.ProtoMsg[T]]() collcodec.ValueCodec[PT] {
+	return implementation.CollValue[T, PT]()
+}
@@ -0,0 +1,168 @@
+package base
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+
+	v1 "cosmossdk.io/api/cosmos/accounts/defaults/base/v1"
+	authenticationv1 "cosmossdk.io/api/cosmos/accounts/interfaces/authentication/v1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/accounts/accountstd"
+)
+
+var (
+	PubKeyPrefix   = collections.NewPrefix(0)
+	SequencePrefix = collections.NewPrefix(1)
+)
+
+var (
+	ErrInvalidPubKey    = errors.New("invalid public key")
+	ErrUnauthorized     = errors.New("unauthorized")
+	ErrInvalidSequence  = errors.New("invalid sequence")
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+// compressedPubKeySize is the size of a compressed secp256k1 public key.
+const compressedPubKeySize = 33
+
+var (
+	_ accountstd.Interface     = (*Account)(nil)
+	_ accountstd.Authenticator = (*Account)(nil)
+)
+
+// NewAccount creates a new base account, it can be registered in x/accounts
+// using accountstd.AddAccount.
+func NewAccount(d accountstd.Dependencies) (Account, error) {
+	return Account{
+		PubKey:   collections.NewItem(d.SchemaBuilder, PubKeyPrefix, "pub_key", collections.BytesValue),
+		Sequence: collections.NewSequence(d.SchemaBuilder, SequencePrefix, "sequence"),
+	}, nil
+}
+
+// Account is a base account which is controlled by a single secp256k1 public key.
+type Account struct {
+	// PubKey is the compressed secp256k1 public key of the account.
+	PubKey collections.Item[[]byte]
+	// Sequence is the sequence of the account, it is used to prevent replay attacks.
+	Sequence collections.Sequence
+}
+
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	if err := validatePubKey(msg.PubKey); err != nil {
+		return nil, err
+	}
+	return &v1.MsgInitResponse{}, a.PubKey.Set(ctx, msg.PubKey)
+}
+
+func (a Account) SwapPubKey(ctx context.Context, msg *v1.MsgSwapPubKey) (*v1.MsgSwapPubKeyResponse, error) {
+	// only the account itself can change its public key.
+	if !bytes.Equal(accountstd.Sender(ctx), accountstd.Whoami(ctx)) {
+		return nil, ErrUnauthorized
+	}
+	if err := validatePubKey(msg.NewPubKey); err != nil {
+		return nil, err
+	}
+	return &v1.MsgSwapPubKeyResponse{}, a.PubKey.Set(ctx, msg.NewPubKey)
+}
+
+// Authenticate authenticates a transaction signed by the account, it checks the
+// sequence of the signature, verifies it against the account's public key and
+// increments the sequence.
+func (a Account) Authenticate(ctx context.Context, msg *authenticationv1.MsgAuthenticate) (*authenticationv1.MsgAuthenticateResponse, error) {
+	if msg.Signature == nil {
+		return nil, fmt.Errorf("%w: missing signature", ErrInvalidSignature)
+	}
+	seq, err := a.Sequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Signature.Sequence != seq {
+		return nil, fmt.Errorf("%w: expected %d, got %d", ErrInvalidSequence, seq, msg.Signature.Sequence)
+	}
+
+	if !msg.SkipSignatureVerification {
+		single := msg.Signature.GetData().GetSingle()
+		if single == nil {
+			return nil, fmt.Errorf("%w: only single signatures are supported", ErrInvalidSignature)
+		}
+		pubKey, err := a.PubKey.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !verifySignature(pubKey, msg.SignBytes, single.Signature) {
+			return nil, fmt.Errorf("%w: signature verification failed", ErrUnauthorized)
+		}
+	}
+
+	_, err = a.Sequence.Next(ctx)
+	return &authenticationv1.MsgAuthenticateResponse{}, err
+}
+
+func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
+	seq, err := a.Sequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySequenceResponse{Sequence: seq}, nil
+}
+
+func (a Account) QueryPubKey(ctx context.Context, _ *v1.QueryPubKey) (*v1.QueryPubKeyResponse, error) {
+	pubKey, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryPubKeyResponse{PubKey: pubKey}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.SwapPubKey)
+}
+
+func (a Account) RegisterAuthenticateHandler(builder *accountstd.AuthenticateBuilder) {
+	accountstd.RegisterAuthenticateHandler(builder, a.Authenticate)
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
+	accountstd.RegisterQueryHandler(builder, a.QueryPubKey)
+}
+
+func validatePubKey(pubKey []byte) error {
+	if len(pubKey) != compressedPubKeySize {
+		return fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidPubKey, compressedPubKeySize, len(pubKey))
+	}
+	if pubKey[0] != 0x02 && pubKey[0] != 0x03 {
+		return fmt.Errorf("%w: not a compressed public key", ErrInvalidPubKey)
+	}
+	return nil
+}
+
+// verifySignature verifies a secp256k1 signature of the form R || S over the
+// sha256 hash of msg, it rejects signatures which are not in lower-S form.
+func verifySignature(pubKey, msg, sig []byte) bool {
+	if len(sig) != 64 {
+		return false
+	}
+	pub, err := secp256k1.ParsePubKey(pubKey)
+	if err != nil {
+		return false
+	}
+	var r, s secp256k1.ModNScalar
+	r.SetByteSlice(sig[:32])
+	s.SetByteSlice(sig[32:])
+	if s.IsOverHalfOrder() {
+		return false
+	}
+	hash := sha256.Sum256(msg)
+	return ecdsa.NewSignature(&r, &s).Verify(hash[:], pub)
+}
@@ -0,0 +1,155 @@
+package base
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	v1 "cosmossdk.io/api/cosmos/accounts/defaults/base/v1"
+	authenticationv1 "cosmossdk.io/api/cosmos/accounts/interfaces/authentication/v1"
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts"
+	"cosmossdk.io/x/accounts/accountstd"
+)
+
+type addressCodec struct{}
+
+func (addressCodec) StringToBytes(text string) ([]byte, error) { return []byte(text), nil }
+func (addressCodec) BytesToString(bz []byte) (string, error)   { return string(bz), nil }
+
+type headerService struct{}
+
+func (headerService) GetHeaderInfo(_ context.Context) header.Info { return header.Info{} }
+
+func newKeeper(t *testing.T) (accounts.Keeper, context.Context) {
+	t.Helper()
+	ss, ctx := colltest.MockStore()
+	k, err := accounts.NewKeeper(ss, headerService{}, addressCodec{}, nil, nil, nil, accountstd.AddAccount("base", NewAccount))
+	require.NoError(t, err)
+	return k, ctx
+}
+
+func TestAccount(t *testing.T) {
+	k, ctx := newKeeper(t)
+	pubKey := append([]byte{0x02}, make([]byte, 32)...)
+	newPubKey := append([]byte{0x03}, make([]byte, 32)...)
+
+	t.Run("invalid pub key", func(t *testing.T) {
+		_, _, err := k.Init(ctx, "base", []byte("creator"), &v1.MsgInit{PubKey: []byte("invalid")})
+		require.ErrorIs(t, err, ErrInvalidPubKey)
+
+		_, _, err = k.Init(ctx, "base", []byte("creator"), &v1.MsgInit{PubKey: append([]byte{0x04}, make([]byte, 32)...)})
+		require.ErrorIs(t, err, ErrInvalidPubKey)
+	})
+
+	_, addr, err := k.Init(ctx, "base", []byte("creator"), &v1.MsgInit{PubKey: pubKey})
+	require.NoError(t, err)
+
+	resp, err := k.Query(ctx, addr, &v1.QueryPubKey{})
+	require.NoError(t, err)
+	require.Equal(t, pubKey, resp.(*v1.QueryPubKeyResponse).PubKey)
+
+	resp, err = k.Query(ctx, addr, &v1.QuerySequence{})
+	require.NoError(t, err)
+	require.True(t, proto.Equal(&v1.QuerySequenceResponse{Sequence: 0}, resp.(proto.Message)))
+
+	t.Run("swap pub key - unauthorized", func(t *testing.T) {
+		_, err := k.Execute(ctx, addr, []byte("creator"), &v1.MsgSwapPubKey{NewPubKey: newPubKey})
+		require.ErrorIs(t, err, ErrUnauthorized)
+	})
+
+	t.Run("swap pub key - ok", func(t *testing.T) {
+		_, err := k.Execute(ctx, addr, addr, &v1.MsgSwapPubKey{NewPubKey: newPubKey})
+		require.NoError(t, err)
+
+		resp, err := k.Query(ctx, addr, &v1.QueryPubKey{})
+		require.NoError(t, err)
+		require.Equal(t, newPubKey, resp.(*v1.QueryPubKeyResponse).PubKey)
+	})
+}
+
+func TestAccount_Authenticate(t *testing.T) {
+	k, ctx := newKeeper(t)
+	privKey, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	_, addr, err := k.Init(ctx, "base", []byte("creator"), &v1.MsgInit{PubKey: privKey.PubKey().SerializeCompressed()})
+	require.NoError(t, err)
+
+	signBytes := []byte("sign bytes")
+	sign := func(msg []byte) []byte {
+		hash := sha256.Sum256(msg)
+		// remove the recovery code.
+		return ecdsa.SignCompact(privKey, hash[:], false)[1:]
+	}
+	sigDesc := func(seq uint64, sig []byte) *signingv1beta1.SignatureDescriptor {
+		return &signingv1beta1.SignatureDescriptor{
+			Data: &signingv1beta1.SignatureDescriptor_Data{
+				Sum: &signingv1beta1.SignatureDescriptor_Data_Single_{
+					Single: &signingv1beta1.SignatureDescriptor_Data_Single{
+						Mode:      signingv1beta1.SignMode_SIGN_MODE_DIRECT,
+						Signature: sig,
+					},
+				},
+			},
+			Sequence: seq,
+		}
+	}
+	requireSequence := func(t *testing.T, expected uint64) {
+		t.Helper()
+		resp, err := k.Query(ctx, addr, &v1.QuerySequence{})
+		require.NoError(t, err)
+		require.Equal(t, expected, resp.(*v1.QuerySequenceResponse).Sequence)
+	}
+
+	t.Run("wrong signature", func(t *testing.T) {
+		err := k.AuthenticateAccount(ctx, addr, 0, sigDesc(0, sign([]byte("other"))), signBytes, false)
+		require.ErrorIs(t, err, ErrUnauthorized)
+		requireSequence(t, 0)
+	})
+
+	t.Run("wrong sequence", func(t *testing.T) {
+		err := k.AuthenticateAccount(ctx, addr, 0, sigDesc(1, sign(signBytes)), signBytes, false)
+		require.ErrorIs(t, err, ErrInvalidSequence)
+		requireSequence(t, 0)
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		err := k.AuthenticateAccount(ctx, addr, 0, sigDesc(0, sign(signBytes)), signBytes, false)
+		require.NoError(t, err)
+		requireSequence(t, 1)
+
+		// the same signature cannot be replayed.
+		err = k.AuthenticateAccount(ctx, addr, 0, sigDesc(0, sign(signBytes)), signBytes, false)
+		require.ErrorIs(t, err, ErrInvalidSequence)
+	})
+
+	t.Run("skip signature verification", func(t *testing.T) {
+		err := k.AuthenticateAccount(ctx, addr, 0, sigDesc(1, nil), nil, true)
+		require.NoError(t, err)
+		requireSequence(t, 2)
+	})
+
+	t.Run("multi signature", func(t *testing.T) {
+		sig := &signingv1beta1.SignatureDescriptor{
+			Data: &signingv1beta1.SignatureDescriptor_Data{
+				Sum: &signingv1beta1.SignatureDescriptor_Data_Multi_{Multi: &signingv1beta1.SignatureDescriptor_Data_Multi{}},
+			},
+			Sequence: 2,
+		}
+		err := k.AuthenticateAccount(ctx, addr, 0, sig, signBytes, false)
+		require.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("direct execution is not allowed", func(t *testing.T) {
+		_, err := k.Execute(ctx, addr, addr, &authenticationv1.MsgAuthenticate{})
+		require.Error(t, err)
+	})
+}
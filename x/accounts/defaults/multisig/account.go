@@ -0,0 +1,417 @@
+package multisig
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	v1 "cosmossdk.io/api/cosmos/accounts/defaults/multisig/v1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+)
+
+var (
+	MembersPrefix   = collections.NewPrefix(0)
+	ConfigPrefix    = collections.NewPrefix(1)
+	SequencePrefix  = collections.NewPrefix(2)
+	ProposalsPrefix = collections.NewPrefix(3)
+	VotesPrefix     = collections.NewPrefix(4)
+)
+
+var (
+	ErrUnauthorized  = errors.New("unauthorized")
+	ErrInvalidConfig = errors.New("invalid multisig config")
+	ErrInvalidMember = errors.New("invalid multisig member")
+	ErrInvalidVote   = errors.New("invalid vote")
+	ErrProposal      = errors.New("invalid proposal")
+)
+
+var _ accountstd.Interface = (*Account)(nil)
+
+// NewAccount creates a new multisig account, it can be registered in x/accounts
+// using accountstd.AddAccount.
+func NewAccount(d accountstd.Dependencies) (*Account, error) {
+	return &Account{
+		Members:       collections.NewMap(d.SchemaBuilder, MembersPrefix, "members", collections.BytesKey, collections.Uint64Value),
+		Config:        collections.NewItem(d.SchemaBuilder, ConfigPrefix, "config", accountstd.CollValue[v1.Config]()),
+		Sequence:      collections.NewSequence(d.SchemaBuilder, SequencePrefix, "sequence"),
+		Proposals:     collections.NewMap(d.SchemaBuilder, ProposalsPrefix, "proposals", collections.Uint64Key, accountstd.CollValue[v1.Proposal]()),
+		Votes:         collections.NewMap(d.SchemaBuilder, VotesPrefix, "votes", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), collections.Int32Value),
+		addrCodec:     d.AddressCodec,
+		headerService: d.HeaderService,
+	}, nil
+}
+
+// Account is a weighted threshold multisig account. Members create proposals
+// containing messages, vote on them and execute them once they pass.
+type Account struct {
+	// Members maps the address of each member to its voting weight.
+	Members collections.Map[[]byte, uint64]
+	// Config is the configuration of the multisig.
+	Config collections.Item[*v1.Config]
+	// Sequence is used to assign the proposal IDs.
+	Sequence collections.Sequence
+	// Proposals maps proposal IDs to proposals.
+	Proposals collections.Map[uint64, *v1.Proposal]
+	// Votes maps (proposal ID, member address) to the vote option.
+	Votes collections.Map[collections.Pair[uint64, []byte], int32]
+
+	addrCodec     address.Codec
+	headerService header.Service
+}
+
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	if len(msg.Members) == 0 {
+		return nil, fmt.Errorf("%w: no members provided", ErrInvalidMember)
+	}
+
+	totalWeight := uint64(0)
+	for _, member := range msg.Members {
+		addr, err := a.addrCodec.StringToBytes(member.Address)
+		if err != nil {
+			return nil, err
+		}
+		if member.Weight == 0 {
+			return nil, fmt.Errorf("%w: member %s has zero weight", ErrInvalidMember, member.Address)
+		}
+		has, err := a.Members.Has(ctx, addr)
+		if err != nil {
+			return nil, err
+		}
+		if has {
+			return nil, fmt.Errorf("%w: duplicate member %s", ErrInvalidMember, member.Address)
+		}
+		if err := a.Members.Set(ctx, addr, member.Weight); err != nil {
+			return nil, err
+		}
+		totalWeight, err = addWeight(totalWeight, member.Weight)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := validateConfig(msg.Config, totalWeight); err != nil {
+		return nil, err
+	}
+	return &v1.MsgInitResponse{}, a.Config.Set(ctx, msg.Config)
+}
+
+// UpdateConfig updates the members and configuration of the multisig. It can only
+// be executed by the multisig itself, which means it must be part of a proposal.
+func (a Account) UpdateConfig(ctx context.Context, msg *v1.MsgUpdateConfig) (*v1.MsgUpdateConfigResponse, error) {
+	if !bytes.Equal(accountstd.Sender(ctx), accountstd.Whoami(ctx)) {
+		return nil, ErrUnauthorized
+	}
+	return a.updateConfig(ctx, msg)
+}
+
+func (a Account) updateConfig(ctx context.Context, msg *v1.MsgUpdateConfig) (*v1.MsgUpdateConfigResponse, error) {
+	for _, member := range msg.UpdateMembers {
+		addr, err := a.addrCodec.StringToBytes(member.Address)
+		if err != nil {
+			return nil, err
+		}
+		if member.Weight == 0 {
+			err = a.Members.Remove(ctx, addr)
+		} else {
+			err = a.Members.Set(ctx, addr, member.Weight)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	config := msg.Config
+	if config == nil {
+		var err error
+		config, err = a.Config.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	totalWeight, err := a.totalWeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateConfig(config, totalWeight); err != nil {
+		return nil, err
+	}
+	return &v1.MsgUpdateConfigResponse{}, a.Config.Set(ctx, config)
+}
+
+// CreateProposal creates a new proposal, only members can create proposals.
+func (a Account) CreateProposal(ctx context.Context, msg *v1.MsgCreateProposal) (*v1.MsgCreateProposalResponse, error) {
+	if err := a.assertMember(ctx, accountstd.Sender(ctx)); err != nil {
+		return nil, err
+	}
+	if msg.Proposal == nil || len(msg.Proposal.Messages) == 0 {
+		return nil, fmt.Errorf("%w: proposal must contain at least one message", ErrProposal)
+	}
+
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the voting period is positive, see validateConfig.
+	now := a.now(ctx)
+	if now > math.MaxInt64-config.VotingPeriod {
+		return nil, fmt.Errorf("%w: the end of the voting period overflows", ErrInvalidConfig)
+	}
+
+	proposalID, err := a.Sequence.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal := &v1.Proposal{
+		Title:           msg.Proposal.Title,
+		Summary:         msg.Proposal.Summary,
+		Messages:        msg.Proposal.Messages,
+		VotingPeriodEnd: now + config.VotingPeriod,
+		Status:          v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD,
+	}
+	if err := a.Proposals.Set(ctx, proposalID, proposal); err != nil {
+		return nil, err
+	}
+	return &v1.MsgCreateProposalResponse{ProposalId: proposalID}, nil
+}
+
+// Vote casts the vote of a member on a proposal.
+func (a Account) Vote(ctx context.Context, msg *v1.MsgVote) (*v1.MsgVoteResponse, error) {
+	voter := accountstd.Sender(ctx)
+	if err := a.assertMember(ctx, voter); err != nil {
+		return nil, err
+	}
+	if _, ok := v1.VoteOption_name[int32(msg.Option)]; !ok || msg.Option == v1.VoteOption_VOTE_OPTION_UNSPECIFIED {
+		return nil, fmt.Errorf("%w: unknown vote option %d", ErrInvalidVote, msg.Option)
+	}
+
+	proposal, err := a.Proposals.Get(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	if proposal.Status != v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD {
+		return nil, fmt.Errorf("%w: proposal %d is not in voting period", ErrInvalidVote, msg.ProposalId)
+	}
+	if a.now(ctx) >= proposal.VotingPeriodEnd {
+		return nil, fmt.Errorf("%w: voting period of proposal %d has ended", ErrInvalidVote, msg.ProposalId)
+	}
+
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	key := collections.Join(msg.ProposalId, voter)
+	if !config.Revote {
+		voted, err := a.Votes.Has(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if voted {
+			return nil, fmt.Errorf("%w: member already voted and revote is disabled", ErrInvalidVote)
+		}
+	}
+	return &v1.MsgVoteResponse{}, a.Votes.Set(ctx, key, int32(msg.Option))
+}
+
+// ExecuteProposal tallies the votes of a proposal and, if it passed, executes its messages.
+// Anyone can execute a proposal once its voting period ended, or earlier if the proposal
+// already passed and early execution is enabled.
+func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal) (*v1.MsgExecuteProposalResponse, error) {
+	proposal, err := a.Proposals.Get(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	if proposal.Status != v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD {
+		return nil, fmt.Errorf("%w: proposal %d was already executed", ErrProposal, msg.ProposalId)
+	}
+
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	passed, err := a.tally(ctx, msg.ProposalId, config)
+	if err != nil {
+		return nil, err
+	}
+
+	votingEnded := a.now(ctx) >= proposal.VotingPeriodEnd
+	if !votingEnded && !(passed && config.EarlyExecution) {
+		return nil, fmt.Errorf("%w: voting period of proposal %d has not ended", ErrProposal, msg.ProposalId)
+	}
+
+	if !passed {
+		proposal.Status = v1.ProposalStatus_PROPOSAL_STATUS_REJECTED
+		return &v1.MsgExecuteProposalResponse{}, a.Proposals.Set(ctx, msg.ProposalId, proposal)
+	}
+
+	proposal.Status = v1.ProposalStatus_PROPOSAL_STATUS_PASSED
+	if err := a.Proposals.Set(ctx, msg.ProposalId, proposal); err != nil {
+		return nil, err
+	}
+
+	responses := make([]*anypb.Any, 0, len(proposal.Messages))
+	for i, anyMsg := range proposal.Messages {
+		resp, err := a.executeMessage(ctx, anyMsg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute message %d of proposal %d: %w", i, msg.ProposalId, err)
+		}
+		respAny, err := anypb.New(resp)
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, respAny)
+	}
+	return &v1.MsgExecuteProposalResponse{Responses: responses}, nil
+}
+
+// executeMessage executes a single proposal message, messages which target the
+// multisig configuration are handled by the multisig itself.
+func (a Account) executeMessage(ctx context.Context, anyMsg *anypb.Any) (proto.Message, error) {
+	msg, err := anyMsg.UnmarshalNew()
+	if err != nil {
+		return nil, err
+	}
+	if updateConfig, ok := msg.(*v1.MsgUpdateConfig); ok {
+		return a.updateConfig(ctx, updateConfig)
+	}
+	return accountstd.ExecModuleUntyped(ctx, msg)
+}
+
+func (a Account) QueryConfig(ctx context.Context, _ *v1.QueryConfig) (*v1.QueryConfigResponse, error) {
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.QueryConfigResponse{Config: config}
+	err = a.Members.Walk(ctx, nil, func(addr []byte, weight uint64) (stop bool, err error) {
+		addrStr, err := a.addrCodec.BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+		resp.Members = append(resp.Members, &v1.Member{Address: addrStr, Weight: weight})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a Account) QueryProposal(ctx context.Context, msg *v1.QueryProposal) (*v1.QueryProposalResponse, error) {
+	proposal, err := a.Proposals.Get(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryProposalResponse{Proposal: proposal}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.UpdateConfig)
+	accountstd.RegisterExecuteHandler(builder, a.CreateProposal)
+	accountstd.RegisterExecuteHandler(builder, a.Vote)
+	accountstd.RegisterExecuteHandler(builder, a.ExecuteProposal)
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QueryConfig)
+	accountstd.RegisterQueryHandler(builder, a.QueryProposal)
+}
+
+// tally returns true if the proposal reached both the quorum and the threshold,
+// votes are weighted using the current member weights.
+func (a Account) tally(ctx context.Context, proposalID uint64, config *v1.Config) (bool, error) {
+	yesWeight, totalWeight := uint64(0), uint64(0)
+	rng := collections.NewPrefixedPairRange[uint64, []byte](proposalID)
+	err := a.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, []byte], option int32) (stop bool, err error) {
+		weight, err := a.Members.Get(ctx, key.K2())
+		if errors.Is(err, collections.ErrNotFound) {
+			// the member was removed after voting.
+			return false, nil
+		}
+		if err != nil {
+			return true, err
+		}
+		totalWeight, err = addWeight(totalWeight, weight)
+		if err != nil {
+			return true, err
+		}
+		if v1.VoteOption(option) == v1.VoteOption_VOTE_OPTION_YES {
+			yesWeight, err = addWeight(yesWeight, weight)
+			if err != nil {
+				return true, err
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return yesWeight >= config.Threshold && totalWeight >= config.Quorum, nil
+}
+
+func (a Account) totalWeight(ctx context.Context) (uint64, error) {
+	total := uint64(0)
+	err := a.Members.Walk(ctx, nil, func(_ []byte, weight uint64) (stop bool, err error) {
+		total, err = addWeight(total, weight)
+		return err != nil, err
+	})
+	return total, err
+}
+
+// addWeight returns the sum of two weights, or an error if it overflows.
+func addWeight(a, b uint64) (uint64, error) {
+	sum, carry := bits.Add64(a, b, 0)
+	if carry != 0 {
+		return 0, fmt.Errorf("%w: the total weight overflows", ErrInvalidMember)
+	}
+	return sum, nil
+}
+
+func (a Account) assertMember(ctx context.Context, addr []byte) error {
+	isMember, err := a.Members.Has(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return fmt.Errorf("%w: sender is not a member", ErrUnauthorized)
+	}
+	return nil
+}
+
+func (a Account) now(ctx context.Context) int64 {
+	return a.headerService.GetHeaderInfo(ctx).Time.Unix()
+}
+
+func validateConfig(config *v1.Config, totalWeight uint64) error {
+	switch {
+	case config == nil:
+		return fmt.Errorf("%w: config must be provided", ErrInvalidConfig)
+	case totalWeight == 0:
+		return fmt.Errorf("%w: the multisig must have at least one member", ErrInvalidConfig)
+	case config.Threshold == 0:
+		return fmt.Errorf("%w: threshold must be greater than zero", ErrInvalidConfig)
+	case config.Threshold > totalWeight:
+		return fmt.Errorf("%w: threshold %d is greater than the total weight %d", ErrInvalidConfig, config.Threshold, totalWeight)
+	case config.Quorum > totalWeight:
+		return fmt.Errorf("%w: quorum %d is greater than the total weight %d", ErrInvalidConfig, config.Quorum, totalWeight)
+	case config.VotingPeriod <= 0:
+		return fmt.Errorf("%w: voting period must be positive", ErrInvalidConfig)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,237 @@
+package multisig
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	v1 "cosmossdk.io/api/cosmos/accounts/defaults/multisig/v1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts"
+	"cosmossdk.io/x/accounts/accountstd"
+)
+
+type addressCodec struct{}
+
+func (addressCodec) StringToBytes(text string) ([]byte, error) { return []byte(text), nil }
+func (addressCodec) BytesToString(bz []byte) (string, error)   { return string(bz), nil }
+
+type headerService struct{ now *time.Time }
+
+func (h headerService) GetHeaderInfo(_ context.Context) header.Info { return header.Info{Time: *h.now} }
+
+func newKeeper(t *testing.T, now *time.Time, execModule func(ctx context.Context, msg proto.Message) (proto.Message, error)) (accounts.Keeper, context.Context) {
+	t.Helper()
+	ss, ctx := colltest.MockStore()
+	getSender := func(msg proto.Message) ([]byte, error) {
+		return []byte(msg.(*bankv1beta1.MsgSend).FromAddress), nil
+	}
+	k, err := accounts.NewKeeper(ss, headerService{now}, addressCodec{}, getSender, execModule, nil, accountstd.AddAccount("multisig", NewAccount))
+	require.NoError(t, err)
+	return k, ctx
+}
+
+func newAny(t *testing.T, msg proto.Message) *anypb.Any {
+	t.Helper()
+	anyMsg, err := anypb.New(msg)
+	require.NoError(t, err)
+	return anyMsg
+}
+
+func TestAccount(t *testing.T) {
+	now := time.Unix(1000, 0)
+	var executed []proto.Message
+	k, ctx := newKeeper(t, &now, func(_ context.Context, msg proto.Message) (proto.Message, error) {
+		executed = append(executed, msg)
+		return &bankv1beta1.MsgSendResponse{}, nil
+	})
+
+	config := &v1.Config{Threshold: 2, Quorum: 2, VotingPeriod: 100}
+	members := []*v1.Member{
+		{Address: "alice", Weight: 1},
+		{Address: "bob", Weight: 2},
+		{Address: "carol", Weight: 1},
+	}
+
+	t.Run("init - invalid config", func(t *testing.T) {
+		_, _, err := k.Init(ctx, "multisig", []byte("creator"), &v1.MsgInit{
+			Members: members,
+			Config:  &v1.Config{Threshold: 5, VotingPeriod: 100},
+		})
+		require.ErrorIs(t, err, ErrInvalidConfig)
+	})
+
+	t.Run("init - duplicate member", func(t *testing.T) {
+		_, _, err := k.Init(ctx, "multisig", []byte("creator"), &v1.MsgInit{
+			Members: append(members, &v1.Member{Address: "alice", Weight: 1}),
+			Config:  config,
+		})
+		require.ErrorIs(t, err, ErrInvalidMember)
+	})
+
+	_, addr, err := k.Init(ctx, "multisig", []byte("creator"), &v1.MsgInit{Members: members, Config: config})
+	require.NoError(t, err)
+
+	send := &bankv1beta1.MsgSend{FromAddress: string(addr), ToAddress: "recipient"}
+	update := &v1.MsgUpdateConfig{UpdateMembers: []*v1.Member{{Address: "dave", Weight: 1}, {Address: "carol"}}}
+
+	t.Run("create proposal - not a member", func(t *testing.T) {
+		_, err := k.Execute(ctx, addr, []byte("mallory"), &v1.MsgCreateProposal{
+			Proposal: &v1.Proposal{Messages: []*anypb.Any{newAny(t, send)}},
+		})
+		require.ErrorIs(t, err, ErrUnauthorized)
+	})
+
+	t.Run("update config - not the multisig", func(t *testing.T) {
+		_, err := k.Execute(ctx, addr, []byte("alice"), update)
+		require.ErrorIs(t, err, ErrUnauthorized)
+	})
+
+	resp, err := k.Execute(ctx, addr, []byte("alice"), &v1.MsgCreateProposal{
+		Proposal: &v1.Proposal{Title: "send and update", Messages: []*anypb.Any{newAny(t, send), newAny(t, update)}},
+	})
+	require.NoError(t, err)
+	proposalID := resp.(*v1.MsgCreateProposalResponse).ProposalId
+
+	_, err = k.Execute(ctx, addr, []byte("bob"), &v1.MsgVote{ProposalId: proposalID, Option: v1.VoteOption_VOTE_OPTION_YES})
+	require.NoError(t, err)
+
+	t.Run("vote - revote disabled", func(t *testing.T) {
+		_, err := k.Execute(ctx, addr, []byte("bob"), &v1.MsgVote{ProposalId: proposalID, Option: v1.VoteOption_VOTE_OPTION_NO})
+		require.ErrorIs(t, err, ErrInvalidVote)
+	})
+
+	t.Run("execute - voting period not ended", func(t *testing.T) {
+		_, err := k.Execute(ctx, addr, []byte("alice"), &v1.MsgExecuteProposal{ProposalId: proposalID})
+		require.ErrorIs(t, err, ErrProposal)
+	})
+
+	now = now.Add(100 * time.Second)
+
+	t.Run("vote - voting period ended", func(t *testing.T) {
+		_, err := k.Execute(ctx, addr, []byte("alice"), &v1.MsgVote{ProposalId: proposalID, Option: v1.VoteOption_VOTE_OPTION_YES})
+		require.ErrorIs(t, err, ErrInvalidVote)
+	})
+
+	resp, err = k.Execute(ctx, addr, []byte("anyone"), &v1.MsgExecuteProposal{ProposalId: proposalID})
+	require.NoError(t, err)
+	require.Len(t, resp.(*v1.MsgExecuteProposalResponse).Responses, 2)
+	require.Len(t, executed, 1)
+	require.True(t, proto.Equal(send, executed[0]))
+
+	resp, err = k.Query(ctx, addr, &v1.QueryProposal{ProposalId: proposalID})
+	require.NoError(t, err)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_PASSED, resp.(*v1.QueryProposalResponse).Proposal.Status)
+
+	resp, err = k.Query(ctx, addr, &v1.QueryConfig{})
+	require.NoError(t, err)
+	require.Equal(t, []*v1.Member{
+		{Address: "alice", Weight: 1},
+		{Address: "bob", Weight: 2},
+		{Address: "dave", Weight: 1},
+	}, resp.(*v1.QueryConfigResponse).Members)
+
+	t.Run("execute - already executed", func(t *testing.T) {
+		_, err := k.Execute(ctx, addr, []byte("alice"), &v1.MsgExecuteProposal{ProposalId: proposalID})
+		require.ErrorIs(t, err, ErrProposal)
+	})
+
+	t.Run("rejected proposal", func(t *testing.T) {
+		resp, err := k.Execute(ctx, addr, []byte("dave"), &v1.MsgCreateProposal{
+			Proposal: &v1.Proposal{Messages: []*anypb.Any{newAny(t, send)}},
+		})
+		require.NoError(t, err)
+		id := resp.(*v1.MsgCreateProposalResponse).ProposalId
+
+		_, err = k.Execute(ctx, addr, []byte("bob"), &v1.MsgVote{ProposalId: id, Option: v1.VoteOption_VOTE_OPTION_NO})
+		require.NoError(t, err)
+
+		now = now.Add(100 * time.Second)
+		_, err = k.Execute(ctx, addr, []byte("dave"), &v1.MsgExecuteProposal{ProposalId: id})
+		require.NoError(t, err)
+		require.Len(t, executed, 1)
+
+		resp, err = k.Query(ctx, addr, &v1.QueryProposal{ProposalId: id})
+		require.NoError(t, err)
+		require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_REJECTED, resp.(*v1.QueryProposalResponse).Proposal.Status)
+	})
+}
+
+func TestAccount_EarlyExecution(t *testing.T) {
+	now := time.Unix(1000, 0)
+	k, ctx := newKeeper(t, &now, func(_ context.Context, msg proto.Message) (proto.Message, error) {
+		return &bankv1beta1.MsgSendResponse{}, nil
+	})
+
+	_, addr, err := k.Init(ctx, "multisig", []byte("creator"), &v1.MsgInit{
+		Members: []*v1.Member{{Address: "alice", Weight: 1}, {Address: "bob", Weight: 1}},
+		Config:  &v1.Config{Threshold: 1, VotingPeriod: 100, EarlyExecution: true, Revote: true},
+	})
+	require.NoError(t, err)
+
+	send := &bankv1beta1.MsgSend{FromAddress: string(addr), ToAddress: "recipient"}
+	resp, err := k.Execute(ctx, addr, []byte("alice"), &v1.MsgCreateProposal{
+		Proposal: &v1.Proposal{Messages: []*anypb.Any{newAny(t, send)}},
+	})
+	require.NoError(t, err)
+	proposalID := resp.(*v1.MsgCreateProposalResponse).ProposalId
+
+	// the threshold is not reached yet.
+	_, err = k.Execute(ctx, addr, []byte("alice"), &v1.MsgExecuteProposal{ProposalId: proposalID})
+	require.ErrorIs(t, err, ErrProposal)
+
+	// revote is allowed.
+	_, err = k.Execute(ctx, addr, []byte("alice"), &v1.MsgVote{ProposalId: proposalID, Option: v1.VoteOption_VOTE_OPTION_NO})
+	require.NoError(t, err)
+	_, err = k.Execute(ctx, addr, []byte("alice"), &v1.MsgVote{ProposalId: proposalID, Option: v1.VoteOption_VOTE_OPTION_YES})
+	require.NoError(t, err)
+
+	_, err = k.Execute(ctx, addr, []byte("alice"), &v1.MsgExecuteProposal{ProposalId: proposalID})
+	require.NoError(t, err)
+}
+
+func TestAccount_WeightOverflow(t *testing.T) {
+	now := time.Unix(1000, 0)
+	k, ctx := newKeeper(t, &now, nil)
+	config := &v1.Config{Threshold: 1, Quorum: 1, VotingPeriod: 100}
+
+	_, _, err := k.Init(ctx, "multisig", []byte("creator"), &v1.MsgInit{
+		Members: []*v1.Member{{Address: "alice", Weight: math.MaxUint64}, {Address: "bob", Weight: 1}},
+		Config:  config,
+	})
+	require.ErrorIs(t, err, ErrInvalidMember)
+
+	_, addr, err := k.Init(ctx, "multisig", []byte("creator"), &v1.MsgInit{
+		Members: []*v1.Member{{Address: "alice", Weight: 1}, {Address: "bob", Weight: 1}},
+		Config:  config,
+	})
+	require.NoError(t, err)
+
+	_, err = k.Execute(ctx, addr, addr, &v1.MsgUpdateConfig{
+		UpdateMembers: []*v1.Member{{Address: "alice", Weight: math.MaxUint64}},
+	})
+	require.ErrorIs(t, err, ErrInvalidMember)
+}
+
+func TestAccount_VotingPeriodOverflow(t *testing.T) {
+	now := time.Unix(1000, 0)
+	k, ctx := newKeeper(t, &now, nil)
+
+	_, addr, err := k.Init(ctx, "multisig", []byte("creator"), &v1.MsgInit{
+		Members: []*v1.Member{{Address: "alice", Weight: 1}},
+		Config:  &v1.Config{Threshold: 1, Quorum: 1, VotingPeriod: math.MaxInt64},
+	})
+	require.NoError(t, err)
+
+	_, err = k.Execute(ctx, addr, []byte("alice"), &v1.MsgCreateProposal{
+		Proposal: &v1.Proposal{Messages: []*anypb.Any{newAny(t, &bankv1beta1.MsgSend{})}},
+	})
+	require.ErrorIs(t, err, ErrInvalidConfig)
+}
@@ -0,0 +1,102 @@
+package vesting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+)
+
+// coin is the parsed representation of a basev1beta1.Coin.
+type coin struct {
+	denom  string
+	amount math.Int
+}
+
+// parseCoins parses and validates the given coins, amounts must be positive
+// and denoms must not be repeated.
+func parseCoins(coins []*basev1beta1.Coin) ([]coin, error) {
+	if len(coins) == 0 {
+		return nil, fmt.Errorf("%w: empty coins", ErrInvalidCoins)
+	}
+	parsed := make([]coin, 0, len(coins))
+	seen := make(map[string]struct{}, len(coins))
+	for _, c := range coins {
+		if c == nil || c.Denom == "" {
+			return nil, fmt.Errorf("%w: empty denom", ErrInvalidCoins)
+		}
+		if _, ok := seen[c.Denom]; ok {
+			return nil, fmt.Errorf("%w: duplicate denom %s", ErrInvalidCoins, c.Denom)
+		}
+		seen[c.Denom] = struct{}{}
+		amount, ok := math.NewIntFromString(c.Amount)
+		if !ok || !amount.IsPositive() {
+			return nil, fmt.Errorf("%w: invalid amount %q for denom %s", ErrInvalidCoins, c.Amount, c.Denom)
+		}
+		parsed = append(parsed, coin{denom: c.Denom, amount: amount})
+	}
+	return parsed, nil
+}
+
+// mapToCoins returns the non-zero amounts of the given map as coins, sorted by denom.
+func mapToCoins(ctx context.Context, m collections.Map[string, math.Int]) ([]*basev1beta1.Coin, error) {
+	var coins []*basev1beta1.Coin
+	err := m.Walk(ctx, nil, func(denom string, amount math.Int) (stop bool, err error) {
+		if !amount.IsZero() {
+			coins = append(coins, &basev1beta1.Coin{Denom: denom, Amount: amount.String()})
+		}
+		return false, nil
+	})
+	return coins, err
+}
+
+// getAmount returns the amount of the given denom, or zero if it does not exist.
+func getAmount(ctx context.Context, m collections.Map[string, math.Int], denom string) (math.Int, error) {
+	amount, err := m.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+	return amount, err
+}
+
+// intValue is a collections.ValueCodec for math.Int.
+var intValue intValueCodec
+
+type intValueCodec struct{}
+
+func (i intValueCodec) Encode(value math.Int) ([]byte, error) {
+	return value.Marshal()
+}
+
+func (i intValueCodec) Decode(b []byte) (math.Int, error) {
+	v := new(math.Int)
+	err := v.Unmarshal(b)
+	if err != nil {
+		return math.Int{}, err
+	}
+	return *v, nil
+}
+
+func (i intValueCodec) EncodeJSON(value math.Int) ([]byte, error) {
+	return value.MarshalJSON()
+}
+
+func (i intValueCodec) DecodeJSON(b []byte) (math.Int, error) {
+	v := new(math.Int)
+	err := v.UnmarshalJSON(b)
+	if err != nil {
+		return math.Int{}, err
+	}
+	return *v, nil
+}
+
+func (i intValueCodec) Stringify(value math.Int) string {
+	return value.String()
+}
+
+func (i intValueCodec) ValueType() string {
+	return "math.Int"
+}
@@ -0,0 +1,69 @@
+package vesting
+
+import (
+	"context"
+
+	v1 "cosmossdk.io/api/cosmos/accounts/defaults/vesting/v1"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+)
+
+var _ accountstd.Interface = (*ContinuousVestingAccount)(nil)
+
+// NewContinuousVestingAccount creates a new continuous vesting account, it can be
+// registered in x/accounts using accountstd.AddAccount.
+func NewContinuousVestingAccount(d accountstd.Dependencies) (*ContinuousVestingAccount, error) {
+	return &ContinuousVestingAccount{BaseVesting: newBaseVesting(d)}, nil
+}
+
+// ContinuousVestingAccount vests coins linearly between its start and end time.
+type ContinuousVestingAccount struct {
+	*BaseVesting
+}
+
+func (cva ContinuousVestingAccount) Init(ctx context.Context, msg *v1.MsgInitContinuousVestingAccount) (*v1.MsgInitVestingAccountResponse, error) {
+	startTime := msg.StartTime
+	if startTime == 0 {
+		startTime = cva.now(ctx)
+	}
+	return &v1.MsgInitVestingAccountResponse{}, cva.init(ctx, msg.Owner, msg.OriginalVesting, startTime, msg.EndTime)
+}
+
+// GetVestingCoins returns the amount of the given denom still vesting at the given
+// unix time. The vested amount is truncated, which favours vesting coins.
+func (cva ContinuousVestingAccount) GetVestingCoins(ctx context.Context, denom string, blockTime int64) (math.Int, error) {
+	original, err := getAmount(ctx, cva.OriginalVesting, denom)
+	if err != nil {
+		return math.Int{}, err
+	}
+	startTime, err := cva.StartTime.Get(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+	endTime, err := cva.EndTime.Get(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	switch {
+	case blockTime <= startTime:
+		return original, nil
+	case blockTime >= endTime:
+		return math.ZeroInt(), nil
+	}
+
+	vested := original.MulRaw(blockTime - startTime).QuoRaw(endTime - startTime)
+	return original.Sub(vested), nil
+}
+
+func (cva ContinuousVestingAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, cva.Init)
+}
+
+func (cva ContinuousVestingAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	cva.registerExecuteHandlers(builder, cva.GetVestingCoins)
+}
+
+func (cva ContinuousVestingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	cva.registerQueryHandlers(builder, cva.GetVestingCoins)
+}
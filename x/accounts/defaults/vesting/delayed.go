@@ -0,0 +1,50 @@
+package vesting
+
+import (
+	"context"
+
+	v1 "cosmossdk.io/api/cosmos/accounts/defaults/vesting/v1"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+)
+
+var _ accountstd.Interface = (*DelayedVestingAccount)(nil)
+
+// NewDelayedVestingAccount creates a new delayed vesting account, it can be
+// registered in x/accounts using accountstd.AddAccount.
+func NewDelayedVestingAccount(d accountstd.Dependencies) (*DelayedVestingAccount, error) {
+	return &DelayedVestingAccount{BaseVesting: newBaseVesting(d)}, nil
+}
+
+// DelayedVestingAccount vests all of its coins at its end time.
+type DelayedVestingAccount struct {
+	*BaseVesting
+}
+
+func (dva DelayedVestingAccount) Init(ctx context.Context, msg *v1.MsgInitDelayedVestingAccount) (*v1.MsgInitVestingAccountResponse, error) {
+	return &v1.MsgInitVestingAccountResponse{}, dva.init(ctx, msg.Owner, msg.OriginalVesting, dva.now(ctx), msg.EndTime)
+}
+
+// GetVestingCoins returns the amount of the given denom still vesting at the given unix time.
+func (dva DelayedVestingAccount) GetVestingCoins(ctx context.Context, denom string, blockTime int64) (math.Int, error) {
+	endTime, err := dva.EndTime.Get(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+	if blockTime >= endTime {
+		return math.ZeroInt(), nil
+	}
+	return getAmount(ctx, dva.OriginalVesting, denom)
+}
+
+func (dva DelayedVestingAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, dva.Init)
+}
+
+func (dva DelayedVestingAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	dva.registerExecuteHandlers(builder, dva.GetVestingCoins)
+}
+
+func (dva DelayedVestingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	dva.registerQueryHandlers(builder, dva.GetVestingCoins)
+}
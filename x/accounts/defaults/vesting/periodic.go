@@ -0,0 +1,122 @@
+package vesting
+
+import (
+	"context"
+	"fmt"
+	stdmath "math"
+
+	v1 "cosmossdk.io/api/cosmos/accounts/defaults/vesting/v1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+)
+
+var _ accountstd.Interface = (*PeriodicVestingAccount)(nil)
+
+// NewPeriodicVestingAccount creates a new periodic vesting account, it can be
+// registered in x/accounts using accountstd.AddAccount.
+func NewPeriodicVestingAccount(d accountstd.Dependencies) (*PeriodicVestingAccount, error) {
+	return &PeriodicVestingAccount{
+		BaseVesting:    newBaseVesting(d),
+		VestingPeriods: collections.NewMap(d.SchemaBuilder, VestingPeriodsPrefix, "vesting_periods", collections.Uint64Key, accountstd.CollValue[v1.Period]()),
+	}, nil
+}
+
+// PeriodicVestingAccount vests coins at the end of each of its vesting periods.
+type PeriodicVestingAccount struct {
+	*BaseVesting
+	// VestingPeriods maps the index of each period to the period.
+	VestingPeriods collections.Map[uint64, *v1.Period]
+}
+
+func (pva PeriodicVestingAccount) Init(ctx context.Context, msg *v1.MsgInitPeriodicVestingAccount) (*v1.MsgInitVestingAccountResponse, error) {
+	if len(msg.VestingPeriods) == 0 {
+		return nil, fmt.Errorf("%w: no vesting periods provided", ErrInvalidSchedule)
+	}
+	startTime := msg.StartTime
+	if startTime == 0 {
+		startTime = pva.now(ctx)
+	}
+
+	endTime := startTime
+	totals := make(map[string]math.Int)
+	var denoms []string
+	for i, period := range msg.VestingPeriods {
+		if period.Length <= 0 {
+			return nil, fmt.Errorf("%w: period %d length must be positive", ErrInvalidSchedule, i)
+		}
+		amounts, err := parseCoins(period.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("period %d: %w", i, err)
+		}
+		for _, coin := range amounts {
+			total, ok := totals[coin.denom]
+			if !ok {
+				total = math.ZeroInt()
+				denoms = append(denoms, coin.denom)
+			}
+			totals[coin.denom] = total.Add(coin.amount)
+		}
+		if endTime > stdmath.MaxInt64-period.Length {
+			return nil, fmt.Errorf("%w: the end of period %d overflows", ErrInvalidSchedule, i)
+		}
+		endTime += period.Length
+		if err := pva.VestingPeriods.Set(ctx, uint64(i), period); err != nil {
+			return nil, err
+		}
+	}
+
+	originalVesting := make([]*basev1beta1.Coin, 0, len(denoms))
+	for _, denom := range denoms {
+		originalVesting = append(originalVesting, &basev1beta1.Coin{Denom: denom, Amount: totals[denom].String()})
+	}
+	return &v1.MsgInitVestingAccountResponse{}, pva.init(ctx, msg.Owner, originalVesting, startTime, endTime)
+}
+
+// GetVestingCoins returns the amount of the given denom still vesting at the given unix time,
+// which is the original vesting amount minus the amounts of the elapsed periods.
+func (pva PeriodicVestingAccount) GetVestingCoins(ctx context.Context, denom string, blockTime int64) (math.Int, error) {
+	vesting, err := getAmount(ctx, pva.OriginalVesting, denom)
+	if err != nil {
+		return math.Int{}, err
+	}
+	periodEnd, err := pva.StartTime.Get(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	err = pva.VestingPeriods.Walk(ctx, nil, func(_ uint64, period *v1.Period) (stop bool, err error) {
+		periodEnd += period.Length
+		if blockTime < periodEnd {
+			return true, nil
+		}
+		for _, c := range period.Amount {
+			if c.Denom != denom {
+				continue
+			}
+			amount, ok := math.NewIntFromString(c.Amount)
+			if !ok {
+				return true, fmt.Errorf("%w: invalid amount %q", ErrInvalidCoins, c.Amount)
+			}
+			vesting = vesting.Sub(amount)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return math.Int{}, err
+	}
+	return vesting, nil
+}
+
+func (pva PeriodicVestingAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, pva.Init)
+}
+
+func (pva PeriodicVestingAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	pva.registerExecuteHandlers(builder, pva.GetVestingCoins)
+}
+
+func (pva PeriodicVestingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	pva.registerQueryHandlers(builder, pva.GetVestingCoins)
+}
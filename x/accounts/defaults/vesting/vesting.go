@@ -0,0 +1,383 @@
+package vesting
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "cosmossdk.io/api/cosmos/accounts/defaults/vesting/v1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+)
+
+var (
+	OwnerPrefix            = collections.NewPrefix(0)
+	OriginalVestingPrefix  = collections.NewPrefix(1)
+	DelegatedFreePrefix    = collections.NewPrefix(2)
+	DelegatedVestingPrefix = collections.NewPrefix(3)
+	StartTimePrefix        = collections.NewPrefix(4)
+	EndTimePrefix          = collections.NewPrefix(5)
+	VestingPeriodsPrefix   = collections.NewPrefix(6)
+)
+
+var (
+	ErrUnauthorized      = errors.New("unauthorized")
+	ErrInvalidCoins      = errors.New("invalid coins")
+	ErrInvalidSchedule   = errors.New("invalid vesting schedule")
+	ErrInsufficientFunds = errors.New("insufficient spendable funds")
+)
+
+// getVestingFunc returns the amount of the given denom which is still vesting at the given unix time.
+type getVestingFunc = func(ctx context.Context, denom string, blockTime int64) (math.Int, error)
+
+func newBaseVesting(d accountstd.Dependencies) *BaseVesting {
+	return &BaseVesting{
+		Owner:            collections.NewItem(d.SchemaBuilder, OwnerPrefix, "owner", collections.BytesValue),
+		OriginalVesting:  collections.NewMap(d.SchemaBuilder, OriginalVestingPrefix, "original_vesting", collections.StringKey, intValue),
+		DelegatedFree:    collections.NewMap(d.SchemaBuilder, DelegatedFreePrefix, "delegated_free", collections.StringKey, intValue),
+		DelegatedVesting: collections.NewMap(d.SchemaBuilder, DelegatedVestingPrefix, "delegated_vesting", collections.StringKey, intValue),
+		StartTime:        collections.NewItem(d.SchemaBuilder, StartTimePrefix, "start_time", collections.Int64Value),
+		EndTime:          collections.NewItem(d.SchemaBuilder, EndTimePrefix, "end_time", collections.Int64Value),
+		addrCodec:        d.AddressCodec,
+		headerService:    d.HeaderService,
+	}
+}
+
+// BaseVesting implements the state and the logic shared by all the vesting accounts.
+// Vesting accounts lock the OriginalVesting amount out of their balance, the locked
+// amount decreases over time following the schedule of the concrete vesting account.
+// Coins sent to the account outside the original vesting amount are always spendable.
+type BaseVesting struct {
+	// Owner is the address allowed to operate the account.
+	Owner collections.Item[[]byte]
+	// OriginalVesting maps each denom to the amount locked at account creation.
+	OriginalVesting collections.Map[string, math.Int]
+	// DelegatedFree maps each denom to the amount of vested coins that are delegated.
+	DelegatedFree collections.Map[string, math.Int]
+	// DelegatedVesting maps each denom to the amount of vesting coins that are delegated.
+	DelegatedVesting collections.Map[string, math.Int]
+	// StartTime is the unix time, in seconds, at which coins start vesting.
+	StartTime collections.Item[int64]
+	// EndTime is the unix time, in seconds, at which all coins are vested.
+	EndTime collections.Item[int64]
+
+	addrCodec     address.Codec
+	headerService header.Service
+}
+
+func (bv *BaseVesting) init(ctx context.Context, owner string, originalVesting []*basev1beta1.Coin, startTime, endTime int64) error {
+	ownerAddr, err := bv.addrCodec.StringToBytes(owner)
+	if err != nil {
+		return err
+	}
+	if startTime < 0 || endTime <= startTime {
+		return fmt.Errorf("%w: end time %d must be after start time %d", ErrInvalidSchedule, endTime, startTime)
+	}
+	amounts, err := parseCoins(originalVesting)
+	if err != nil {
+		return err
+	}
+	for _, coin := range amounts {
+		if err := bv.OriginalVesting.Set(ctx, coin.denom, coin.amount); err != nil {
+			return err
+		}
+	}
+	if err := bv.Owner.Set(ctx, ownerAddr); err != nil {
+		return err
+	}
+	if err := bv.StartTime.Set(ctx, startTime); err != nil {
+		return err
+	}
+	return bv.EndTime.Set(ctx, endTime)
+}
+
+// Send sends spendable coins from the vesting account, only the owner can send coins.
+func (bv *BaseVesting) Send(ctx context.Context, msg *v1.MsgSend, getVesting getVestingFunc) (*v1.MsgSendResponse, error) {
+	if err := bv.assertOwner(ctx); err != nil {
+		return nil, err
+	}
+	amounts, err := parseCoins(msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	whoami, err := bv.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	blockTime := bv.now(ctx)
+	for _, coin := range amounts {
+		spendable, err := bv.spendable(ctx, whoami, coin.denom, blockTime, getVesting)
+		if err != nil {
+			return nil, err
+		}
+		if spendable.LT(coin.amount) {
+			return nil, fmt.Errorf("%w: %s%s is smaller than %s%s", ErrInsufficientFunds, spendable, coin.denom, coin.amount, coin.denom)
+		}
+	}
+
+	_, err = accountstd.ExecModule[bankv1beta1.MsgSendResponse](ctx, &bankv1beta1.MsgSend{
+		FromAddress: whoami,
+		ToAddress:   msg.ToAddress,
+		Amount:      msg.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MsgSendResponse{}, nil
+}
+
+// Delegate delegates coins from the vesting account, vesting coins can be delegated.
+// Only the owner can delegate.
+func (bv *BaseVesting) Delegate(ctx context.Context, msg *v1.MsgDelegate, getVesting getVestingFunc) (*v1.MsgDelegateResponse, error) {
+	if err := bv.assertOwner(ctx); err != nil {
+		return nil, err
+	}
+	amounts, err := parseCoins([]*basev1beta1.Coin{msg.Amount})
+	if err != nil {
+		return nil, err
+	}
+	coin := amounts[0]
+	whoami, err := bv.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := bv.balance(ctx, whoami, coin.denom)
+	if err != nil {
+		return nil, err
+	}
+	if balance.LT(coin.amount) {
+		return nil, fmt.Errorf("%w: balance %s%s is smaller than %s%s", ErrInsufficientFunds, balance, coin.denom, coin.amount, coin.denom)
+	}
+	vesting, err := getVesting(ctx, coin.denom, bv.now(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := bv.trackDelegation(ctx, coin.denom, vesting, coin.amount); err != nil {
+		return nil, err
+	}
+
+	_, err = accountstd.ExecModule[stakingv1beta1.MsgDelegateResponse](ctx, &stakingv1beta1.MsgDelegate{
+		DelegatorAddress: whoami,
+		ValidatorAddress: msg.ValidatorAddress,
+		Amount:           msg.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MsgDelegateResponse{}, nil
+}
+
+// Undelegate undelegates coins of the vesting account. Only the owner can undelegate.
+// NOTE: the undelegation is tracked immediately, while the coins are only returned to
+// the account balance once the unbonding period is over. In the meantime, the amount
+// of locked coins can only be overestimated, never underestimated.
+func (bv *BaseVesting) Undelegate(ctx context.Context, msg *v1.MsgUndelegate) (*v1.MsgUndelegateResponse, error) {
+	if err := bv.assertOwner(ctx); err != nil {
+		return nil, err
+	}
+	amounts, err := parseCoins([]*basev1beta1.Coin{msg.Amount})
+	if err != nil {
+		return nil, err
+	}
+	coin := amounts[0]
+	whoami, err := bv.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = accountstd.ExecModule[stakingv1beta1.MsgUndelegateResponse](ctx, &stakingv1beta1.MsgUndelegate{
+		DelegatorAddress: whoami,
+		ValidatorAddress: msg.ValidatorAddress,
+		Amount:           msg.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := bv.trackUndelegation(ctx, coin.denom, coin.amount); err != nil {
+		return nil, err
+	}
+	return &v1.MsgUndelegateResponse{}, nil
+}
+
+// QueryVestingAccountInfo returns the vesting account information at the current block time.
+func (bv *BaseVesting) QueryVestingAccountInfo(ctx context.Context, getVesting getVestingFunc) (*v1.QueryVestingAccountInfoResponse, error) {
+	owner, err := bv.Owner.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ownerStr, err := bv.addrCodec.BytesToString(owner)
+	if err != nil {
+		return nil, err
+	}
+	startTime, err := bv.StartTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	endTime, err := bv.EndTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	originalVesting, err := mapToCoins(ctx, bv.OriginalVesting)
+	if err != nil {
+		return nil, err
+	}
+	delegatedFree, err := mapToCoins(ctx, bv.DelegatedFree)
+	if err != nil {
+		return nil, err
+	}
+	delegatedVesting, err := mapToCoins(ctx, bv.DelegatedVesting)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTime := bv.now(ctx)
+	var vestingCoins []*basev1beta1.Coin
+	for _, coin := range originalVesting {
+		vesting, err := getVesting(ctx, coin.Denom, blockTime)
+		if err != nil {
+			return nil, err
+		}
+		if vesting.IsPositive() {
+			vestingCoins = append(vestingCoins, &basev1beta1.Coin{Denom: coin.Denom, Amount: vesting.String()})
+		}
+	}
+
+	return &v1.QueryVestingAccountInfoResponse{
+		Owner:            ownerStr,
+		OriginalVesting:  originalVesting,
+		DelegatedFree:    delegatedFree,
+		DelegatedVesting: delegatedVesting,
+		StartTime:        startTime,
+		EndTime:          endTime,
+		VestingCoins:     vestingCoins,
+	}, nil
+}
+
+// spendable returns the amount of the given denom that the account can send, which is
+// its balance minus the vesting coins that are not delegated.
+func (bv *BaseVesting) spendable(ctx context.Context, whoami, denom string, blockTime int64, getVesting getVestingFunc) (math.Int, error) {
+	balance, err := bv.balance(ctx, whoami, denom)
+	if err != nil {
+		return math.Int{}, err
+	}
+	vesting, err := getVesting(ctx, denom, blockTime)
+	if err != nil {
+		return math.Int{}, err
+	}
+	delegatedVesting, err := getAmount(ctx, bv.DelegatedVesting, denom)
+	if err != nil {
+		return math.Int{}, err
+	}
+	locked := math.MaxInt(vesting.Sub(delegatedVesting), math.ZeroInt())
+	spendable := balance.Sub(locked)
+	if spendable.IsNegative() {
+		return math.ZeroInt(), nil
+	}
+	return spendable, nil
+}
+
+// trackDelegation tracks a delegation amount, splitting it between vesting and free coins.
+// It mirrors the logic of x/auth/vesting.
+func (bv *BaseVesting) trackDelegation(ctx context.Context, denom string, vesting, amount math.Int) error {
+	delegatedVesting, err := getAmount(ctx, bv.DelegatedVesting, denom)
+	if err != nil {
+		return err
+	}
+	delegatedFree, err := getAmount(ctx, bv.DelegatedFree, denom)
+	if err != nil {
+		return err
+	}
+
+	// compute x and y per the specification, where:
+	// X := min(max(V - DV, 0), D)
+	// Y := D - X
+	x := math.MinInt(math.MaxInt(vesting.Sub(delegatedVesting), math.ZeroInt()), amount)
+	y := amount.Sub(x)
+
+	if err := bv.DelegatedVesting.Set(ctx, denom, delegatedVesting.Add(x)); err != nil {
+		return err
+	}
+	return bv.DelegatedFree.Set(ctx, denom, delegatedFree.Add(y))
+}
+
+// trackUndelegation tracks an undelegation amount, free coins are undelegated first.
+// It mirrors the logic of x/auth/vesting.
+func (bv *BaseVesting) trackUndelegation(ctx context.Context, denom string, amount math.Int) error {
+	delegatedVesting, err := getAmount(ctx, bv.DelegatedVesting, denom)
+	if err != nil {
+		return err
+	}
+	delegatedFree, err := getAmount(ctx, bv.DelegatedFree, denom)
+	if err != nil {
+		return err
+	}
+
+	// compute x and y per the specification, where:
+	// X := min(DF, D)
+	// Y := min(DV, D - X)
+	x := math.MinInt(delegatedFree, amount)
+	y := math.MinInt(delegatedVesting, amount.Sub(x))
+
+	if err := bv.DelegatedFree.Set(ctx, denom, delegatedFree.Sub(x)); err != nil {
+		return err
+	}
+	return bv.DelegatedVesting.Set(ctx, denom, delegatedVesting.Sub(y))
+}
+
+func (bv *BaseVesting) balance(ctx context.Context, whoami, denom string) (math.Int, error) {
+	resp, err := accountstd.QueryModule[bankv1beta1.QueryBalanceResponse](ctx, &bankv1beta1.QueryBalanceRequest{
+		Address: whoami,
+		Denom:   denom,
+	})
+	if err != nil {
+		return math.Int{}, err
+	}
+	if resp.Balance == nil {
+		return math.ZeroInt(), nil
+	}
+	amount, ok := math.NewIntFromString(resp.Balance.Amount)
+	if !ok {
+		return math.Int{}, fmt.Errorf("%w: invalid balance amount %s", ErrInvalidCoins, resp.Balance.Amount)
+	}
+	return amount, nil
+}
+
+func (bv *BaseVesting) assertOwner(ctx context.Context) error {
+	owner, err := bv.Owner.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(owner, accountstd.Sender(ctx)) {
+		return fmt.Errorf("%w: sender is not the owner", ErrUnauthorized)
+	}
+	return nil
+}
+
+func (bv *BaseVesting) now(ctx context.Context) int64 {
+	return bv.headerService.GetHeaderInfo(ctx).Time.Unix()
+}
+
+func (bv *BaseVesting) registerExecuteHandlers(builder *accountstd.ExecuteBuilder, getVesting getVestingFunc) {
+	accountstd.RegisterExecuteHandler(builder, func(ctx context.Context, msg *v1.MsgSend) (*v1.MsgSendResponse, error) {
+		return bv.Send(ctx, msg, getVesting)
+	})
+	accountstd.RegisterExecuteHandler(builder, func(ctx context.Context, msg *v1.MsgDelegate) (*v1.MsgDelegateResponse, error) {
+		return bv.Delegate(ctx, msg, getVesting)
+	})
+	accountstd.RegisterExecuteHandler(builder, bv.Undelegate)
+}
+
+func (bv *BaseVesting) registerQueryHandlers(builder *accountstd.QueryBuilder, getVesting getVestingFunc) {
+	accountstd.RegisterQueryHandler(builder, func(ctx context.Context, _ *v1.QueryVestingAccountInfo) (*v1.QueryVestingAccountInfoResponse, error) {
+		return bv.QueryVestingAccountInfo(ctx, getVesting)
+	})
+}
@@ -0,0 +1,211 @@
+package vesting
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	v1 "cosmossdk.io/api/cosmos/accounts/defaults/vesting/v1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts"
+	"cosmossdk.io/x/accounts/accountstd"
+)
+
+type addressCodec struct{}
+
+func (addressCodec) StringToBytes(text string) ([]byte, error) { return []byte(text), nil }
+func (addressCodec) BytesToString(bz []byte) (string, error)   { return string(bz), nil }
+
+type headerService struct{ now *time.Time }
+
+func (h headerService) GetHeaderInfo(_ context.Context) header.Info { return header.Info{Time: *h.now} }
+
+func getSender(msg proto.Message) ([]byte, error) {
+	switch msg := msg.(type) {
+	case *bankv1beta1.MsgSend:
+		return []byte(msg.FromAddress), nil
+	case *stakingv1beta1.MsgDelegate:
+		return []byte(msg.DelegatorAddress), nil
+	case *stakingv1beta1.MsgUndelegate:
+		return []byte(msg.DelegatorAddress), nil
+	default:
+		panic("unexpected message")
+	}
+}
+
+func newKeeper(t *testing.T, now *time.Time, balance *int64, executed *[]proto.Message) (accounts.Keeper, context.Context) {
+	t.Helper()
+	ss, ctx := colltest.MockStore()
+	execModule := func(_ context.Context, msg proto.Message) (proto.Message, error) {
+		*executed = append(*executed, msg)
+		switch msg.(type) {
+		case *bankv1beta1.MsgSend:
+			return &bankv1beta1.MsgSendResponse{}, nil
+		case *stakingv1beta1.MsgDelegate:
+			return &stakingv1beta1.MsgDelegateResponse{}, nil
+		default:
+			return &stakingv1beta1.MsgUndelegateResponse{}, nil
+		}
+	}
+	queryModule := func(_ context.Context, msg proto.Message) (proto.Message, error) {
+		req := msg.(*bankv1beta1.QueryBalanceRequest)
+		return &bankv1beta1.QueryBalanceResponse{Balance: &basev1beta1.Coin{Denom: req.Denom, Amount: strconv.FormatInt(*balance, 10)}}, nil
+	}
+	k, err := accounts.NewKeeper(ss, headerService{now}, addressCodec{}, getSender, execModule, queryModule,
+		accountstd.AddAccount("continuous", NewContinuousVestingAccount),
+		accountstd.AddAccount("delayed", NewDelayedVestingAccount),
+		accountstd.AddAccount("periodic", NewPeriodicVestingAccount),
+	)
+	require.NoError(t, err)
+	return k, ctx
+}
+
+func coins(amount string) []*basev1beta1.Coin {
+	return []*basev1beta1.Coin{{Denom: "stake", Amount: amount}}
+}
+
+func queryVesting(t *testing.T, k accounts.Keeper, ctx context.Context, addr []byte) *v1.QueryVestingAccountInfoResponse {
+	t.Helper()
+	resp, err := k.Query(ctx, addr, &v1.QueryVestingAccountInfo{})
+	require.NoError(t, err)
+	return resp.(*v1.QueryVestingAccountInfoResponse)
+}
+
+func TestContinuousVestingAccount(t *testing.T) {
+	now := time.Unix(1500, 0)
+	balance := int64(100)
+	var executed []proto.Message
+	k, ctx := newKeeper(t, &now, &balance, &executed)
+
+	t.Run("init - invalid schedule", func(t *testing.T) {
+		_, _, err := k.Init(ctx, "continuous", []byte("creator"), &v1.MsgInitContinuousVestingAccount{
+			Owner: "owner", OriginalVesting: coins("100"), StartTime: 2000, EndTime: 1000,
+		})
+		require.ErrorIs(t, err, ErrInvalidSchedule)
+	})
+
+	t.Run("init - invalid coins", func(t *testing.T) {
+		_, _, err := k.Init(ctx, "continuous", []byte("creator"), &v1.MsgInitContinuousVestingAccount{
+			Owner: "owner", OriginalVesting: coins("-1"), StartTime: 1000, EndTime: 2000,
+		})
+		require.ErrorIs(t, err, ErrInvalidCoins)
+	})
+
+	_, addr, err := k.Init(ctx, "continuous", []byte("creator"), &v1.MsgInitContinuousVestingAccount{
+		Owner: "owner", OriginalVesting: coins("100"), StartTime: 1000, EndTime: 2000,
+	})
+	require.NoError(t, err)
+
+	info := queryVesting(t, k, ctx, addr)
+	require.Equal(t, "owner", info.Owner)
+	require.Equal(t, "50", info.VestingCoins[0].Amount)
+
+	t.Run("send - not the owner", func(t *testing.T) {
+		_, err := k.Execute(ctx, addr, []byte("creator"), &v1.MsgSend{ToAddress: "recipient", Amount: coins("10")})
+		require.ErrorIs(t, err, ErrUnauthorized)
+	})
+
+	t.Run("send - locked coins", func(t *testing.T) {
+		_, err := k.Execute(ctx, addr, []byte("owner"), &v1.MsgSend{ToAddress: "recipient", Amount: coins("51")})
+		require.ErrorIs(t, err, ErrInsufficientFunds)
+	})
+
+	_, err = k.Execute(ctx, addr, []byte("owner"), &v1.MsgSend{ToAddress: "recipient", Amount: coins("50")})
+	require.NoError(t, err)
+	require.Len(t, executed, 1)
+	balance = 50
+
+	// delegating vesting coins unlocks the balance they were accounted against.
+	_, err = k.Execute(ctx, addr, []byte("owner"), &v1.MsgDelegate{ValidatorAddress: "val", Amount: coins("40")[0]})
+	require.NoError(t, err)
+	require.Len(t, executed, 2)
+	balance = 10
+
+	info = queryVesting(t, k, ctx, addr)
+	require.Equal(t, coins("40"), info.DelegatedVesting)
+	require.Empty(t, info.DelegatedFree)
+
+	// the account holds 10 coins, 10 vesting coins are not delegated.
+	_, err = k.Execute(ctx, addr, []byte("owner"), &v1.MsgSend{ToAddress: "recipient", Amount: coins("1")})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	// after the end time everything is spendable.
+	now = time.Unix(2000, 0)
+	_, err = k.Execute(ctx, addr, []byte("owner"), &v1.MsgSend{ToAddress: "recipient", Amount: coins("10")})
+	require.NoError(t, err)
+
+	_, err = k.Execute(ctx, addr, []byte("owner"), &v1.MsgUndelegate{ValidatorAddress: "val", Amount: coins("40")[0]})
+	require.NoError(t, err)
+	info = queryVesting(t, k, ctx, addr)
+	require.Empty(t, info.DelegatedVesting)
+	require.Empty(t, info.VestingCoins)
+}
+
+func TestDelayedVestingAccount(t *testing.T) {
+	now := time.Unix(1000, 0)
+	balance := int64(100)
+	var executed []proto.Message
+	k, ctx := newKeeper(t, &now, &balance, &executed)
+
+	_, addr, err := k.Init(ctx, "delayed", []byte("creator"), &v1.MsgInitDelayedVestingAccount{
+		Owner: "owner", OriginalVesting: coins("100"), EndTime: 2000,
+	})
+	require.NoError(t, err)
+
+	now = time.Unix(1999, 0)
+	require.Equal(t, coins("100"), queryVesting(t, k, ctx, addr).VestingCoins)
+	_, err = k.Execute(ctx, addr, []byte("owner"), &v1.MsgSend{ToAddress: "recipient", Amount: coins("1")})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	now = time.Unix(2000, 0)
+	require.Empty(t, queryVesting(t, k, ctx, addr).VestingCoins)
+	_, err = k.Execute(ctx, addr, []byte("owner"), &v1.MsgSend{ToAddress: "recipient", Amount: coins("100")})
+	require.NoError(t, err)
+}
+
+func TestPeriodicVestingAccount(t *testing.T) {
+	now := time.Unix(1000, 0)
+	balance := int64(30)
+	var executed []proto.Message
+	k, ctx := newKeeper(t, &now, &balance, &executed)
+
+	_, addr, err := k.Init(ctx, "periodic", []byte("creator"), &v1.MsgInitPeriodicVestingAccount{
+		Owner:     "owner",
+		StartTime: 1000,
+		VestingPeriods: []*v1.Period{
+			{Length: 100, Amount: coins("10")},
+			{Length: 100, Amount: coins("20")},
+		},
+	})
+	require.NoError(t, err)
+
+	info := queryVesting(t, k, ctx, addr)
+	require.Equal(t, coins("30"), info.OriginalVesting)
+	require.Equal(t, int64(1200), info.EndTime)
+
+	now = time.Unix(1150, 0)
+	require.Equal(t, coins("20"), queryVesting(t, k, ctx, addr).VestingCoins)
+
+	now = time.Unix(1200, 0)
+	require.Empty(t, queryVesting(t, k, ctx, addr).VestingCoins)
+
+	// the end of the vesting periods must not overflow
+	_, _, err = k.Init(ctx, "periodic", []byte("creator"), &v1.MsgInitPeriodicVestingAccount{
+		Owner:     "owner",
+		StartTime: 1000,
+		VestingPeriods: []*v1.Period{
+			{Length: 100, Amount: coins("10")},
+			{Length: math.MaxInt64 - 1000, Amount: coins("20")},
+		},
+	})
+	require.ErrorIs(t, err, ErrInvalidSchedule)
+}
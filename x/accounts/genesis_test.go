@@ -10,18 +10,12 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
-	"cosmossdk.io/collections"
 	"cosmossdk.io/collections/colltest"
-	"cosmossdk.io/x/accounts/internal/implementation"
+	"cosmossdk.io/x/accounts/accountstd"
 )
 
 func TestGenesis(t *testing.T) {
-	sb := collections.NewSchemaBuilderFromAccessor(implementation.OpenKVStore)
-	acc := NewTestAccount(sb)
-
-	k, ctx := newKeeper(t, map[string]implementation.Account{
-		"test": acc,
-	})
+	k, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount))
 	k.queryModuleFunc = func(ctx context.Context, _ proto.Message) (proto.Message, error) {
 		return &bankv1beta1.QueryBalanceResponse{}, nil
 	}
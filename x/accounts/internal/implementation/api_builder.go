@@ -8,12 +8,16 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/anypb"
+
+	authenticationv1 "cosmossdk.io/api/cosmos/accounts/interfaces/authentication/v1"
 )
 
 var (
-	errNoInitHandler    = errors.New("no init handler")
-	errNoExecuteHandler = errors.New("account does not accept messages")
-	errInvalidMessage   = errors.New("invalid message")
+	errNoInitHandler         = errors.New("no init handler")
+	errNoExecuteHandler      = errors.New("account does not accept messages")
+	errNoAuthenticateHandler = errors.New("account does not support authentication")
+	errNoMigrateHandler      = errors.New("account does not support migrations")
+	errInvalidMessage        = errors.New("invalid message")
 )
 
 // NewInitBuilder creates a new InitBuilder instance.
@@ -33,6 +37,9 @@ type InitBuilder struct {
 
 	// encodeResponse is the function that will be used to encode the init response to bytes.
 	encodeResponse func(any) ([]byte, error)
+
+	// schema is the schema of the init handler.
+	schema HandlerSchema
 }
 
 // makeHandler returns the handler function that will be called when the smart account is initialized.
@@ -47,7 +54,8 @@ func (i *InitBuilder) makeHandler() (func(ctx context.Context, initRequest any)
 // NewExecuteBuilder creates a new ExecuteBuilder instance.
 func NewExecuteBuilder() *ExecuteBuilder {
 	return &ExecuteBuilder{
-		handlers: make(map[string]func(ctx context.Context, executeRequest any) (executeResponse any, err error)),
+		handlers:       make(map[string]func(ctx context.Context, executeRequest any) (executeResponse any, err error)),
+		handlersSchema: make(map[string]HandlerSchema),
 	}
 }
 
@@ -56,6 +64,8 @@ func NewExecuteBuilder() *ExecuteBuilder {
 type ExecuteBuilder struct {
 	// handlers is a map of handler functions that will be called when the smart account is executed.
 	handlers map[string]func(ctx context.Context, executeRequest any) (executeResponse any, err error)
+	// handlersSchema is a map of the handlers' schemas, keyed by request message name.
+	handlersSchema map[string]HandlerSchema
 	// err is the error that occurred before building the handler function.
 	err error
 }
@@ -147,3 +157,98 @@ type QueryBuilder struct {
 func (r *QueryBuilder) makeHandler() (func(ctx context.Context, queryRequest any) (queryResponse any, err error), error) {
 	return r.er.makeHandler()
 }
+
+// NewAuthenticateBuilder creates a new AuthenticateBuilder instance.
+func NewAuthenticateBuilder() *AuthenticateBuilder {
+	return &AuthenticateBuilder{}
+}
+
+// AuthenticateBuilder defines a smart account's authentication handler builder.
+// The handler is called by the x/accounts module when the account is a signer of
+// a transaction, it allows the account to define its own authentication logic.
+type AuthenticateBuilder struct {
+	// handler is the handler function that will be called when the smart account
+	// needs to authenticate a transaction.
+	handler func(ctx context.Context, req *authenticationv1.MsgAuthenticate) (*authenticationv1.MsgAuthenticateResponse, error)
+}
+
+// makeHandler returns the handler function that will be called when the smart account
+// needs to authenticate a transaction. If no handler was registered, the returned handler
+// rejects every authentication request.
+func (a *AuthenticateBuilder) makeHandler() func(ctx context.Context, req *authenticationv1.MsgAuthenticate) error {
+	if a.handler == nil {
+		return func(_ context.Context, _ *authenticationv1.MsgAuthenticate) error {
+			return errNoAuthenticateHandler
+		}
+	}
+	return func(ctx context.Context, req *authenticationv1.MsgAuthenticate) error {
+		_, err := a.handler(ctx, req)
+		return err
+	}
+}
+
+// NewMigrateBuilder creates a new MigrateBuilder instance.
+func NewMigrateBuilder() *MigrateBuilder {
+	return &MigrateBuilder{}
+}
+
+// MigrateBuilder defines a smart account's migration handler builder. The handler is
+// called when an account of another type is migrated to this account type.
+type MigrateBuilder struct {
+	// handler is the handler function that will be called when an account is migrated
+	// to the smart account, fromAccountType is the account type the account is migrated from.
+	// Although the function here is defined to take an any, the smart account will work
+	// with a typed version of it.
+	handler func(ctx context.Context, fromAccountType string, migrateRequest any) (migrateResponse any, err error)
+
+	// decodeRequest is the function that will be used to decode the migrate request from bytes.
+	decodeRequest func([]byte) (any, error)
+
+	// encodeResponse is the function that will be used to encode the migrate response to bytes.
+	encodeResponse func(any) ([]byte, error)
+
+	// schema is the schema of the migrate handler, nil if no handler was registered.
+	schema *HandlerSchema
+}
+
+// makeHandler returns the handler function that will be called when an account is migrated
+// to the smart account. If no handler was registered, the returned handler rejects every migration.
+func (m *MigrateBuilder) makeHandler() func(ctx context.Context, fromAccountType string, migrateRequest any) (migrateResponse any, err error) {
+	if m.handler == nil {
+		return func(_ context.Context, _ string, _ any) (any, error) {
+			return nil, errNoMigrateHandler
+		}
+	}
+	return m.handler
+}
+
+// makeRequestDecoder returns the migrate request decoder, if no handler was registered
+// the decoder always fails.
+func (m *MigrateBuilder) makeRequestDecoder() func([]byte) (any, error) {
+	if m.decodeRequest == nil {
+		return func(_ []byte) (any, error) {
+			return nil, errNoMigrateHandler
+		}
+	}
+	return m.decodeRequest
+}
+
+// makeResponseEncoder returns the migrate response encoder, if no handler was registered
+// the encoder always fails.
+func (m *MigrateBuilder) makeResponseEncoder() func(any) ([]byte, error) {
+	if m.encodeResponse == nil {
+		return func(_ any) ([]byte, error) {
+			return nil, errNoMigrateHandler
+		}
+	}
+	return m.encodeResponse
+}
+
+// HandlerSchema defines the schema of a handler, it contains the proto
+// descriptors of its request and response messages.
+type HandlerSchema struct {
+	// RequestDescriptor is the proto descriptor of the request message.
+	RequestDescriptor protoreflect.MessageDescriptor
+	// ResponseDescriptor is the proto descriptor of the response message.
+	ResponseDescriptor protoreflect.MessageDescriptor
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	authenticationv1 "cosmossdk.io/api/cosmos/accounts/interfaces/authentication/v1"
 )
 
 func TestRouterDoubleRegistration(t *testing.T) {
@@ -33,3 +35,11 @@ func TestEmptyQueryExecuteHandler(t *testing.T) {
 	_, err = eh(ctx, &wrapperspb.StringValue{})
 	require.ErrorIs(t, err, errNoExecuteHandler)
 }
+
+func TestEmptyAuthenticateHandler(t *testing.T) {
+	impl, err := NewImplementation(TestAccount{})
+	require.NoError(t, err)
+
+	err = impl.Authenticate(context.Background(), &authenticationv1.MsgAuthenticate{})
+	require.ErrorIs(t, err, errNoAuthenticateHandler)
+}
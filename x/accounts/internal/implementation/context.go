@@ -16,18 +16,26 @@ import (
 var (
 	errUnauthorized    = errors.New("unauthorized")
 	AccountStatePrefix = collections.NewPrefix(255)
+	// MigrationStatePrefix is the prefix under which the state of an account
+	// is moved while the account is being migrated.
+	MigrationStatePrefix = collections.NewPrefix(254)
 )
 
 type contextKey struct{}
 
+// oldStateContextKey is the context key of the store containing the state of
+// an account before its migration.
+type oldStateContextKey struct{}
+
 type contextValue struct {
-	store             store.KVStore                                                       // store is the prefixed store for the account.
-	sender            []byte                                                              // sender is the address of the entity invoking the account action.
-	whoami            []byte                                                              // whoami is the address of the account being invoked.
-	originalContext   context.Context                                                     // originalContext that was used to build the account context.
-	getExpectedSender func(msg proto.Message) ([]byte, error)                             // getExpectedSender is a function that returns the expected sender for a given message.
-	moduleExec        func(ctx context.Context, msg proto.Message) (proto.Message, error) // moduleExec is a function that executes a module message.
-	moduleQuery       func(ctx context.Context, msg proto.Message) (proto.Message, error) // moduleQuery is a function that queries a module.
+	store             store.KVStore                                                                              // store is the prefixed store for the account.
+	sender            []byte                                                                                     // sender is the address of the entity invoking the account action.
+	whoami            []byte                                                                                     // whoami is the address of the account being invoked.
+	originalContext   context.Context                                                                            // originalContext that was used to build the account context.
+	getExpectedSender func(msg proto.Message) ([]byte, error)                                                    // getExpectedSender is a function that returns the expected sender for a given message.
+	moduleExec        func(ctx context.Context, msg proto.Message) (proto.Message, error)                        // moduleExec is a function that executes a module message.
+	moduleQuery       func(ctx context.Context, msg proto.Message) (proto.Message, error)                        // moduleQuery is a function that queries a module.
+	accountExec       func(ctx context.Context, target, sender []byte, msg proto.Message) (proto.Message, error) // accountExec is a function that executes a message on another account.
 }
 
 // MakeAccountContext creates a new account execution context given:
@@ -37,6 +45,7 @@ type contextValue struct {
 // sender: the address of entity invoking the account action.
 // moduleExec: a function that executes a module message.
 // moduleQuery: a function that queries a module.
+// accountExec: a function that executes a message on another account.
 func MakeAccountContext(
 	ctx context.Context,
 	storeSvc store.KVStoreService,
@@ -45,6 +54,7 @@ func MakeAccountContext(
 	getSenderFunc func(msg proto.Message) ([]byte, error),
 	moduleExec func(ctx context.Context, msg proto.Message) (proto.Message, error),
 	moduleQuery func(ctx context.Context, msg proto.Message) (proto.Message, error),
+	accountExec func(ctx context.Context, target, sender []byte, msg proto.Message) (proto.Message, error),
 ) context.Context {
 	return context.WithValue(ctx, contextKey{}, contextValue{
 		store:             prefixstore.New(storeSvc.OpenKVStore(ctx), append(AccountStatePrefix, accountAddr...)),
@@ -54,11 +64,26 @@ func MakeAccountContext(
 		getExpectedSender: getSenderFunc,
 		moduleExec:        moduleExec,
 		moduleQuery:       moduleQuery,
+		accountExec:       accountExec,
 	})
 }
 
 // ExecModule can be used to execute a message towards a module.
 func ExecModule[Resp any, RespProto ProtoMsg[Resp], Req any, ReqProto ProtoMsg[Req]](ctx context.Context, msg ReqProto) (RespProto, error) {
+	resp, err := ExecModuleUntyped(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	concreteResp, ok := resp.(RespProto)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type %T", resp)
+	}
+	return concreteResp, nil
+}
+
+// ExecModuleUntyped can be used to execute a message towards a module, when the response type is unknown.
+func ExecModuleUntyped(ctx context.Context, msg proto.Message) (proto.Message, error) {
 	// get sender
 	v := ctx.Value(contextKey{}).(contextValue)
 	// check sender
@@ -71,7 +96,13 @@ func ExecModule[Resp any, RespProto ProtoMsg[Resp], Req any, ReqProto ProtoMsg[R
 	}
 
 	// execute module, unwrapping the original context.
-	resp, err := v.moduleExec(v.originalContext, msg)
+	return v.moduleExec(v.originalContext, msg)
+}
+
+// ExecAccount can be used by an account to execute a message on another account,
+// the invoking account is the sender of the message.
+func ExecAccount[Resp any, RespProto ProtoMsg[Resp], Req any, ReqProto ProtoMsg[Req]](ctx context.Context, target []byte, msg ReqProto) (RespProto, error) {
+	resp, err := ExecAccountUntyped(ctx, target, msg)
 	if err != nil {
 		return nil, err
 	}
@@ -83,6 +114,15 @@ func ExecModule[Resp any, RespProto ProtoMsg[Resp], Req any, ReqProto ProtoMsg[R
 	return concreteResp, nil
 }
 
+// ExecAccountUntyped can be used by an account to execute a message on another account,
+// when the response type is unknown.
+func ExecAccountUntyped(ctx context.Context, target []byte, msg proto.Message) (proto.Message, error) {
+	v := ctx.Value(contextKey{}).(contextValue)
+	// execute on the target account, unwrapping the original context,
+	// the sender is the account itself.
+	return v.accountExec(v.originalContext, target, v.whoami, msg)
+}
+
 // QueryModule can be used by an account to execute a module query.
 func QueryModule[Resp any, RespProto ProtoMsg[Resp], Req any, ReqProto ProtoMsg[Req]](ctx context.Context, msg ReqProto) (RespProto, error) {
 	// we do not need to check the sender in a query because it is not a state transition.
@@ -99,6 +139,24 @@ func QueryModule[Resp any, RespProto ProtoMsg[Resp], Req any, ReqProto ProtoMsg[
 	return concreteResp, nil
 }
 
+// MakeMigrationContext wraps an account context, created using MakeAccountContext,
+// giving it access to the state of the account before the migration, which is
+// stored under MigrationStatePrefix.
+func MakeMigrationContext(ctx context.Context, storeSvc store.KVStoreService, accountAddr []byte) context.Context {
+	oldStore := prefixstore.New(storeSvc.OpenKVStore(ctx), append(MigrationStatePrefix, accountAddr...))
+	return context.WithValue(ctx, oldStateContextKey{}, oldStore)
+}
+
+// OpenOldKVStore returns the prefixed store containing the state of the account before
+// the migration, it can only be used within a migration handler.
+func OpenOldKVStore(ctx context.Context) store.KVStore {
+	oldStore, ok := ctx.Value(oldStateContextKey{}).(store.KVStore)
+	if !ok {
+		panic("old account state can only be accessed during a migration")
+	}
+	return oldStore
+}
+
 // OpenKVStore returns the prefixed store for the account given the context.
 func OpenKVStore(ctx context.Context) store.KVStore {
 	return ctx.Value(contextKey{}).(contextValue).store
@@ -18,7 +18,7 @@ func TestMakeAccountContext(t *testing.T) {
 	sender := []byte("sender")
 	sb := collections.NewSchemaBuilderFromAccessor(OpenKVStore)
 
-	accountCtx := MakeAccountContext(originalContext, storeService, accountAddr, sender, nil, nil, nil)
+	accountCtx := MakeAccountContext(originalContext, storeService, accountAddr, sender, nil, nil, nil, nil)
 
 	// ensure whoami
 	require.Equal(t, accountAddr, Whoami(accountCtx))
@@ -46,7 +46,7 @@ func TestMakeAccountContext(t *testing.T) {
 	// ensure getSenderAccount blocks impersonation
 	accountCtx = MakeAccountContext(originalContext, storeService, []byte("impersonator"), []byte("account-invoker"), func(_ proto.Message) ([]byte, error) {
 		return []byte("legit-exec-module"), nil
-	}, nil, nil)
+	}, nil, nil, nil)
 
 	_, err = ExecModule[wrapperspb.StringValue](accountCtx, &wrapperspb.UInt64Value{Value: 1000})
 	require.ErrorIs(t, err, errUnauthorized)
@@ -58,7 +58,7 @@ func TestMakeAccountContext(t *testing.T) {
 		// ensure we unwrapped the context when invoking a module call
 		require.Equal(t, originalContext, ctx)
 		return wrapperspb.String("module exec was called"), nil
-	}, nil)
+	}, nil, nil)
 
 	resp, err := ExecModule[wrapperspb.StringValue](accountCtx, &wrapperspb.UInt64Value{Value: 1000})
 	require.NoError(t, err)
@@ -69,9 +69,22 @@ func TestMakeAccountContext(t *testing.T) {
 	accountCtx = MakeAccountContext(originalContext, storeService, nil, nil, nil, nil, func(ctx context.Context, msg proto.Message) (proto.Message, error) {
 		require.Equal(t, originalContext, ctx)
 		return wrapperspb.String("module query was called"), nil
-	})
+	}, nil)
 
 	resp, err = QueryModule[wrapperspb.StringValue](accountCtx, &wrapperspb.UInt64Value{Value: 1000})
 	require.NoError(t, err)
 	require.True(t, proto.Equal(wrapperspb.String("module query was called"), resp))
+
+	// ensure calling ExecAccount works, the sender must be the invoking account.
+	accountCtx = MakeAccountContext(originalContext, storeService, []byte("invoker-account"), []byte("invoker"), nil, nil, nil,
+		func(ctx context.Context, target, sender []byte, msg proto.Message) (proto.Message, error) {
+			require.Equal(t, originalContext, ctx)
+			require.Equal(t, []byte("target-account"), target)
+			require.Equal(t, []byte("invoker-account"), sender)
+			return wrapperspb.String("account exec was called"), nil
+		})
+
+	resp, err = ExecAccount[wrapperspb.StringValue](accountCtx, []byte("target-account"), &wrapperspb.UInt64Value{Value: 1000})
+	require.NoError(t, err)
+	require.True(t, proto.Equal(wrapperspb.String("account exec was called"), resp))
 }
@@ -1,6 +1,65 @@
 package implementation
 
-import "context"
+import (
+	"context"
+	"fmt"
+
+	authenticationv1 "cosmossdk.io/api/cosmos/accounts/interfaces/authentication/v1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+)
+
+// Dependencies are passed to the constructor of a smart account.
+type Dependencies struct {
+	// SchemaBuilder is the builder used to create the account's state collections,
+	// it is already scoped to the account's storage.
+	SchemaBuilder *collections.SchemaBuilder
+	// AddressCodec is the address codec of the chain.
+	AddressCodec address.Codec
+	// HeaderService gives the account access to the block header information.
+	HeaderService header.Service
+}
+
+// AccountCreatorFunc is a function that creates an account given its dependencies,
+// it returns the name which will be used to register the account type.
+type AccountCreatorFunc = func(deps Dependencies) (string, Account, error)
+
+// MakeAccountsMap creates a map of account type names to their Implementation
+// given a list of AccountCreatorFunc.
+func MakeAccountsMap(
+	addressCodec address.Codec,
+	headerService header.Service,
+	accounts []AccountCreatorFunc,
+) (map[string]Implementation, error) {
+	accountsMap := make(map[string]Implementation, len(accounts))
+	for _, makeAccount := range accounts {
+		stateSchemaBuilder := collections.NewSchemaBuilderFromAccessor(OpenKVStore)
+		deps := Dependencies{
+			SchemaBuilder: stateSchemaBuilder,
+			AddressCodec:  addressCodec,
+			HeaderService: headerService,
+		}
+		name, accountInterface, err := makeAccount(deps)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create account %s: %w", name, err)
+		}
+		if _, ok := accountsMap[name]; ok {
+			return nil, fmt.Errorf("account with name %s is already registered", name)
+		}
+		impl, err := NewImplementation(accountInterface)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create implementation for account %s: %w", name, err)
+		}
+		// ensure the account's state schema is valid.
+		impl.CollectionsSchema, err = stateSchemaBuilder.Build()
+		if err != nil {
+			return nil, fmt.Errorf("failed to build schema for account %s: %w", name, err)
+		}
+		accountsMap[name] = impl
+	}
+	return accountsMap, nil
+}
 
 // NewImplementation creates a new Implementation instance given an Account implementer.
 func NewImplementation(account Account) (Implementation, error) {
@@ -27,16 +86,40 @@ func NewImplementation(account Account) (Implementation, error) {
 	if err != nil {
 		return Implementation{}, err
 	}
+
+	// make authenticate handler, accounts which do not implement
+	// Authenticator cannot authenticate transactions.
+	ar := NewAuthenticateBuilder()
+	authenticator, authenticates := account.(Authenticator)
+	if authenticates {
+		authenticator.RegisterAuthenticateHandler(ar)
+	}
+
+	// make migrate handler, accounts which do not implement
+	// Migrator do not accept migrations.
+	mr := NewMigrateBuilder()
+	if migrator, ok := account.(Migrator); ok {
+		migrator.RegisterMigrateHandler(mr)
+	}
 	return Implementation{
 		Init:                  initHandler,
 		Execute:               executeHandler,
 		Query:                 queryHandler,
+		Authenticate:          ar.makeHandler(),
+		Migrate:               mr.makeHandler(),
+		InitHandlerSchema:     ir.schema,
+		ExecuteHandlersSchema: er.handlersSchema,
+		QueryHandlersSchema:   qr.er.handlersSchema,
+		MigrateHandlerSchema:  mr.schema,
+		Authenticates:         authenticates,
 		DecodeInitRequest:     ir.decodeRequest,
 		EncodeInitResponse:    ir.encodeResponse,
 		DecodeExecuteRequest:  er.makeRequestDecoder(),
 		EncodeExecuteResponse: er.makeResponseEncoder(),
 		DecodeQueryRequest:    qr.er.makeRequestDecoder(),
 		EncodeQueryResponse:   qr.er.makeResponseEncoder(),
+		DecodeMigrateRequest:  mr.makeRequestDecoder(),
+		EncodeMigrateResponse: mr.makeResponseEncoder(),
 	}, nil
 }
 
@@ -49,8 +132,26 @@ type Implementation struct {
 	Execute func(ctx context.Context, msg any) (resp any, err error)
 	// Query defines the query handler for the smart account.
 	Query func(ctx context.Context, msg any) (resp any, err error)
+	// Authenticate defines the authentication handler for the smart account.
+	Authenticate func(ctx context.Context, req *authenticationv1.MsgAuthenticate) error
+	// Migrate defines the migration handler for the smart account, it is called
+	// when an account of type fromAccountType is migrated to the smart account.
+	Migrate func(ctx context.Context, fromAccountType string, msg any) (resp any, err error)
 
-	// Schema
+	// CollectionsSchema represents the state schema of the account, it is
+	// only set for accounts created using MakeAccountsMap.
+	CollectionsSchema collections.Schema
+	// InitHandlerSchema represents the init handler schema.
+	InitHandlerSchema HandlerSchema
+	// ExecuteHandlersSchema is the schema of the execute handlers, keyed by request message name.
+	ExecuteHandlersSchema map[string]HandlerSchema
+	// QueryHandlersSchema is the schema of the query handlers, keyed by request message name.
+	QueryHandlersSchema map[string]HandlerSchema
+	// MigrateHandlerSchema is the schema of the migrate handler, nil if the
+	// account does not accept migrations.
+	MigrateHandlerSchema *HandlerSchema
+	// Authenticates is true if the account can authenticate transactions.
+	Authenticates bool
 
 	// DecodeInitRequest decodes an init request coming from the message server.
 	DecodeInitRequest func([]byte) (any, error)
@@ -66,4 +167,9 @@ type Implementation struct {
 	DecodeQueryRequest func([]byte) (any, error)
 	// EncodeQueryResponse encodes a query response to be sent back from the message server.
 	EncodeQueryResponse func(any) ([]byte, error)
+
+	// DecodeMigrateRequest decodes a migrate request coming from the message server.
+	DecodeMigrateRequest func([]byte) (any, error)
+	// EncodeMigrateResponse encodes a migrate response to be sent back from the message server.
+	EncodeMigrateResponse func(any) ([]byte, error)
 }
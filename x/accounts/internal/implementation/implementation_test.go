@@ -32,6 +32,18 @@ func TestImplementation(t *testing.T) {
 		require.ErrorIs(t, err, errInvalidMessage)
 	})
 
+	t.Run("schema", func(t *testing.T) {
+		require.Equal(t, protoreflect.FullName("google.protobuf.StringValue"), impl.InitHandlerSchema.RequestDescriptor.FullName())
+		require.Len(t, impl.ExecuteHandlersSchema, 3)
+		require.Equal(t,
+			protoreflect.FullName("google.protobuf.BytesValue"),
+			impl.ExecuteHandlersSchema["google.protobuf.BytesValue"].ResponseDescriptor.FullName(),
+		)
+		require.Len(t, impl.QueryHandlersSchema, 2)
+		require.Nil(t, impl.MigrateHandlerSchema)
+		require.False(t, impl.Authenticates)
+	})
+
 	t.Run("init ok", func(t *testing.T) {
 		resp, err := impl.Init(ctx, &wrapperspb.StringValue{Value: "test"})
 		require.NoError(t, err)
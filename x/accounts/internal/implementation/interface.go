@@ -15,3 +15,20 @@ type Account interface {
 	// might also decide to not register any query handler.
 	RegisterQueryHandlers(builder *QueryBuilder)
 }
+
+// Authenticator is an optional interface which can be implemented by a smart account
+// that wants to authenticate the transactions it signs, this allows the account to
+// be used as a transaction signer.
+type Authenticator interface {
+	// RegisterAuthenticateHandler allows the smart account to register its authentication
+	// handler, using the provided AuthenticateBuilder.
+	RegisterAuthenticateHandler(builder *AuthenticateBuilder)
+}
+
+// Migrator is an optional interface which can be implemented by a smart account
+// that accepts accounts of other types to be migrated to it.
+type Migrator interface {
+	// RegisterMigrateHandler allows the smart account to register its migration
+	// handler, using the provided MigrateBuilder.
+	RegisterMigrateHandler(builder *MigrateBuilder)
+}
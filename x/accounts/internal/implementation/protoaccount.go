@@ -4,8 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+
+	authenticationv1 "cosmossdk.io/api/cosmos/accounts/interfaces/authentication/v1"
+	collcodec "cosmossdk.io/collections/codec"
 )
 
 // ProtoMsg is a generic interface for protobuf messages.
@@ -22,6 +26,11 @@ func RegisterInitHandler[
 	reqName := ProtoReq(new(Req)).ProtoReflect().Descriptor().FullName()
 	respName := ProtoResp(new(Resp)).ProtoReflect().Descriptor().FullName()
 
+	router.schema = HandlerSchema{
+		RequestDescriptor:  ProtoReq(new(Req)).ProtoReflect().Descriptor(),
+		ResponseDescriptor: ProtoResp(new(Resp)).ProtoReflect().Descriptor(),
+	}
+
 	router.handler = func(ctx context.Context, initRequest any) (initResponse any, err error) {
 		concrete, ok := initRequest.(ProtoReq)
 		if !ok {
@@ -57,6 +66,11 @@ func RegisterExecuteHandler[
 		return
 	}
 
+	router.handlersSchema[string(reqName)] = HandlerSchema{
+		RequestDescriptor:  ProtoReq(new(Req)).ProtoReflect().Descriptor(),
+		ResponseDescriptor: ProtoResp(new(Resp)).ProtoReflect().Descriptor(),
+	}
+
 	router.handlers[string(reqName)] = func(ctx context.Context, executeRequest any) (executeResponse any, err error) {
 		concrete, ok := executeRequest.(ProtoReq)
 		if !ok {
@@ -73,3 +87,89 @@ func RegisterQueryHandler[
 ) {
 	RegisterExecuteHandler(router.er, handler)
 }
+
+// RegisterMigrateHandler registers a migration handler for a smart account that uses protobuf.
+// The handler is called when an account of type fromAccountType is migrated to the smart
+// account, the state of the account before the migration can be accessed using OpenOldKVStore.
+func RegisterMigrateHandler[
+	Req any, ProtoReq ProtoMsg[Req], Resp any, ProtoResp ProtoMsg[Resp],
+](router *MigrateBuilder, handler func(ctx context.Context, fromAccountType string, req ProtoReq) (ProtoResp, error),
+) {
+	reqName := ProtoReq(new(Req)).ProtoReflect().Descriptor().FullName()
+	respName := ProtoResp(new(Resp)).ProtoReflect().Descriptor().FullName()
+
+	router.schema = &HandlerSchema{
+		RequestDescriptor:  ProtoReq(new(Req)).ProtoReflect().Descriptor(),
+		ResponseDescriptor: ProtoResp(new(Resp)).ProtoReflect().Descriptor(),
+	}
+
+	router.handler = func(ctx context.Context, fromAccountType string, migrateRequest any) (migrateResponse any, err error) {
+		concrete, ok := migrateRequest.(ProtoReq)
+		if !ok {
+			return nil, fmt.Errorf("%w: wanted %s, got %T", errInvalidMessage, reqName, migrateRequest)
+		}
+		return handler(ctx, fromAccountType, concrete)
+	}
+
+	router.decodeRequest = func(b []byte) (any, error) {
+		req := new(Req)
+		err := proto.Unmarshal(b, ProtoReq(req))
+		return req, err
+	}
+
+	router.encodeResponse = func(resp any) ([]byte, error) {
+		protoResp, ok := resp.(ProtoResp)
+		if !ok {
+			return nil, fmt.Errorf("%w: wanted %s, got %T", errInvalidMessage, respName, resp)
+		}
+		return proto.Marshal(protoResp)
+	}
+}
+
+// RegisterAuthenticateHandler registers the authentication handler for a smart account.
+func RegisterAuthenticateHandler(
+	router *AuthenticateBuilder,
+	handler func(ctx context.Context, req *authenticationv1.MsgAuthenticate) (*authenticationv1.MsgAuthenticateResponse, error),
+) {
+	router.handler = handler
+}
+
+// CollValue returns a collections.ValueCodec for a protobuf message, it can be used
+// by accounts to store protobuf messages in their state.
+func CollValue[T any, PT ProtoMsg[T]]() collcodec.ValueCodec[PT] {
+	return collValue[T, PT]{
+		messageName: string(PT(new(T)).ProtoReflect().Descriptor().FullName()),
+	}
+}
+
+type collValue[T any, PT ProtoMsg[T]] struct {
+	messageName string
+}
+
+func (c collValue[T, PT]) Encode(value PT) ([]byte, error) {
+	return proto.Marshal(value)
+}
+
+func (c collValue[T, PT]) Decode(b []byte) (PT, error) {
+	value := PT(new(T))
+	err := proto.Unmarshal(b, value)
+	return value, err
+}
+
+func (c collValue[T, PT]) EncodeJSON(value PT) ([]byte, error) {
+	return protojson.Marshal(value)
+}
+
+func (c collValue[T, PT]) DecodeJSON(b []byte) (PT, error) {
+	value := PT(new(T))
+	err := protojson.Unmarshal(b, value)
+	return value, err
+}
+
+func (c collValue[T, PT]) Stringify(value PT) string {
+	return fmt.Sprintf("%v", value)
+}
+
+func (c collValue[T, PT]) ValueType() string {
+	return "google.golang.org/protobuf/" + c.messageName
+}
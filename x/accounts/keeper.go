@@ -1,6 +1,7 @@
 package accounts
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/binary"
@@ -9,13 +10,22 @@ import (
 
 	"google.golang.org/protobuf/proto"
 
+	authenticationv1 "cosmossdk.io/api/cosmos/accounts/interfaces/authentication/v1"
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/accounts/accountstd"
 	"cosmossdk.io/x/accounts/internal/implementation"
+	"cosmossdk.io/x/accounts/internal/prefixstore"
 )
 
-var errAccountTypeNotFound = errors.New("account type not found")
+var (
+	errAccountTypeNotFound = errors.New("account type not found")
+	errUnauthorized        = errors.New("unauthorized")
+	errInvalidMigration    = errors.New("invalid migration")
+)
 
 var (
 	// AccountTypeKeyPrefix is the prefix for the account type key.
@@ -26,11 +36,12 @@ var (
 
 func NewKeeper(
 	ss store.KVStoreService,
+	hs header.Service,
 	addressCodec address.Codec,
 	getMsgSenderFunc func(msg proto.Message) ([]byte, error),
 	execModuleFunc func(ctx context.Context, msg proto.Message) (proto.Message, error),
 	queryModuleFunc func(ctx context.Context, msg proto.Message) (proto.Message, error),
-	accounts map[string]implementation.Account,
+	accounts ...accountstd.AccountCreatorFunc,
 ) (Keeper, error) {
 	sb := collections.NewSchemaBuilder(ss)
 	keeper := Keeper{
@@ -39,7 +50,6 @@ func NewKeeper(
 		getSenderFunc:   getMsgSenderFunc,
 		execModuleFunc:  execModuleFunc,
 		queryModuleFunc: queryModuleFunc,
-		accounts:        map[string]implementation.Implementation{},
 		Schema:          collections.Schema{},
 		AccountNumber:   collections.NewSequence(sb, AccountNumberKey, "account_number"),
 		AccountsByType:  collections.NewMap(sb, AccountTypeKeyPrefix, "accounts_by_type", collections.BytesKey, collections.StringValue),
@@ -47,13 +57,12 @@ func NewKeeper(
 	}
 
 	// make accounts implementation
-	for typ, acc := range accounts {
-		impl, err := implementation.NewImplementation(acc)
-		if err != nil {
-			return Keeper{}, err
-		}
-		keeper.accounts[typ] = impl
+	accountsMap, err := implementation.MakeAccountsMap(addressCodec, hs, accounts)
+	if err != nil {
+		return Keeper{}, err
 	}
+	keeper.accounts = accountsMap
+
 	schema, err := sb.Build()
 	if err != nil {
 		return Keeper{}, err
@@ -171,6 +180,165 @@ func (k Keeper) Query(
 	return impl.Query(ctx, queryRequest)
 }
 
+// Migrate migrates the given account to a new account type, the migration handler of the
+// new account type is invoked with the given migrate request and has access to the state
+// of the account before the migration. Only the account itself can migrate.
+func (k Keeper) Migrate(
+	ctx context.Context,
+	accountAddr []byte,
+	sender []byte,
+	newAccountType string,
+	migrateRequest any,
+) (any, error) {
+	// only the account can migrate itself.
+	if !bytes.Equal(accountAddr, sender) {
+		return nil, fmt.Errorf("%w: only the account can migrate itself", errUnauthorized)
+	}
+
+	// get account type
+	accountType, err := k.AccountsByType.Get(ctx, accountAddr)
+	if err != nil {
+		return nil, err
+	}
+	if accountType == newAccountType {
+		return nil, fmt.Errorf("%w: account is already of type %s", errInvalidMigration, newAccountType)
+	}
+
+	// get the new account implementation
+	impl, err := k.getImplementation(newAccountType)
+	if err != nil {
+		return nil, err
+	}
+
+	// move the account state under the migration prefix, so that the new
+	// implementation starts with an empty state and can read the old one.
+	if err := k.moveState(ctx, accountAddr, implementation.AccountStatePrefix, implementation.MigrationStatePrefix); err != nil {
+		return nil, err
+	}
+
+	// make the context and run the migration
+	migrationCtx := implementation.MakeMigrationContext(
+		k.makeAccountContext(ctx, accountAddr, sender, false),
+		k.storeService,
+		accountAddr,
+	)
+	resp, err := impl.Migrate(migrationCtx, accountType, migrateRequest)
+	if err != nil {
+		// restore the account state, discarding the state written by the
+		// failed migration.
+		if err := k.clearState(ctx, accountAddr, implementation.AccountStatePrefix); err != nil {
+			return nil, err
+		}
+		if err := k.moveState(ctx, accountAddr, implementation.MigrationStatePrefix, implementation.AccountStatePrefix); err != nil {
+			return nil, err
+		}
+		return nil, err
+	}
+
+	// clear the old state, and map the account to its new type.
+	if err := k.clearState(ctx, accountAddr, implementation.MigrationStatePrefix); err != nil {
+		return nil, err
+	}
+	if err := k.AccountsByType.Set(ctx, accountAddr, newAccountType); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// IsAccountsModuleAccount returns true if the given address belongs to an
+// account managed by the x/accounts module.
+func (k Keeper) IsAccountsModuleAccount(ctx context.Context, accountAddr []byte) bool {
+	has, _ := k.AccountsByType.Has(ctx, accountAddr)
+	return has
+}
+
+// AuthenticateAccount asks the given account to authenticate a transaction it signed,
+// signerIndex is the index of the account in the transaction's signers, signBytes are
+// the bytes that the account signed given the sign mode of sig.
+// If skipSigVerification is true, then the account is only expected to perform its
+// stateful checks (ex: sequence), this is used during simulation and mempool re-checks.
+func (k Keeper) AuthenticateAccount(
+	ctx context.Context,
+	accountAddr []byte,
+	signerIndex uint32,
+	sig *signingv1beta1.SignatureDescriptor,
+	signBytes []byte,
+	skipSigVerification bool,
+) error {
+	// get account type
+	accountType, err := k.AccountsByType.Get(ctx, accountAddr)
+	if err != nil {
+		return err
+	}
+
+	// get account implementation
+	impl, err := k.getImplementation(accountType)
+	if err != nil {
+		return err
+	}
+
+	// make the context and authenticate, there is no sender as the request
+	// is coming from the x/accounts module itself.
+	ctx = k.makeAccountContext(ctx, accountAddr, nil, false)
+	return impl.Authenticate(ctx, &authenticationv1.MsgAuthenticate{
+		SignerIndex:               signerIndex,
+		Signature:                 sig,
+		SignBytes:                 signBytes,
+		SkipSignatureVerification: skipSigVerification,
+	})
+}
+
+// moveState moves the state of the given account from one prefix to another.
+func (k Keeper) moveState(ctx context.Context, accountAddr, fromPrefix, toPrefix []byte) error {
+	store := k.storeService.OpenKVStore(ctx)
+	from := prefixstore.New(store, append(bytes.Clone(fromPrefix), accountAddr...))
+	to := prefixstore.New(store, append(bytes.Clone(toPrefix), accountAddr...))
+
+	keys, values, err := collectState(from)
+	if err != nil {
+		return err
+	}
+	for i, key := range keys {
+		if err := to.Set(key, values[i]); err != nil {
+			return err
+		}
+		if err := from.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// clearState deletes the state of the given account under the given prefix.
+func (k Keeper) clearState(ctx context.Context, accountAddr, prefix []byte) error {
+	state := prefixstore.New(k.storeService.OpenKVStore(ctx), append(bytes.Clone(prefix), accountAddr...))
+	keys, _, err := collectState(state)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := state.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectState returns all the keys and values of the given store, the iterator
+// is closed before returning so that the store can be safely written to.
+func collectState(s store.KVStore) (keys, values [][]byte, err error) {
+	iter, err := s.Iterator(nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+		values = append(values, iter.Value())
+	}
+	return keys, values, nil
+}
+
 func (k Keeper) getImplementation(accountType string) (implementation.Implementation, error) {
 	impl, ok := k.accounts[accountType]
 	if !ok {
@@ -202,6 +370,7 @@ func (k Keeper) makeAccountContext(ctx context.Context, accountAddr, sender []by
 			k.getSenderFunc,
 			k.execModuleFunc,
 			k.queryModuleFunc,
+			k.execAccount,
 		)
 	}
 
@@ -219,5 +388,21 @@ func (k Keeper) makeAccountContext(ctx context.Context, accountAddr, sender []by
 			return nil, fmt.Errorf("cannot execute module from query")
 		},
 		k.queryModuleFunc,
+		func(_ context.Context, _, _ []byte, _ proto.Message) (proto.Message, error) {
+			return nil, fmt.Errorf("cannot execute account from query")
+		},
 	)
 }
+
+// execAccount is used by accounts to execute a message on another account.
+func (k Keeper) execAccount(ctx context.Context, target, sender []byte, msg proto.Message) (proto.Message, error) {
+	resp, err := k.Execute(ctx, target, sender, msg)
+	if err != nil {
+		return nil, err
+	}
+	protoResp, ok := resp.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type %T", resp)
+	}
+	return protoResp, nil
+}
@@ -11,9 +11,12 @@ import (
 
 	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 	"cosmossdk.io/collections"
 	"cosmossdk.io/collections/colltest"
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
 	"cosmossdk.io/x/accounts/internal/implementation"
 )
 
@@ -24,18 +27,22 @@ type addressCodec struct{}
 func (a addressCodec) StringToBytes(text string) ([]byte, error) { return []byte(text), nil }
 func (a addressCodec) BytesToString(bz []byte) (string, error)   { return string(bz), nil }
 
-func newKeeper(t *testing.T, accounts map[string]implementation.Account) (Keeper, context.Context) {
+var _ header.Service = headerService{}
+
+type headerService struct{}
+
+func (h headerService) GetHeaderInfo(_ context.Context) header.Info { return header.Info{} }
+
+func newKeeper(t *testing.T, accounts ...accountstd.AccountCreatorFunc) (Keeper, context.Context) {
 	t.Helper()
 	ss, ctx := colltest.MockStore()
-	m, err := NewKeeper(ss, addressCodec{}, nil, nil, nil, accounts)
+	m, err := NewKeeper(ss, headerService{}, addressCodec{}, nil, nil, nil, accounts...)
 	require.NoError(t, err)
 	return m, ctx
 }
 
 func TestKeeper_Init(t *testing.T) {
-	m, ctx := newKeeper(t, map[string]implementation.Account{
-		"test": TestAccount{},
-	})
+	m, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount))
 	m.queryModuleFunc = func(ctx context.Context, msg proto.Message) (proto.Message, error) {
 		_, ok := msg.(*bankv1beta1.QueryBalanceRequest)
 		require.True(t, ok)
@@ -68,9 +75,7 @@ func TestKeeper_Init(t *testing.T) {
 }
 
 func TestKeeper_Execute(t *testing.T) {
-	m, ctx := newKeeper(t, map[string]implementation.Account{
-		"test": TestAccount{},
-	})
+	m, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount))
 	m.queryModuleFunc = func(_ context.Context, _ proto.Message) (proto.Message, error) {
 		return &bankv1beta1.QueryBalanceResponse{}, nil
 	}
@@ -108,12 +113,27 @@ func TestKeeper_Execute(t *testing.T) {
 		require.NoError(t, err)
 		require.True(t, proto.Equal(&emptypb.Empty{}, resp.(proto.Message)))
 	})
+
+	t.Run("exec account", func(t *testing.T) {
+		_, targetAddr, err := m.Init(ctx, "test", sender, &emptypb.Empty{})
+		require.NoError(t, err)
+
+		_, err = m.Execute(ctx, accAddr, sender, &wrapperspb.BytesValue{Value: targetAddr})
+		require.NoError(t, err)
+
+		// ensure the target account state was changed.
+		resp, err := m.Query(ctx, targetAddr, &wrapperspb.DoubleValue{})
+		require.NoError(t, err)
+		require.True(t, proto.Equal(wrapperspb.UInt64(42), resp.(proto.Message)))
+
+		// executing on an unknown account fails.
+		_, err = m.Execute(ctx, accAddr, sender, &wrapperspb.BytesValue{Value: []byte("unknown")})
+		require.ErrorIs(t, err, collections.ErrNotFound)
+	})
 }
 
 func TestKeeper_Query(t *testing.T) {
-	m, ctx := newKeeper(t, map[string]implementation.Account{
-		"test": TestAccount{},
-	})
+	m, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount))
 	m.queryModuleFunc = func(_ context.Context, _ proto.Message) (proto.Message, error) {
 		return &bankv1beta1.QueryBalanceResponse{}, nil
 	}
@@ -153,3 +173,115 @@ func TestKeeper_Query(t *testing.T) {
 		require.True(t, proto.Equal(wrapperspb.Int64(1000), resp.(proto.Message)))
 	})
 }
+
+func TestKeeper_AuthenticateAccount(t *testing.T) {
+	m, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount))
+	m.queryModuleFunc = func(_ context.Context, _ proto.Message) (proto.Message, error) {
+		return &bankv1beta1.QueryBalanceResponse{}, nil
+	}
+
+	// create account
+	sender := []byte("sender")
+	_, accAddr, err := m.Init(ctx, "test", sender, &emptypb.Empty{})
+	require.NoError(t, err)
+
+	t.Run("is accounts module account", func(t *testing.T) {
+		require.True(t, m.IsAccountsModuleAccount(ctx, accAddr))
+		require.False(t, m.IsAccountsModuleAccount(ctx, []byte("unknown")))
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		err := m.AuthenticateAccount(ctx, accAddr, 0, &signingv1beta1.SignatureDescriptor{Sequence: 1}, []byte("sign bytes"), false)
+		require.NoError(t, err)
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		err := m.AuthenticateAccount(ctx, accAddr, 0, &signingv1beta1.SignatureDescriptor{Sequence: 0}, []byte("sign bytes"), false)
+		require.ErrorContains(t, err, "invalid sequence")
+	})
+
+	t.Run("unknown account", func(t *testing.T) {
+		err := m.AuthenticateAccount(ctx, []byte("unknown"), 0, &signingv1beta1.SignatureDescriptor{}, nil, false)
+		require.ErrorIs(t, err, collections.ErrNotFound)
+	})
+}
+
+func TestKeeper_Migrate(t *testing.T) {
+	m, ctx := newKeeper(t,
+		accountstd.AddAccount("test", NewTestAccount),
+		accountstd.AddAccount("migrated", NewTestMigratedAccount),
+	)
+	m.queryModuleFunc = func(_ context.Context, _ proto.Message) (proto.Message, error) {
+		return &bankv1beta1.QueryBalanceResponse{}, nil
+	}
+
+	// create account and set its state
+	sender := []byte("sender")
+	_, accAddr, err := m.Init(ctx, "test", sender, &emptypb.Empty{})
+	require.NoError(t, err)
+	_, err = m.Execute(ctx, accAddr, sender, &wrapperspb.UInt64Value{Value: 10})
+	require.NoError(t, err)
+
+	t.Run("unauthorized", func(t *testing.T) {
+		_, err := m.Migrate(ctx, accAddr, sender, "migrated", wrapperspb.String("-migrated"))
+		require.ErrorIs(t, err, errUnauthorized)
+	})
+
+	t.Run("same account type", func(t *testing.T) {
+		_, err := m.Migrate(ctx, accAddr, accAddr, "test", &emptypb.Empty{})
+		require.ErrorIs(t, err, errInvalidMigration)
+	})
+
+	t.Run("unknown account type", func(t *testing.T) {
+		_, err := m.Migrate(ctx, accAddr, accAddr, "unknown", &emptypb.Empty{})
+		require.ErrorIs(t, err, errAccountTypeNotFound)
+	})
+
+	t.Run("failed migration", func(t *testing.T) {
+		_, err := m.Migrate(ctx, accAddr, accAddr, "migrated", wrapperspb.String("fail"))
+		require.ErrorContains(t, err, "migration failure")
+
+		// ensure the account and its state were left untouched
+		accType, err := m.AccountsByType.Get(ctx, accAddr)
+		require.NoError(t, err)
+		require.Equal(t, "test", accType)
+
+		queryResp, err := m.Query(ctx, accAddr, &wrapperspb.DoubleValue{})
+		require.NoError(t, err)
+		require.True(t, proto.Equal(wrapperspb.UInt64(10), queryResp.(proto.Message)))
+
+		store := m.storeService.OpenKVStore(ctx)
+		iter, err := store.Iterator(implementation.MigrationStatePrefix, collections.NewPrefix(255))
+		require.NoError(t, err)
+		require.False(t, iter.Valid())
+		require.NoError(t, iter.Close())
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		resp, err := m.Migrate(ctx, accAddr, accAddr, "migrated", wrapperspb.String("-migrated"))
+		require.NoError(t, err)
+		require.True(t, proto.Equal(wrapperspb.UInt64(10), resp.(proto.Message)))
+
+		// ensure the account type was changed
+		accType, err := m.AccountsByType.Get(ctx, accAddr)
+		require.NoError(t, err)
+		require.Equal(t, "migrated", accType)
+
+		// ensure the new implementation is used, and the state was migrated
+		queryResp, err := m.Query(ctx, accAddr, &emptypb.Empty{})
+		require.NoError(t, err)
+		require.True(t, proto.Equal(wrapperspb.String("10-migrated"), queryResp.(proto.Message)))
+
+		// ensure the old state was cleared
+		store := m.storeService.OpenKVStore(ctx)
+		iter, err := store.Iterator(implementation.MigrationStatePrefix, collections.NewPrefix(255))
+		require.NoError(t, err)
+		require.False(t, iter.Valid())
+		require.NoError(t, iter.Close())
+	})
+
+	t.Run("no migrate handler", func(t *testing.T) {
+		_, err := m.Migrate(ctx, accAddr, accAddr, "test", &emptypb.Empty{})
+		require.ErrorContains(t, err, "does not support migrations")
+	})
+}
@@ -103,3 +103,44 @@ func (m msgServer) Execute(ctx context.Context, execute *v1.MsgExecute) (*v1.Msg
 		Response: respBytes,
 	}, nil
 }
+
+func (m msgServer) Migrate(ctx context.Context, msg *v1.MsgMigrate) (*v1.MsgMigrateResponse, error) {
+	// decode sender address
+	senderAddr, err := m.k.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	// decode the account address
+	accountAddr, err := m.k.addressCodec.StringToBytes(msg.AccountAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// get the new implementation
+	impl, err := m.k.getImplementation(msg.AccountType)
+	if err != nil {
+		return nil, err
+	}
+
+	// decode message bytes into the concrete boxed message type
+	req, err := impl.DecodeMigrateRequest(msg.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	// run account migration logic
+	resp, err := m.k.Migrate(ctx, accountAddr, senderAddr, msg.AccountType, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// encode the response
+	respBytes, err := impl.EncodeMigrateResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgMigrateResponse{
+		Response: respBytes,
+	}, nil
+}
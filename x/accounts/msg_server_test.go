@@ -11,14 +11,15 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
-	"cosmossdk.io/x/accounts/internal/implementation"
+	"cosmossdk.io/x/accounts/accountstd"
 	v1 "cosmossdk.io/x/accounts/v1"
 )
 
 func TestMsgServer(t *testing.T) {
-	k, ctx := newKeeper(t, map[string]implementation.Account{
-		"test": TestAccount{},
-	})
+	k, ctx := newKeeper(t,
+		accountstd.AddAccount("test", NewTestAccount),
+		accountstd.AddAccount("migrated", NewTestMigratedAccount),
+	)
 	k.queryModuleFunc = func(ctx context.Context, msg proto.Message) (proto.Message, error) {
 		_, ok := msg.(*bankv1beta1.QueryBalanceRequest)
 		require.True(t, ok)
@@ -56,4 +57,28 @@ func TestMsgServer(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.NotNil(t, execResp)
+
+	// migrate, only the account itself can migrate.
+	migrateMsg, err := proto.Marshal(wrapperspb.String("-migrated"))
+	require.NoError(t, err)
+
+	_, err = s.Migrate(ctx, &v1.MsgMigrate{
+		Sender:         "sender",
+		AccountAddress: initResp.AccountAddress,
+		AccountType:    "migrated",
+		Message:        migrateMsg,
+	})
+	require.ErrorIs(t, err, errUnauthorized)
+
+	migrateResp, err := s.Migrate(ctx, &v1.MsgMigrate{
+		Sender:         initResp.AccountAddress,
+		AccountAddress: initResp.AccountAddress,
+		AccountType:    "migrated",
+		Message:        migrateMsg,
+	})
+	require.NoError(t, err)
+
+	migrateRespMsg := new(wrapperspb.UInt64Value)
+	require.NoError(t, proto.Unmarshal(migrateResp.Response, migrateRespMsg))
+	require.Equal(t, uint64(0), migrateRespMsg.Value)
 }
@@ -2,7 +2,9 @@ package accounts
 
 import (
 	"context"
+	"sort"
 
+	"cosmossdk.io/x/accounts/internal/implementation"
 	v1 "cosmossdk.io/x/accounts/v1"
 )
 
@@ -56,3 +58,53 @@ func (q queryServer) AccountQuery(ctx context.Context, request *v1.AccountQueryR
 		Response: respBytes,
 	}, nil
 }
+
+func (q queryServer) Schema(_ context.Context, request *v1.SchemaRequest) (*v1.SchemaResponse, error) {
+	// get impl
+	impl, err := q.k.getImplementation(request.AccountType)
+	if err != nil {
+		return nil, err
+	}
+
+	return makeAccountSchema(impl), nil
+}
+
+// makeAccountSchema returns the schema of the given account implementation,
+// handlers are sorted by request name.
+func makeAccountSchema(impl implementation.Implementation) *v1.SchemaResponse {
+	resp := &v1.SchemaResponse{
+		InitSchema:      makeHandlerSchema(impl.InitHandlerSchema),
+		ExecuteHandlers: makeHandlersSchema(impl.ExecuteHandlersSchema),
+		QueryHandlers:   makeHandlersSchema(impl.QueryHandlersSchema),
+		Authenticates:   impl.Authenticates,
+	}
+	if impl.MigrateHandlerSchema != nil {
+		resp.MigrateSchema = makeHandlerSchema(*impl.MigrateHandlerSchema)
+	}
+	for _, coll := range impl.CollectionsSchema.ListCollections() {
+		resp.StateSchema = append(resp.StateSchema, &v1.SchemaResponse_Collection{
+			Name:      coll.GetName(),
+			Prefix:    coll.GetPrefix(),
+			ValueType: coll.ValueCodec().ValueType(),
+		})
+	}
+	return resp
+}
+
+func makeHandlersSchema(schemas map[string]implementation.HandlerSchema) []*v1.SchemaResponse_Handler {
+	handlers := make([]*v1.SchemaResponse_Handler, 0, len(schemas))
+	for _, schema := range schemas {
+		handlers = append(handlers, makeHandlerSchema(schema))
+	}
+	sort.Slice(handlers, func(i, j int) bool {
+		return handlers[i].Request < handlers[j].Request
+	})
+	return handlers
+}
+
+func makeHandlerSchema(schema implementation.HandlerSchema) *v1.SchemaResponse_Handler {
+	return &v1.SchemaResponse_Handler{
+		Request:  string(schema.RequestDescriptor.FullName()),
+		Response: string(schema.ResponseDescriptor.FullName()),
+	}
+}
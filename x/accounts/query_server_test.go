@@ -11,14 +11,12 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
-	"cosmossdk.io/x/accounts/internal/implementation"
+	"cosmossdk.io/x/accounts/accountstd"
 	v1 "cosmossdk.io/x/accounts/v1"
 )
 
 func TestQueryServer(t *testing.T) {
-	k, ctx := newKeeper(t, map[string]implementation.Account{
-		"test": TestAccount{},
-	})
+	k, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount))
 	k.queryModuleFunc = func(ctx context.Context, msg proto.Message) (proto.Message, error) {
 		return &bankv1beta1.QueryBalanceResponse{}, nil
 	}
@@ -60,3 +58,48 @@ func TestQueryServer(t *testing.T) {
 
 	require.Equal(t, "10", resp.(*wrapperspb.StringValue).Value)
 }
+
+func TestQueryServer_Schema(t *testing.T) {
+	k, ctx := newKeeper(t,
+		accountstd.AddAccount("test", NewTestAccount),
+		accountstd.AddAccount("migrated", NewTestMigratedAccount),
+	)
+	qs := NewQueryServer(k)
+
+	t.Run("ok", func(t *testing.T) {
+		resp, err := qs.Schema(ctx, &v1.SchemaRequest{AccountType: "test"})
+		require.NoError(t, err)
+
+		require.Equal(t, &v1.SchemaResponse_Handler{Request: "google.protobuf.Empty", Response: "google.protobuf.Empty"}, resp.InitSchema)
+		require.Equal(t, []*v1.SchemaResponse_Handler{
+			{Request: "google.protobuf.BytesValue", Response: "google.protobuf.Empty"},
+			{Request: "google.protobuf.Empty", Response: "google.protobuf.Empty"},
+			{Request: "google.protobuf.Int64Value", Response: "google.protobuf.Empty"},
+			{Request: "google.protobuf.StringValue", Response: "google.protobuf.UInt64Value"},
+			{Request: "google.protobuf.UInt64Value", Response: "google.protobuf.Empty"},
+		}, resp.ExecuteHandlers)
+		require.Equal(t, []*v1.SchemaResponse_Handler{
+			{Request: "google.protobuf.DoubleValue", Response: "google.protobuf.UInt64Value"},
+			{Request: "google.protobuf.Empty", Response: "google.protobuf.Empty"},
+			{Request: "google.protobuf.StringValue", Response: "google.protobuf.Int64Value"},
+			{Request: "google.protobuf.UInt64Value", Response: "google.protobuf.StringValue"},
+		}, resp.QueryHandlers)
+		require.Nil(t, resp.MigrateSchema)
+		require.True(t, resp.Authenticates)
+		require.Equal(t, []*v1.SchemaResponse_Collection{
+			{Name: "counter", Prefix: []byte{0}, ValueType: "uint64"},
+		}, resp.StateSchema)
+	})
+
+	t.Run("migrate schema", func(t *testing.T) {
+		resp, err := qs.Schema(ctx, &v1.SchemaRequest{AccountType: "migrated"})
+		require.NoError(t, err)
+		require.Equal(t, &v1.SchemaResponse_Handler{Request: "google.protobuf.StringValue", Response: "google.protobuf.UInt64Value"}, resp.MigrateSchema)
+		require.False(t, resp.Authenticates)
+	})
+
+	t.Run("unknown account type", func(t *testing.T) {
+		_, err := qs.Schema(ctx, &v1.SchemaRequest{AccountType: "unknown"})
+		require.ErrorIs(t, err, errAccountTypeNotFound)
+	})
+}
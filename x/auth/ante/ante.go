@@ -14,6 +14,7 @@ import (
 // HandlerOptions are the options required for constructing a default SDK AnteHandler.
 type HandlerOptions struct {
 	AccountKeeper          AccountKeeper
+	AccountsModKeeper      AccountsModKeeper
 	BankKeeper             types.BankKeeper
 	ExtensionOptionChecker ExtensionOptionChecker
 	FeegrantKeeper         FeegrantKeeper
@@ -46,11 +47,11 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		NewValidateMemoDecorator(options.AccountKeeper),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
-		NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
+		NewSetPubKeyDecorator(options.AccountKeeper).WithAccountsModKeeper(options.AccountsModKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		NewValidateSigCountDecorator(options.AccountKeeper),
-		NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
-		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
-		NewIncrementSequenceDecorator(options.AccountKeeper),
+		NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer).WithAccountsModKeeper(options.AccountsModKeeper),
+		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler).WithAccountsModKeeper(options.AccountsModKeeper),
+		NewIncrementSequenceDecorator(options.AccountKeeper).WithAccountsModKeeper(options.AccountsModKeeper),
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
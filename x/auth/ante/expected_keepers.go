@@ -3,6 +3,7 @@ package ante
 import (
 	"context"
 
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 	"cosmossdk.io/core/address"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -23,3 +24,20 @@ type AccountKeeper interface {
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
+
+// AccountsModKeeper defines the contract needed from the x/accounts module in order to
+// authenticate transaction signers which are x/accounts accounts.
+type AccountsModKeeper interface {
+	// IsAccountsModuleAccount returns true if the given address is an x/accounts account.
+	IsAccountsModuleAccount(ctx context.Context, accountAddr []byte) bool
+	// AuthenticateAccount asks the x/accounts account to authenticate the transaction,
+	// the account is responsible for checking and incrementing its own sequence.
+	AuthenticateAccount(
+		ctx context.Context,
+		accountAddr []byte,
+		signerIndex uint32,
+		sig *signingv1beta1.SignatureDescriptor,
+		signBytes []byte,
+		skipSigVerification bool,
+	) error
+}
@@ -8,6 +8,7 @@ import (
 
 	"google.golang.org/protobuf/types/known/anypb"
 
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 	txsigning "cosmossdk.io/x/tx/signing"
@@ -47,9 +48,11 @@ type SignatureVerificationGasConsumer = func(meter storetypes.GasMeter, sig sign
 
 // SetPubKeyDecorator sets PubKeys in context for any signer which does not already have pubkey set
 // PubKeys must be set in context for all signers before any other sigverify decorators run
+// Signers which are x/accounts accounts are skipped, as they manage their own credentials.
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SetPubKeyDecorator struct {
-	ak AccountKeeper
+	ak             AccountKeeper
+	accountsKeeper AccountsModKeeper
 }
 
 func NewSetPubKeyDecorator(ak AccountKeeper) SetPubKeyDecorator {
@@ -58,6 +61,13 @@ func NewSetPubKeyDecorator(ak AccountKeeper) SetPubKeyDecorator {
 	}
 }
 
+// WithAccountsModKeeper returns a copy of the decorator skipping the signers
+// which are x/accounts accounts, the keeper can be nil.
+func (spkd SetPubKeyDecorator) WithAccountsModKeeper(accountsKeeper AccountsModKeeper) SetPubKeyDecorator {
+	spkd.accountsKeeper = accountsKeeper
+	return spkd
+}
+
 func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	sigTx, ok := tx.(authsigning.SigVerifiableTx)
 	if !ok {
@@ -82,6 +92,11 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 			return sdk.Context{}, err
 		}
 
+		// x/accounts accounts do not have a public key set in x/auth.
+		if isAccountsModuleAccount(ctx, spkd.accountsKeeper, signers[i]) {
+			continue
+		}
+
 		// PublicKey was omitted from slice since it has already been set in context
 		if pk == nil {
 			if !simulate {
@@ -147,6 +162,7 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SigGasConsumeDecorator struct {
 	ak             AccountKeeper
+	accountsKeeper AccountsModKeeper
 	sigGasConsumer SignatureVerificationGasConsumer
 }
 
@@ -161,6 +177,14 @@ func NewSigGasConsumeDecorator(ak AccountKeeper, sigGasConsumer SignatureVerific
 	}
 }
 
+// WithAccountsModKeeper returns a copy of the decorator charging the signatures
+// of the signers which are x/accounts accounts as secp256k1 signatures, the
+// keeper can be nil.
+func (sgcd SigGasConsumeDecorator) WithAccountsModKeeper(accountsKeeper AccountsModKeeper) SigGasConsumeDecorator {
+	sgcd.accountsKeeper = accountsKeeper
+	return sgcd
+}
+
 func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	sigTx, ok := tx.(authsigning.SigVerifiableTx)
 	if !ok {
@@ -181,12 +205,19 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	}
 
 	for i, sig := range sigs {
-		signerAcc, err := GetSignerAcc(ctx, sgcd.ak, signers[i])
-		if err != nil {
-			return ctx, err
-		}
+		var pubKey cryptotypes.PubKey
+		if isAccountsModuleAccount(ctx, sgcd.accountsKeeper, signers[i]) {
+			// the public keys of x/accounts accounts are not known to x/auth, their
+			// signatures are charged as secp256k1 signatures.
+			pubKey = simSecp256k1Pubkey
+		} else {
+			signerAcc, err := GetSignerAcc(ctx, sgcd.ak, signers[i])
+			if err != nil {
+				return ctx, err
+			}
 
-		pubKey := signerAcc.GetPubKey()
+			pubKey = signerAcc.GetPubKey()
+		}
 
 		// In simulate mode the transaction comes with no signatures, thus if the
 		// account's pubkey is nil, both signature verification and gasKVStore.Set()
@@ -214,11 +245,14 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 
 // SigVerificationDecorator verifies all signatures for a tx and return an error if any are invalid. Note,
 // the SigVerificationDecorator will not check signatures on ReCheck.
+// Signers which are x/accounts accounts are authenticated by the account itself, which is
+// also responsible for checking and incrementing its sequence.
 //
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SigVerificationDecorator struct {
 	ak              AccountKeeper
+	accountsKeeper  AccountsModKeeper
 	signModeHandler *txsigning.HandlerMap
 }
 
@@ -229,6 +263,14 @@ func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler *txsigning.Ha
 	}
 }
 
+// WithAccountsModKeeper returns a copy of the decorator delegating the
+// authentication of the signers which are x/accounts accounts to the accounts,
+// the keeper can be nil.
+func (svd SigVerificationDecorator) WithAccountsModKeeper(accountsKeeper AccountsModKeeper) SigVerificationDecorator {
+	svd.accountsKeeper = accountsKeeper
+	return svd
+}
+
 // OnlyLegacyAminoSigners checks SignatureData to see if all
 // signers are using SIGN_MODE_LEGACY_AMINO_JSON. If this is the case
 // then the corresponding SignatureV2 struct will not have account sequence
@@ -274,6 +316,14 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 	}
 
 	for i, sig := range sigs {
+		if isAccountsModuleAccount(ctx, svd.accountsKeeper, signers[i]) {
+			err = svd.authenticateAccountsModuleAccount(ctx, tx, signers[i], i, sig, simulate || ctx.IsReCheckTx())
+			if err != nil {
+				return ctx, err
+			}
+			continue
+		}
+
 		acc, err := GetSignerAcc(ctx, svd.ak, signers[i])
 		if err != nil {
 			return ctx, err
@@ -339,6 +389,59 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 	return next(ctx, tx, simulate)
 }
 
+// authenticateAccountsModuleAccount delegates the authentication of a signer which is an
+// x/accounts account to the account itself.
+func (svd SigVerificationDecorator) authenticateAccountsModuleAccount(
+	ctx sdk.Context, tx sdk.Tx, signer []byte, signerIndex int, sig signing.SignatureV2, skipSigVerification bool,
+) error {
+	data, ok := sig.Data.(*signing.SingleSignatureData)
+	if !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "x/accounts signers must provide a single signature, got %T", sig.Data)
+	}
+	// SDK and API sign modes share the same values.
+	signMode := signingv1beta1.SignMode(data.SignMode)
+
+	var signBytes []byte
+	if !skipSigVerification {
+		signerStr, err := svd.ak.AddressCodec().BytesToString(signer)
+		if err != nil {
+			return err
+		}
+		adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+		if !ok {
+			return fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
+		}
+		// x/accounts accounts do not have an x/auth account number, replay
+		// protection relies on the chain-id and the account's own sequence.
+		signerData := txsigning.SignerData{
+			Address:  signerStr,
+			ChainID:  ctx.ChainID(),
+			Sequence: sig.Sequence,
+		}
+		signBytes, err = svd.signModeHandler.GetSignBytes(ctx, signMode, signerData, adaptableTx.GetSigningTxData())
+		if err != nil {
+			return err
+		}
+	}
+
+	sigDesc := &signingv1beta1.SignatureDescriptor{
+		Data: &signingv1beta1.SignatureDescriptor_Data{
+			Sum: &signingv1beta1.SignatureDescriptor_Data_Single_{
+				Single: &signingv1beta1.SignatureDescriptor_Data_Single{
+					Mode:      signMode,
+					Signature: data.Signature,
+				},
+			},
+		},
+		Sequence: sig.Sequence,
+	}
+	err := svd.accountsKeeper.AuthenticateAccount(ctx, signer, uint32(signerIndex), sigDesc, signBytes, skipSigVerification)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "x/accounts authentication failed: %s", err)
+	}
+	return nil
+}
+
 // IncrementSequenceDecorator handles incrementing sequences of all signers.
 // Use the IncrementSequenceDecorator decorator to prevent replay attacks. Note,
 // there is need to execute IncrementSequenceDecorator on RecheckTx since
@@ -348,8 +451,12 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 // sequential txs orginating from the same account cannot be handled correctly in
 // a reliable way unless sequence numbers are managed and tracked manually by a
 // client. It is recommended to instead use multiple messages in a tx.
+//
+// Sequences of x/accounts accounts are incremented by the accounts themselves
+// during authentication, so they are skipped.
 type IncrementSequenceDecorator struct {
-	ak AccountKeeper
+	ak             AccountKeeper
+	accountsKeeper AccountsModKeeper
 }
 
 func NewIncrementSequenceDecorator(ak AccountKeeper) IncrementSequenceDecorator {
@@ -358,6 +465,13 @@ func NewIncrementSequenceDecorator(ak AccountKeeper) IncrementSequenceDecorator
 	}
 }
 
+// WithAccountsModKeeper returns a copy of the decorator skipping the signers
+// which are x/accounts accounts, the keeper can be nil.
+func (isd IncrementSequenceDecorator) WithAccountsModKeeper(accountsKeeper AccountsModKeeper) IncrementSequenceDecorator {
+	isd.accountsKeeper = accountsKeeper
+	return isd
+}
+
 func (isd IncrementSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	sigTx, ok := tx.(authsigning.SigVerifiableTx)
 	if !ok {
@@ -371,6 +485,10 @@ func (isd IncrementSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 	}
 
 	for _, signer := range signers {
+		if isAccountsModuleAccount(ctx, isd.accountsKeeper, signer) {
+			continue
+		}
+
 		acc := isd.ak.GetAccount(ctx, signer)
 		if err := acc.SetSequence(acc.GetSequence() + 1); err != nil {
 			panic(err)
@@ -483,6 +601,12 @@ func ConsumeMultisignatureVerificationGas(
 	return nil
 }
 
+// isAccountsModuleAccount returns true if the given signer is an x/accounts account,
+// it always returns false if the x/accounts keeper is not provided.
+func isAccountsModuleAccount(ctx sdk.Context, accountsKeeper AccountsModKeeper, signer []byte) bool {
+	return accountsKeeper != nil && accountsKeeper.IsAccountsModuleAccount(ctx, signer)
+}
+
 // GetSignerAcc returns an account for a given address that is expected to sign
 // a transaction.
 func GetSignerAcc(ctx sdk.Context, ak AccountKeeper, addr sdk.AccAddress) (sdk.AccountI, error) {
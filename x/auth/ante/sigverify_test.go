@@ -1,6 +1,8 @@
 package ante_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -8,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -359,3 +362,102 @@ func TestIncrementSequenceDecorator(t *testing.T) {
 		require.Equal(t, tc.expectedSeq, suite.accountKeeper.GetAccount(suite.ctx, addr).GetSequence())
 	}
 }
+
+var _ ante.AccountsModKeeper = (*mockAccountsModKeeper)(nil)
+
+// mockAccountsModKeeper mocks an x/accounts account controlled by a single key.
+type mockAccountsModKeeper struct {
+	addr     sdk.AccAddress
+	pubKey   cryptotypes.PubKey
+	sequence uint64
+}
+
+func (m *mockAccountsModKeeper) IsAccountsModuleAccount(_ context.Context, accountAddr []byte) bool {
+	return m.addr.Equals(sdk.AccAddress(accountAddr))
+}
+
+func (m *mockAccountsModKeeper) AuthenticateAccount(
+	_ context.Context, _ []byte, _ uint32, sig *signingv1beta1.SignatureDescriptor, signBytes []byte, skipSigVerification bool,
+) error {
+	if sig.Sequence != m.sequence {
+		return errors.New("invalid sequence")
+	}
+	if !skipSigVerification && !m.pubKey.VerifySignature(signBytes, sig.Data.GetSingle().Signature) {
+		return errors.New("invalid signature")
+	}
+	m.sequence++
+	return nil
+}
+
+func TestSigVerification_AccountsModuleAccount(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	// the signer is not an x/auth account.
+	priv, pub, addr := testdata.KeyTestPubAddr()
+	accountsKeeper := &mockAccountsModKeeper{addr: addr, pubKey: pub}
+
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewSetPubKeyDecorator(suite.accountKeeper).WithAccountsModKeeper(accountsKeeper),
+		ante.NewSigGasConsumeDecorator(suite.accountKeeper, ante.DefaultSigVerificationGasConsumer).WithAccountsModKeeper(accountsKeeper),
+		ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler()).WithAccountsModKeeper(accountsKeeper),
+		ante.NewIncrementSequenceDecorator(suite.accountKeeper).WithAccountsModKeeper(accountsKeeper),
+	)
+
+	// x/accounts accounts do not have an account number.
+	validTx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{priv}, []uint64{0}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	_, err = antehandler(suite.ctx, validTx, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), accountsKeeper.sequence)
+	require.Nil(t, suite.accountKeeper.GetAccount(suite.ctx, addr))
+
+	// replaying the transaction fails as the sequence was incremented.
+	_, err = antehandler(suite.ctx, validTx, false)
+	require.ErrorContains(t, err, "invalid sequence")
+
+	// a signature over the wrong chain-id is rejected.
+	wrongChainTx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{priv}, []uint64{0}, []uint64{1}, "wrong-chain", signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	_, err = antehandler(suite.ctx, wrongChainTx, false)
+	require.ErrorContains(t, err, "invalid signature")
+	require.Equal(t, uint64(1), accountsKeeper.sequence)
+
+	// during re-checks the account only performs its stateful checks.
+	_, err = antehandler(suite.ctx.WithIsReCheckTx(true), wrongChainTx, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), accountsKeeper.sequence)
+}
+
+func TestSigGasConsume_AccountsModuleAccount(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	priv, pub, addr := testdata.KeyTestPubAddr()
+	accountsKeeper := &mockAccountsModKeeper{addr: addr, pubKey: pub}
+
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{priv}, []uint64{0}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	gasConsumed := func(sigGasConsumer ante.SignatureVerificationGasConsumer) storetypes.Gas {
+		antehandler := sdk.ChainAnteDecorators(ante.NewSigGasConsumeDecorator(suite.accountKeeper, sigGasConsumer).WithAccountsModKeeper(accountsKeeper))
+		ctx := suite.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		_, err := antehandler(ctx, tx, false)
+		require.NoError(t, err)
+		return ctx.GasMeter().GasConsumed()
+	}
+	noSigGas := func(storetypes.GasMeter, signing.SignatureV2, types.Params) error { return nil }
+
+	// the signature of an x/accounts account is charged as a secp256k1 signature.
+	params := suite.accountKeeper.GetParams(suite.ctx)
+	require.Equal(t, params.SigVerifyCostSecp256k1, gasConsumed(ante.DefaultSigVerificationGasConsumer)-gasConsumed(noSigGas))
+}
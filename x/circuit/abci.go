@@ -0,0 +1,20 @@
+package circuit
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/x/circuit/keeper"
+	"cosmossdk.io/x/circuit/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// BeginBlocker resets the circuit breakers whose expiry height or time has
+// been reached.
+func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	_, err := k.ExpireBreaks(ctx)
+	return err
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/cockroachdb/errors"
+	protov2 "google.golang.org/protobuf/proto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -11,6 +12,7 @@ import (
 // CircuitBreaker is an interface that defines the methods for a circuit breaker.
 type CircuitBreaker interface {
 	IsAllowed(ctx context.Context, typeURL string) (bool, error)
+	IsMsgAllowed(ctx context.Context, msg protov2.Message) (bool, error)
 }
 
 // CircuitBreakerDecorator is an AnteDecorator that checks if the transaction type is allowed to enter the mempool or be executed
@@ -37,5 +39,22 @@ func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		}
 	}
 
+	// check the message contents against the filter rules
+	msgs, err := tx.GetMsgsV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	for _, msg := range msgs {
+		isAllowed, err := cbd.circuitKeeper.IsMsgAllowed(ctx, msg)
+		if err != nil {
+			return ctx, err
+		}
+
+		if !isAllowed {
+			return ctx, errors.New("tx message not allowed by a circuit breaker filter rule")
+		}
+	}
+
 	return next(ctx, tx, simulate)
 }
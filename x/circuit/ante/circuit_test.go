@@ -6,6 +6,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
 
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/circuit/ante"
@@ -21,6 +22,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 )
 
+const blockedGrantee = "cosmos1qasf9ehx8m7cnat39ndc74rx3fg7z66u8lw0fd"
+
 type fixture struct {
 	ctx           context.Context
 	mockStoreKey  storetypes.StoreKey
@@ -37,6 +40,11 @@ func (m MockCircuitBreaker) IsAllowed(ctx context.Context, typeURL string) (bool
 	return typeURL == "/cosmos.circuit.v1.MsgAuthorizeCircuitBreaker", nil
 }
 
+func (m MockCircuitBreaker) IsMsgAllowed(ctx context.Context, msg protov2.Message) (bool, error) {
+	fd := msg.ProtoReflect().Descriptor().Fields().ByName("grantee")
+	return fd == nil || msg.ProtoReflect().Get(fd).String() != blockedGrantee, nil
+}
+
 func initFixture(t *testing.T) *fixture {
 	t.Helper()
 	mockStoreKey := storetypes.NewKVStoreKey("test")
@@ -61,14 +69,18 @@ func TestCircuitBreakerDecorator(t *testing.T) {
 	_, _, addr1 := testdata.KeyTestPubAddr()
 
 	testcases := []struct {
-		msg     sdk.Msg
-		allowed bool
+		msg    sdk.Msg
+		expErr string
 	}{
 		{msg: &cbtypes.MsgAuthorizeCircuitBreaker{
-			Grantee: "cosmos1fghij",
-			Granter: "cosmos1abcde",
-		}, allowed: true},
-		{msg: testdata.NewTestMsg(addr1), allowed: false},
+			Grantee: "cosmos1p8s0p6gqc6c9gt77lgr2qqujz49huhu6a80smx",
+			Granter: "cosmos1zglwfu6xjzvzagqcmvzewyzjp9xwqw5qwrr8n9",
+		}},
+		{msg: testdata.NewTestMsg(addr1), expErr: "tx type not allowed"},
+		{msg: &cbtypes.MsgAuthorizeCircuitBreaker{
+			Grantee: blockedGrantee,
+			Granter: "cosmos1zglwfu6xjzvzagqcmvzewyzjp9xwqw5qwrr8n9",
+		}, expErr: "tx message not allowed by a circuit breaker filter rule"},
 	}
 
 	for _, tc := range testcases {
@@ -87,10 +99,10 @@ func TestCircuitBreakerDecorator(t *testing.T) {
 			return ctx, nil
 		})
 
-		if tc.allowed {
+		if tc.expErr == "" {
 			require.NoError(t, err)
 		} else {
-			require.Equal(t, "tx type not allowed", err.Error())
+			require.EqualError(t, err, tc.expErr)
 		}
 	}
 }
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -16,6 +17,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 )
 
+// Flags for the disable command.
+const (
+	FlagExpiryHeight = "expiry-height"
+	FlagExpiryTime   = "expiry-time"
+)
+
 // NewTxCmd returns a root CLI command handler for all x/circuit transaction commands.
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -86,9 +93,12 @@ func AuthorizeCircuitBreakerCmd() *cobra.Command {
 // TripCircuitBreakerCmd returns a CLI command handler for creating a MsgTripCircuitBreaker transaction.
 func TripCircuitBreakerCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "disable [type_url]",
-		Short:   "disable a message from being executed",
-		Example: fmt.Sprintf(`%s circuit disable "cosmos.bank.v1beta1.MsgSend,cosmos.bank.v1beta1.MsgMultiSend"`, version.AppName),
+		Use:   "disable [type_url]",
+		Short: "disable a message from being executed",
+		Long: `Disable a message from being executed.
+The message can optionally be re-enabled automatically at a given block height
+(--expiry-height) or block time (--expiry-time), whichever is reached first.`,
+		Example: fmt.Sprintf(`%s circuit disable "cosmos.bank.v1beta1.MsgSend,cosmos.bank.v1beta1.MsgMultiSend" --expiry-height 1000`, version.AppName),
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -96,15 +106,35 @@ func TripCircuitBreakerCmd() *cobra.Command {
 				return err
 			}
 
+			expiryHeight, err := cmd.Flags().GetInt64(FlagExpiryHeight)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgTripCircuitBreaker{
-				Authority:   clientCtx.GetFromAddress().String(),
-				MsgTypeUrls: strings.Split(args[0], ","),
+				Authority:    clientCtx.GetFromAddress().String(),
+				MsgTypeUrls:  strings.Split(args[0], ","),
+				ExpiryHeight: expiryHeight,
+			}
+
+			expiryTime, err := cmd.Flags().GetString(FlagExpiryTime)
+			if err != nil {
+				return err
+			}
+			if expiryTime != "" {
+				t, err := time.Parse(time.RFC3339, expiryTime)
+				if err != nil {
+					return fmt.Errorf("invalid expiry time %q: %w", expiryTime, err)
+				}
+				msg.ExpiryTime = &t
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Int64(FlagExpiryHeight, 0, "Block height at which the message is automatically re-enabled")
+	cmd.Flags().String(FlagExpiryTime, "", "Block time (RFC3339) at which the message is automatically re-enabled")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -3,13 +3,16 @@ package keeper
 import (
 	context "context"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/circuit/types"
 )
 
 func (k *Keeper) ExportGenesis(ctx context.Context) (data *types.GenesisState) {
 	var (
-		permissions  []*types.GenesisAccountPermissions
-		disabledMsgs []string
+		permissions   []*types.GenesisAccountPermissions
+		disabledMsgs  []string
+		breakExpiries []*types.GenesisBreakExpiry
+		filterRules   []*types.FilterRule
 	)
 
 	err := k.Permissions.Walk(ctx, nil, func(address []byte, perm types.Permissions) (stop bool, err error) {
@@ -37,9 +40,30 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (data *types.GenesisState) {
 		panic(err)
 	}
 
+	err = k.BreakExpiries.Walk(ctx, nil, func(msgUrl string, expiry types.BreakExpiry) (stop bool, err error) {
+		breakExpiries = append(breakExpiries, &types.GenesisBreakExpiry{
+			MsgTypeUrl: msgUrl,
+			Expiry:     &expiry,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.FilterRules.Walk(ctx, nil, func(_ collections.Pair[string, string], rule types.FilterRule) (stop bool, err error) {
+		filterRules = append(filterRules, &rule)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
 		AccountPermissions: permissions,
 		DisabledTypeUrls:   disabledMsgs,
+		BreakExpiries:      breakExpiries,
+		FilterRules:        filterRules,
 	}
 }
 
@@ -62,4 +86,16 @@ func (k *Keeper) InitGenesis(ctx context.Context, genState *types.GenesisState)
 			panic(err)
 		}
 	}
+	for _, expiry := range genState.BreakExpiries {
+		// Set the expiry of the disabled type urls
+		if err := k.BreakExpiries.Set(ctx, expiry.MsgTypeUrl, *expiry.Expiry); err != nil {
+			panic(err)
+		}
+	}
+	for _, rule := range genState.FilterRules {
+		// Set the filter rules
+		if err := k.FilterRules.Set(ctx, collections.Join(rule.MsgTypeUrl, rule.Name), *rule); err != nil {
+			panic(err)
+		}
+	}
 }
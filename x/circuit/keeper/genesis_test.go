@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -67,11 +68,23 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 	accounts = append(accounts, &genAccsPerms)
 
 	url := "test_url"
+	expiry := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
 
 	genesisState := &types.GenesisState{
 		AccountPermissions: accounts,
 		DisabledTypeUrls:   []string{url},
+		BreakExpiries: []*types.GenesisBreakExpiry{
+			{MsgTypeUrl: url, Expiry: &types.BreakExpiry{Height: 100, Time: &expiry}},
+		},
+		FilterRules: []*types.FilterRule{
+			{
+				Name:       "rule",
+				MsgTypeUrl: "/test_url",
+				Predicates: []*types.FieldPredicate{{FieldPath: "field", Operator: types.FieldPredicate_OPERATOR_CONTAINS, Value: "value"}},
+			},
+		},
 	}
+	s.Require().NoError(genesisState.Validate())
 
 	s.keeper.InitGenesis(s.ctx, genesisState)
 
@@ -85,4 +98,6 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 
 	s.Require().Equal(genesisState.AccountPermissions, exportedGenesisState.AccountPermissions)
 	s.Require().Equal(genesisState.DisabledTypeUrls, exportedGenesisState.DisabledTypeUrls)
+	s.Require().Equal(genesisState.BreakExpiries, exportedGenesisState.BreakExpiries)
+	s.Require().Equal(genesisState.FilterRules, exportedGenesisState.FilterRules)
 }
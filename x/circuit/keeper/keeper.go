@@ -3,12 +3,15 @@ package keeper
 import (
 	context "context"
 
+	protov2 "google.golang.org/protobuf/proto"
+
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/x/circuit/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Keeper defines the circuit module's keeper.
@@ -25,6 +28,11 @@ type Keeper struct {
 	Permissions collections.Map[[]byte, types.Permissions]
 	// DisableList contains the message URLs that are disabled
 	DisableList collections.KeySet[string]
+	// BreakExpiries contains the expiry of the disabled message URLs that are
+	// automatically reset
+	BreakExpiries collections.Map[string, types.BreakExpiry]
+	// FilterRules contains the filter rules indexed by message URL and rule name
+	FilterRules collections.Map[collections.Pair[string, string], types.FilterRule]
 }
 
 // NewKeeper constructs a new Circuit Keeper instance
@@ -54,6 +62,20 @@ func NewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, authori
 			"disable_list",
 			collections.StringKey,
 		),
+		BreakExpiries: collections.NewMap(
+			sb,
+			types.BreakExpiriesPrefix,
+			"break_expiries",
+			collections.StringKey,
+			codec.CollValue[types.BreakExpiry](cdc),
+		),
+		FilterRules: collections.NewMap(
+			sb,
+			types.FilterRulesPrefix,
+			"filter_rules",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.FilterRule](cdc),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -74,3 +96,80 @@ func (k *Keeper) IsAllowed(ctx context.Context, msgURL string) (bool, error) {
 	has, err := k.DisableList.Has(ctx, msgURL)
 	return !has, err
 }
+
+// HasFilterRules returns true when there is at least one filter rule for the
+// msg URL.
+func (k *Keeper) HasFilterRules(ctx context.Context, msgURL string) (bool, error) {
+	iter, err := k.FilterRules.Iterate(ctx, collections.NewPrefixedPairRange[string, string](msgURL))
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+
+	return iter.Valid(), nil
+}
+
+// IsMsgAllowed returns false when the message matches one of the filter rules
+// of its msg URL, else true.
+func (k *Keeper) IsMsgAllowed(ctx context.Context, msg protov2.Message) (bool, error) {
+	msgURL := "/" + string(msg.ProtoReflect().Descriptor().FullName())
+
+	iter, err := k.FilterRules.Iterate(ctx, collections.NewPrefixedPairRange[string, string](msgURL))
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		rule, err := iter.Value()
+		if err != nil {
+			return false, err
+		}
+
+		matches, err := rule.Matches(msg.ProtoReflect())
+		if err != nil {
+			return false, err
+		}
+		if matches {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// ExpireBreaks resets every circuit breaker whose expiry has been reached at
+// the current block height or block time, and returns the reset msg URLs.
+func (k *Keeper) ExpireBreaks(ctx context.Context) ([]string, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	headerInfo := sdkCtx.HeaderInfo()
+
+	var expired []string
+	err := k.BreakExpiries.Walk(ctx, nil, func(msgURL string, expiry types.BreakExpiry) (stop bool, err error) {
+		if expiry.IsExpired(headerInfo.Height, headerInfo.Time) {
+			expired = append(expired, msgURL)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, msgURL := range expired {
+		if err := k.DisableList.Remove(ctx, msgURL); err != nil {
+			return nil, err
+		}
+		if err := k.BreakExpiries.Remove(ctx, msgURL); err != nil {
+			return nil, err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"expire_circuit_breaker",
+				sdk.NewAttribute("msg_url", msgURL),
+			),
+		)
+	}
+
+	return expired, nil
+}
@@ -4,20 +4,33 @@ import (
 	"bytes"
 	context "context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
 
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/circuit"
 	"cosmossdk.io/x/circuit/keeper"
 	"cosmossdk.io/x/circuit/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 var addresses = []string{
@@ -161,3 +174,172 @@ func TestIterateDisabledList(t *testing.T) {
 	require.Equal(t, mockMsgs[1], returnedDisabled[0])
 	require.Equal(t, mockMsgs[2], returnedDisabled[1])
 }
+
+func TestExpireBreaks(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+
+	byHeight, byTime, permanent := "by_height", "by_time", "permanent"
+	for _, url := range []string{byHeight, byTime, permanent} {
+		require.NoError(t, f.keeper.DisableList.Set(f.ctx, url))
+	}
+	require.NoError(t, f.keeper.BreakExpiries.Set(f.ctx, byHeight, types.BreakExpiry{Height: 10}))
+	require.NoError(t, f.keeper.BreakExpiries.Set(f.ctx, byTime, types.BreakExpiry{Time: &later}))
+
+	// nothing expires before the bounds are reached
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithHeaderInfo(header.Info{Height: 9, Time: now})
+	expired, err := f.keeper.ExpireBreaks(ctx)
+	require.NoError(t, err)
+	require.Empty(t, expired)
+
+	// the height bound is reached
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 10, Time: now}).WithEventManager(sdk.NewEventManager())
+	expired, err = f.keeper.ExpireBreaks(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{byHeight}, expired)
+	require.Equal(t, sdk.Events{
+		sdk.NewEvent("expire_circuit_breaker", sdk.NewAttribute("msg_url", byHeight)),
+	}, ctx.EventManager().Events())
+
+	allowed, err := f.keeper.IsAllowed(ctx, byHeight)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	// the time bound is reached
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 11, Time: later})
+	expired, err = f.keeper.ExpireBreaks(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{byTime}, expired)
+
+	// breaks without an expiry are never reset
+	allowed, err = f.keeper.IsAllowed(ctx, permanent)
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	has, err := f.keeper.BreakExpiries.Has(ctx, byTime)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestIsMsgAllowed(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	sendURL := "/cosmos.bank.v1beta1.MsgSend"
+	require.NoError(t, f.keeper.FilterRules.Set(f.ctx, collections.Join(sendURL, "block-evil-denom"), types.FilterRule{
+		Name:       "block-evil-denom",
+		MsgTypeUrl: sendURL,
+		Predicates: []*types.FieldPredicate{
+			{FieldPath: "amount.denom", Operator: types.FieldPredicate_OPERATOR_EQUAL, Value: "evil"},
+		},
+	}))
+
+	testCases := []struct {
+		name    string
+		msg     protov2.Message
+		allowed bool
+	}{
+		{
+			name:    "matching message",
+			msg:     &bankv1beta1.MsgSend{Amount: []*basev1beta1.Coin{{Denom: "stake"}, {Denom: "evil"}}},
+			allowed: false,
+		},
+		{
+			name:    "non matching message",
+			msg:     &bankv1beta1.MsgSend{Amount: []*basev1beta1.Coin{{Denom: "stake"}}},
+			allowed: true,
+		},
+		{
+			name:    "message without rules",
+			msg:     &bankv1beta1.MsgMultiSend{Inputs: []*bankv1beta1.Input{{Coins: []*basev1beta1.Coin{{Denom: "evil"}}}}},
+			allowed: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		allowed, err := f.keeper.IsMsgAllowed(f.ctx, tc.msg)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.allowed, allowed, tc.name)
+	}
+}
+
+func TestHasFilterRules(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	sendURL := "/cosmos.bank.v1beta1.MsgSend"
+	require.NoError(t, f.keeper.FilterRules.Set(f.ctx, collections.Join(sendURL, "block-evil-denom"), types.FilterRule{
+		Name:       "block-evil-denom",
+		MsgTypeUrl: sendURL,
+	}))
+
+	has, err := f.keeper.HasFilterRules(f.ctx, sendURL)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	has, err = f.keeper.HasFilterRules(f.ctx, "/cosmos.bank.v1beta1.MsgMultiSend")
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// a msg URL which is a prefix of another one has no rules
+	has, err = f.keeper.HasFilterRules(f.ctx, "/cosmos.bank.v1beta1.MsgSen")
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+type mockBankMsgServer struct {
+	banktypes.MsgServer
+}
+
+func (mockBankMsgServer) Send(context.Context, *banktypes.MsgSend) (*banktypes.MsgSendResponse, error) {
+	return &banktypes.MsgSendResponse{}, nil
+}
+
+type mockAuthzAccountKeeper struct {
+	authz.AccountKeeper
+	ac address.Codec
+}
+
+func (m mockAuthzAccountKeeper) AddressCodec() address.Codec {
+	return m.ac
+}
+
+func TestIsMsgAllowedNestedMsgs(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	sendURL := "/cosmos.bank.v1beta1.MsgSend"
+	require.NoError(t, f.keeper.FilterRules.Set(f.ctx, collections.Join(sendURL, "block-evil-denom"), types.FilterRule{
+		Name:       "block-evil-denom",
+		MsgTypeUrl: sendURL,
+		Predicates: []*types.FieldPredicate{
+			{FieldPath: "amount.denom", Operator: types.FieldPredicate_OPERATOR_EQUAL, Value: "evil"},
+		},
+	}))
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(bank.AppModuleBasic{}, authzmodule.AppModuleBasic{})
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	router.SetCircuit(&f.keeper)
+
+	authzKeeper := authzkeeper.NewKeeper(nil, encCfg.Codec, router, mockAuthzAccountKeeper{ac: f.ac})
+	banktypes.RegisterMsgServer(router, mockBankMsgServer{})
+	authz.RegisterMsgServer(router, authzKeeper)
+
+	addr := addresses[0]
+	execSend := func(denom string) error {
+		send := &banktypes.MsgSend{FromAddress: addr, ToAddress: addresses[1], Amount: sdk.NewCoins(sdk.NewInt64Coin(denom, 10))}
+		exec := authz.NewMsgExec(sdk.MustAccAddressFromBech32(addr), []sdk.Msg{send})
+		_, err := router.Handler(&exec)(sdk.UnwrapSDKContext(f.ctx), &exec)
+		return err
+	}
+
+	require.NoError(t, execSend("stake"))
+
+	// the filter rules apply to the messages executed through x/authz.
+	err := execSend("evil")
+	require.ErrorContains(t, err, "circuit breaker filter rule disables execution of this message: "+sendURL)
+}
@@ -4,12 +4,17 @@ import (
 	"bytes"
 	context "context"
 	fmt "fmt"
+	"strconv"
 	"strings"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/circuit/types"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -86,6 +91,18 @@ func (srv msgServer) TripCircuitBreaker(ctx context.Context, msg *types.MsgTripC
 		return nil, err
 	}
 
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	expiry := types.NewBreakExpiry(msg.ExpiryHeight, msg.ExpiryTime)
+	if expiry != nil {
+		if err := expiry.Validate(); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if expiry.IsExpired(sdkCtx.HeaderInfo().Height, sdkCtx.HeaderInfo().Time) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "expiry must be after the current block height and time")
+		}
+	}
+
 	for _, msgTypeURL := range msg.MsgTypeUrls {
 		// check if the message is in the list of allowed messages
 		isAllowed, err := srv.IsAllowed(ctx, msgTypeURL)
@@ -113,17 +130,28 @@ func (srv msgServer) TripCircuitBreaker(ctx context.Context, msg *types.MsgTripC
 			return nil, err
 		}
 
+		if expiry != nil {
+			if err = srv.BreakExpiries.Set(ctx, msgTypeURL, *expiry); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	urls := strings.Join(msg.GetMsgTypeUrls(), ",")
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute("authority", msg.Authority),
+		sdk.NewAttribute("msg_url", urls),
+	}
+	if msg.ExpiryHeight != 0 {
+		attrs = append(attrs, sdk.NewAttribute("expiry_height", strconv.FormatInt(msg.ExpiryHeight, 10)))
+	}
+	if msg.ExpiryTime != nil {
+		attrs = append(attrs, sdk.NewAttribute("expiry_time", msg.ExpiryTime.UTC().Format(time.RFC3339)))
+	}
+
 	sdkCtx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			"trip_circuit_breaker",
-			sdk.NewAttribute("authority", msg.Authority),
-			sdk.NewAttribute("msg_url", urls),
-		),
+		sdk.NewEvent("trip_circuit_breaker", attrs...),
 	})
 
 	return &types.MsgTripCircuitBreakerResponse{
@@ -172,6 +200,10 @@ func (srv msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgRese
 		if err = srv.DisableList.Remove(ctx, msgTypeURL); err != nil {
 			return nil, err
 		}
+
+		if err = srv.BreakExpiries.Remove(ctx, msgTypeURL); err != nil {
+			return nil, err
+		}
 	}
 
 	urls := strings.Join(msg.GetMsgTypeUrls(), ",")
@@ -188,6 +220,129 @@ func (srv msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgRese
 	return &types.MsgResetCircuitBreakerResponse{Success: true}, nil
 }
 
+// AddFilterRule pauses processing of the Msg's of a type URL whose fields
+// match the rule's predicates.
+func (srv msgServer) AddFilterRule(ctx context.Context, msg *types.MsgAddFilterRule) (*types.MsgAddFilterRuleResponse, error) {
+	if msg.Rule == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "rule cannot be nil")
+	}
+	rule := *msg.Rule
+
+	if err := rule.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// when the codec knows the message descriptors, ensure the rule can be evaluated
+	if registry, ok := srv.cdc.(interface {
+		InterfaceRegistry() codectypes.InterfaceRegistry
+	}); ok {
+		desc, err := registry.InterfaceRegistry().FindDescriptorByName(protoreflect.FullName(strings.TrimPrefix(rule.MsgTypeUrl, "/")))
+		if err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown msg type url %s", rule.MsgTypeUrl)
+		}
+		md, ok := desc.(protoreflect.MessageDescriptor)
+		if !ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s is not a message", rule.MsgTypeUrl)
+		}
+		if err := rule.ValidateDescriptor(md); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+
+	if err := srv.checkMsgPermission(ctx, msg.Authority, rule.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	key := collections.Join(rule.MsgTypeUrl, rule.Name)
+	has, err := srv.FilterRules.Has(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, fmt.Errorf("filter rule %s already exists for message %s", rule.Name, rule.MsgTypeUrl)
+	}
+
+	if err := srv.FilterRules.Set(ctx, key, rule); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"add_circuit_breaker_filter_rule",
+			sdk.NewAttribute("authority", msg.Authority),
+			sdk.NewAttribute("msg_url", rule.MsgTypeUrl),
+			sdk.NewAttribute("name", rule.Name),
+		),
+	})
+
+	return &types.MsgAddFilterRuleResponse{}, nil
+}
+
+// RemoveFilterRule resumes processing of the Msg's that have been paused
+// using AddFilterRule.
+func (srv msgServer) RemoveFilterRule(ctx context.Context, msg *types.MsgRemoveFilterRule) (*types.MsgRemoveFilterRuleResponse, error) {
+	if err := srv.checkMsgPermission(ctx, msg.Authority, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	key := collections.Join(msg.MsgTypeUrl, msg.Name)
+	has, err := srv.FilterRules.Has(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("filter rule %s does not exist for message %s", msg.Name, msg.MsgTypeUrl)
+	}
+
+	if err := srv.FilterRules.Remove(ctx, key); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"remove_circuit_breaker_filter_rule",
+			sdk.NewAttribute("authority", msg.Authority),
+			sdk.NewAttribute("msg_url", msg.MsgTypeUrl),
+			sdk.NewAttribute("name", msg.Name),
+		),
+	})
+
+	return &types.MsgRemoveFilterRuleResponse{}, nil
+}
+
+// checkMsgPermission returns an error if the account cannot trip or reset the
+// circuit breaker for the message.
+func (srv msgServer) checkMsgPermission(ctx context.Context, authority, msgTypeURL string) error {
+	address, err := srv.addressCodec.StringToBytes(authority)
+	if err != nil {
+		return err
+	}
+
+	// if the sender is the module authority, no need to check perms
+	if bytes.Equal(address, srv.GetAuthority()) {
+		return nil
+	}
+
+	perms, err := srv.Permissions.Get(ctx, address)
+	if err != nil && !errorsmod.IsOf(err, collections.ErrNotFound) {
+		return err
+	}
+
+	switch perms.Level {
+	case types.Permissions_LEVEL_SUPER_ADMIN, types.Permissions_LEVEL_ALL_MSGS:
+		return nil
+	case types.Permissions_LEVEL_SOME_MSGS:
+		if !hasPermissionForMsg(perms, msgTypeURL) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account does not have permission for message %s", msgTypeURL)
+		}
+		return nil
+	default:
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have circuit breaker permissions")
+	}
+}
+
 // hasPermissionForMsg returns true if the account can trip or reset the message.
 func hasPermissionForMsg(perms types.Permissions, msg string) bool {
 	for _, msgurl := range perms.LimitTypeUrls {
@@ -3,10 +3,12 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/x/circuit/keeper"
 	"cosmossdk.io/x/circuit/types"
 
@@ -373,3 +375,155 @@ func TestResetCircuitBreakerSomeMsgs(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, allowed, "circuit breaker should be reset")
 }
+
+func TestTripCircuitBreakerWithExpiry(t *testing.T) {
+	ft := initFixture(t)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ft.ctx = sdk.UnwrapSDKContext(ft.ctx).WithHeaderInfo(header.Info{Height: 10, Time: now})
+
+	// expiry in the past is rejected
+	trip := &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}, ExpiryHeight: 10}
+	_, err = srv.TripCircuitBreaker(ft.ctx, trip)
+	require.ErrorContains(t, err, "expiry must be after the current block height and time")
+
+	past := now.Add(-time.Hour)
+	trip = &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}, ExpiryTime: &past}
+	_, err = srv.TripCircuitBreaker(ft.ctx, trip)
+	require.ErrorContains(t, err, "expiry must be after the current block height and time")
+
+	// negative expiry height is rejected
+	trip = &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}, ExpiryHeight: -1}
+	_, err = srv.TripCircuitBreaker(ft.ctx, trip)
+	require.ErrorContains(t, err, "invalid expiry height")
+
+	// trip with a height and a time bound
+	future := now.Add(time.Hour)
+	trip = &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}, ExpiryHeight: 20, ExpiryTime: &future}
+	_, err = srv.TripCircuitBreaker(ft.ctx, trip)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		sdk.NewEvent(
+			"trip_circuit_breaker",
+			sdk.NewAttribute("authority", authority),
+			sdk.NewAttribute("msg_url", msgSend),
+			sdk.NewAttribute("expiry_height", "20"),
+			sdk.NewAttribute("expiry_time", future.Format(time.RFC3339)),
+		),
+		lastEvent(ft.ctx),
+	)
+
+	expiry, err := ft.keeper.BreakExpiries.Get(ft.ctx, msgSend)
+	require.NoError(t, err)
+	require.Equal(t, int64(20), expiry.Height)
+	require.Equal(t, future, *expiry.Time)
+
+	// resetting the circuit breaker removes the expiry
+	reset := &types.MsgResetCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}}
+	_, err = srv.ResetCircuitBreaker(ft.ctx, reset)
+	require.NoError(t, err)
+
+	has, err := ft.keeper.BreakExpiries.Has(ft.ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestAddAndRemoveFilterRule(t *testing.T) {
+	ft := initFixture(t)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+
+	sendURL := "/" + msgSend
+	rule := &types.FilterRule{
+		Name:       "block-evil-denom",
+		MsgTypeUrl: sendURL,
+		Predicates: []*types.FieldPredicate{
+			{FieldPath: "amount.denom", Operator: types.FieldPredicate_OPERATOR_EQUAL, Value: "evil"},
+		},
+	}
+
+	// invalid rules are rejected
+	_, err = srv.AddFilterRule(ft.ctx, &types.MsgAddFilterRule{Authority: authority})
+	require.ErrorContains(t, err, "rule cannot be nil")
+
+	_, err = srv.AddFilterRule(ft.ctx, &types.MsgAddFilterRule{Authority: authority, Rule: &types.FilterRule{Name: "no-predicates", MsgTypeUrl: sendURL}})
+	require.ErrorContains(t, err, "at least one predicate")
+
+	_, err = srv.AddFilterRule(ft.ctx, &types.MsgAddFilterRule{Authority: authority, Rule: &types.FilterRule{
+		Name:       "unknown-field",
+		MsgTypeUrl: sendURL,
+		Predicates: []*types.FieldPredicate{{FieldPath: "amount.unknown", Operator: types.FieldPredicate_OPERATOR_EQUAL}},
+	}})
+	require.ErrorContains(t, err, "unknown field unknown")
+
+	_, err = srv.AddFilterRule(ft.ctx, &types.MsgAddFilterRule{Authority: authority, Rule: &types.FilterRule{
+		Name:       "unknown-msg",
+		MsgTypeUrl: "/cosmos.unknown.v1.MsgUnknown",
+		Predicates: rule.Predicates,
+	}})
+	require.ErrorContains(t, err, "unknown msg type url")
+
+	// user with permissions for other messages cannot add the rule
+	someMsgs := &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{"/cosmos.bank.v1beta1.MsgMultiSend"}}
+	_, err = srv.AuthorizeCircuitBreaker(ft.ctx, &types.MsgAuthorizeCircuitBreaker{Granter: authority, Grantee: addresses[1], Permissions: someMsgs})
+	require.NoError(t, err)
+
+	_, err = srv.AddFilterRule(ft.ctx, &types.MsgAddFilterRule{Authority: addresses[1], Rule: rule})
+	require.ErrorContains(t, err, "unauthorized")
+
+	// user with no permissions cannot add the rule
+	_, err = srv.AddFilterRule(ft.ctx, &types.MsgAddFilterRule{Authority: addresses[2], Rule: rule})
+	require.ErrorContains(t, err, "unauthorized")
+
+	// admin adds the rule
+	_, err = srv.AddFilterRule(ft.ctx, &types.MsgAddFilterRule{Authority: authority, Rule: rule})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		sdk.NewEvent(
+			"add_circuit_breaker_filter_rule",
+			sdk.NewAttribute("authority", authority),
+			sdk.NewAttribute("msg_url", sendURL),
+			sdk.NewAttribute("name", rule.Name),
+		),
+		lastEvent(ft.ctx),
+	)
+
+	stored, err := ft.keeper.FilterRules.Get(ft.ctx, collections.Join(sendURL, rule.Name))
+	require.NoError(t, err)
+	require.Equal(t, *rule, stored)
+
+	// the rule cannot be added twice
+	_, err = srv.AddFilterRule(ft.ctx, &types.MsgAddFilterRule{Authority: authority, Rule: rule})
+	require.ErrorContains(t, err, "already exists")
+
+	// user with permissions for other messages cannot remove the rule
+	remove := &types.MsgRemoveFilterRule{Authority: addresses[1], MsgTypeUrl: sendURL, Name: rule.Name}
+	_, err = srv.RemoveFilterRule(ft.ctx, remove)
+	require.ErrorContains(t, err, "unauthorized")
+
+	// admin removes the rule
+	remove.Authority = authority
+	_, err = srv.RemoveFilterRule(ft.ctx, remove)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		sdk.NewEvent(
+			"remove_circuit_breaker_filter_rule",
+			sdk.NewAttribute("authority", authority),
+			sdk.NewAttribute("msg_url", sendURL),
+			sdk.NewAttribute("name", rule.Name),
+		),
+		lastEvent(ft.ctx),
+	)
+
+	_, err = srv.RemoveFilterRule(ft.ctx, remove)
+	require.ErrorContains(t, err, "does not exist")
+}
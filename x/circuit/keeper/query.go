@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/circuit/types"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -73,3 +74,20 @@ func (qs QueryServer) DisabledList(ctx context.Context, req *types.QueryDisabled
 
 	return &types.DisabledListResponse{DisabledList: msgs}, nil
 }
+
+// FilterRules returns the circuit breaker filter rules.
+func (qs QueryServer) FilterRules(ctx context.Context, req *types.QueryFilterRulesRequest) (*types.FilterRulesResponse, error) {
+	results, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.keeper.FilterRules,
+		req.Pagination,
+		func(_ collections.Pair[string, string], value types.FilterRule) (*types.FilterRule, error) {
+			return &value, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.FilterRulesResponse{Rules: results, Pagination: pageRes}, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/circuit/keeper"
 	"cosmossdk.io/x/circuit/types"
 
@@ -98,3 +99,28 @@ func TestQueryDisabledList(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, []string{f.mockMsgURL}, disabledList.DisabledList)
 }
+
+func TestQueryFilterRules(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	// create a new query server
+	qs := keeper.NewQueryServer(f.keeper)
+
+	// test the FilterRules method
+	res, err := qs.FilterRules(f.ctx, &types.QueryFilterRulesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Rules, 0)
+
+	rule := types.FilterRule{
+		Name:       "rule",
+		MsgTypeUrl: f.mockMsgURL,
+		Predicates: []*types.FieldPredicate{{FieldPath: "field", Operator: types.FieldPredicate_OPERATOR_EQUAL, Value: "value"}},
+	}
+	require.NoError(t, f.keeper.FilterRules.Set(f.ctx, collections.Join(rule.MsgTypeUrl, rule.Name), rule))
+
+	// test the FilterRules method
+	res, err = qs.FilterRules(f.ctx, &types.QueryFilterRulesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*types.FilterRule{&rule}, res.Rules)
+}
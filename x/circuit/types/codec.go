@@ -12,6 +12,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgAuthorizeCircuitBreaker{},
 		&MsgResetCircuitBreaker{},
 		&MsgTripCircuitBreaker{},
+		&MsgAddFilterRule{},
+		&MsgRemoveFilterRule{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
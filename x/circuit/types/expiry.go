@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// NewBreakExpiry returns a BreakExpiry for the given bounds, or nil when
+// neither bound is set.
+func NewBreakExpiry(height int64, expiryTime *time.Time) *BreakExpiry {
+	if height == 0 && expiryTime == nil {
+		return nil
+	}
+
+	return &BreakExpiry{Height: height, Time: expiryTime}
+}
+
+// Validate checks that at least one valid bound is set.
+func (e BreakExpiry) Validate() error {
+	if e.Height < 0 {
+		return fmt.Errorf("invalid expiry height: %d", e.Height)
+	}
+	if e.Height == 0 && e.Time == nil {
+		return fmt.Errorf("expiry must set a height or a time")
+	}
+
+	return nil
+}
+
+// IsExpired returns true when the expiry height or time has been reached.
+func (e BreakExpiry) IsExpired(height int64, blockTime time.Time) bool {
+	if e.Height > 0 && height >= e.Height {
+		return true
+	}
+
+	return e.Time != nil && !blockTime.Before(*e.Time)
+}
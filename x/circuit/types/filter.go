@@ -0,0 +1,204 @@
+package types
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Validate performs a stateless validation of the filter rule.
+func (r FilterRule) Validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("filter rule name cannot be empty")
+	}
+	if !strings.HasPrefix(r.MsgTypeUrl, "/") {
+		return fmt.Errorf("invalid msg type url %q: must start with '/'", r.MsgTypeUrl)
+	}
+	if len(r.Predicates) == 0 {
+		return fmt.Errorf("filter rule %s must have at least one predicate", r.Name)
+	}
+
+	for _, p := range r.Predicates {
+		if p == nil {
+			return fmt.Errorf("filter rule %s has an empty predicate", r.Name)
+		}
+		if p.FieldPath == "" {
+			return fmt.Errorf("filter rule %s has a predicate with an empty field path", r.Name)
+		}
+		if _, ok := FieldPredicate_Operator_name[int32(p.Operator)]; !ok || p.Operator == FieldPredicate_OPERATOR_UNSPECIFIED {
+			return fmt.Errorf("filter rule %s has an invalid operator: %s", r.Name, p.Operator)
+		}
+	}
+
+	return nil
+}
+
+// ValidateDescriptor checks that every predicate field path of the filter rule
+// resolves to a scalar field of the given message descriptor.
+func (r FilterRule) ValidateDescriptor(md protoreflect.MessageDescriptor) error {
+	for _, p := range r.Predicates {
+		if _, err := resolveFieldPath(md, p.FieldPath); err != nil {
+			return fmt.Errorf("filter rule %s: %w", r.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// Matches returns true when the message matches all the predicates of the
+// filter rule. The caller is responsible for checking that the message type
+// URL is the one of the rule.
+func (r FilterRule) Matches(msg protoreflect.Message) (bool, error) {
+	for _, p := range r.Predicates {
+		matches, err := p.Matches(msg)
+		if err != nil {
+			return false, fmt.Errorf("filter rule %s: %w", r.Name, err)
+		}
+		if !matches {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// Matches returns true when the field value(s) of the message satisfy the
+// predicate.
+func (p FieldPredicate) Matches(msg protoreflect.Message) (bool, error) {
+	fields, err := resolveFieldPath(msg.Descriptor(), p.FieldPath)
+	if err != nil {
+		return false, err
+	}
+
+	values := collectFieldValues([]protoreflect.Message{msg}, fields)
+
+	switch p.Operator {
+	case FieldPredicate_OPERATOR_EQUAL:
+		return containsValue(values, p.Value), nil
+	case FieldPredicate_OPERATOR_NOT_EQUAL:
+		return !containsValue(values, p.Value), nil
+	case FieldPredicate_OPERATOR_CONTAINS:
+		for _, v := range values {
+			if strings.Contains(v, p.Value) {
+				return true, nil
+			}
+		}
+		return false, nil
+	default:
+		return false, fmt.Errorf("invalid operator: %s", p.Operator)
+	}
+}
+
+// resolveFieldPath returns the field descriptors of a dot-separated field path.
+// Every field but the last one must be a message field, the last one must be a
+// scalar field. Map fields are not supported.
+func resolveFieldPath(md protoreflect.MessageDescriptor, path string) ([]protoreflect.FieldDescriptor, error) {
+	names := strings.Split(path, ".")
+	fields := make([]protoreflect.FieldDescriptor, 0, len(names))
+
+	for i, name := range names {
+		if md == nil {
+			return nil, fmt.Errorf("invalid field path %s: %s is not a message field", path, names[i-1])
+		}
+
+		fd := md.Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			return nil, fmt.Errorf("invalid field path %s: unknown field %s in %s", path, name, md.FullName())
+		}
+		if fd.IsMap() {
+			return nil, fmt.Errorf("invalid field path %s: map field %s is not supported", path, name)
+		}
+
+		fields = append(fields, fd)
+		md = fd.Message()
+	}
+
+	if md != nil {
+		return nil, fmt.Errorf("invalid field path %s: must end with a scalar field", path)
+	}
+
+	return fields, nil
+}
+
+// collectFieldValues walks the resolved field path from the given messages and
+// returns the string formatted values of the leaf field. Unset message fields
+// along the path yield no values.
+func collectFieldValues(msgs []protoreflect.Message, fields []protoreflect.FieldDescriptor) []string {
+	fd := fields[0]
+
+	if len(fields) > 1 {
+		var next []protoreflect.Message
+		for _, msg := range msgs {
+			// unset message fields have no values
+			if !msg.Has(fd) {
+				continue
+			}
+
+			v := msg.Get(fd)
+			if fd.IsList() {
+				list := v.List()
+				for i := 0; i < list.Len(); i++ {
+					next = append(next, list.Get(i).Message())
+				}
+				continue
+			}
+			next = append(next, v.Message())
+		}
+
+		return collectFieldValues(next, fields[1:])
+	}
+
+	var values []string
+	for _, msg := range msgs {
+		v := msg.Get(fd)
+		if fd.IsList() {
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				values = append(values, formatValue(fd, list.Get(i)))
+			}
+			continue
+		}
+		values = append(values, formatValue(fd, v))
+	}
+
+	return values
+}
+
+// formatValue formats a scalar field value as documented in FieldPredicate.
+func formatValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return strconv.FormatBool(v.Bool())
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return strconv.FormatInt(int64(v.Enum()), 10)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return strconv.FormatInt(v.Int(), 10)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return strconv.FormatUint(v.Uint(), 10)
+	case protoreflect.FloatKind:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 32)
+	case protoreflect.DoubleKind:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64)
+	case protoreflect.BytesKind:
+		return base64.StdEncoding.EncodeToString(v.Bytes())
+	default:
+		return v.String()
+	}
+}
+
+func containsValue(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,182 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/x/circuit/types"
+)
+
+func TestFilterRuleValidate(t *testing.T) {
+	valid := types.FilterRule{
+		Name:       "block-denom",
+		MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend",
+		Predicates: []*types.FieldPredicate{
+			{FieldPath: "amount.denom", Operator: types.FieldPredicate_OPERATOR_EQUAL, Value: "evil"},
+		},
+	}
+	require.NoError(t, valid.Validate())
+
+	testCases := []struct {
+		name     string
+		malleate func(r *types.FilterRule)
+		expErr   string
+	}{
+		{"empty name", func(r *types.FilterRule) { r.Name = "" }, "name cannot be empty"},
+		{"type url without slash", func(r *types.FilterRule) { r.MsgTypeUrl = "cosmos.bank.v1beta1.MsgSend" }, "must start with '/'"},
+		{"no predicates", func(r *types.FilterRule) { r.Predicates = nil }, "at least one predicate"},
+		{"empty field path", func(r *types.FilterRule) { r.Predicates[0].FieldPath = "" }, "empty field path"},
+		{"unspecified operator", func(r *types.FilterRule) { r.Predicates[0].Operator = types.FieldPredicate_OPERATOR_UNSPECIFIED }, "invalid operator"},
+		{"unknown operator", func(r *types.FilterRule) { r.Predicates[0].Operator = 42 }, "invalid operator"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rule := valid
+			rule.Predicates = []*types.FieldPredicate{{
+				FieldPath: valid.Predicates[0].FieldPath,
+				Operator:  valid.Predicates[0].Operator,
+				Value:     valid.Predicates[0].Value,
+			}}
+			tc.malleate(&rule)
+			require.ErrorContains(t, rule.Validate(), tc.expErr)
+		})
+	}
+}
+
+func TestFilterRuleValidateDescriptor(t *testing.T) {
+	md := (&bankv1beta1.MsgSend{}).ProtoReflect().Descriptor()
+
+	testCases := []struct {
+		path   string
+		expErr string
+	}{
+		{"from_address", ""},
+		{"amount.denom", ""},
+		{"amount", "must end with a scalar field"},
+		{"from_address.denom", "from_address is not a message field"},
+		{"amount.unknown", "unknown field unknown"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			rule := types.FilterRule{
+				Name:       "rule",
+				Predicates: []*types.FieldPredicate{{FieldPath: tc.path, Operator: types.FieldPredicate_OPERATOR_EQUAL}},
+			}
+
+			err := rule.ValidateDescriptor(md)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestFilterRuleMatches(t *testing.T) {
+	send := &bankv1beta1.MsgSend{
+		FromAddress: "cosmos1zglwfu6xjzvzagqcmvzewyzjp9xwqw5qwrr8n9",
+		ToAddress:   "cosmos1p8s0p6gqc6c9gt77lgr2qqujz49huhu6a80smx",
+		Amount: []*basev1beta1.Coin{
+			{Denom: "stake", Amount: "10"},
+			{Denom: "ibc/evil", Amount: "1"},
+		},
+	}
+	multiSend := &bankv1beta1.MsgMultiSend{
+		Inputs: []*bankv1beta1.Input{
+			{Address: "cosmos1zglwfu6xjzvzagqcmvzewyzjp9xwqw5qwrr8n9", Coins: []*basev1beta1.Coin{{Denom: "stake"}}},
+		},
+	}
+	undelegate := &stakingv1beta1.MsgUndelegate{}
+
+	predicate := func(path string, op types.FieldPredicate_Operator, value string) *types.FieldPredicate {
+		return &types.FieldPredicate{FieldPath: path, Operator: op, Value: value}
+	}
+
+	testCases := []struct {
+		name       string
+		msg        protoreflect.ProtoMessage
+		predicates []*types.FieldPredicate
+		matches    bool
+		expErr     string
+	}{
+		{
+			name:       "equal scalar",
+			msg:        send,
+			predicates: []*types.FieldPredicate{predicate("to_address", types.FieldPredicate_OPERATOR_EQUAL, send.ToAddress)},
+			matches:    true,
+		},
+		{
+			name:       "equal any repeated element",
+			msg:        send,
+			predicates: []*types.FieldPredicate{predicate("amount.denom", types.FieldPredicate_OPERATOR_EQUAL, "ibc/evil")},
+			matches:    true,
+		},
+		{
+			name:       "not equal any repeated element",
+			msg:        send,
+			predicates: []*types.FieldPredicate{predicate("amount.denom", types.FieldPredicate_OPERATOR_NOT_EQUAL, "ibc/evil")},
+			matches:    false,
+		},
+		{
+			name:       "contains",
+			msg:        send,
+			predicates: []*types.FieldPredicate{predicate("amount.denom", types.FieldPredicate_OPERATOR_CONTAINS, "ibc/")},
+			matches:    true,
+		},
+		{
+			name:       "nested repeated fields",
+			msg:        multiSend,
+			predicates: []*types.FieldPredicate{predicate("inputs.coins.denom", types.FieldPredicate_OPERATOR_EQUAL, "stake")},
+			matches:    true,
+		},
+		{
+			name: "all predicates must match",
+			msg:  send,
+			predicates: []*types.FieldPredicate{
+				predicate("amount.denom", types.FieldPredicate_OPERATOR_EQUAL, "ibc/evil"),
+				predicate("from_address", types.FieldPredicate_OPERATOR_EQUAL, "cosmos1other"),
+			},
+			matches: false,
+		},
+		{
+			name:       "unset message field has no value",
+			msg:        undelegate,
+			predicates: []*types.FieldPredicate{predicate("amount.denom", types.FieldPredicate_OPERATOR_EQUAL, "")},
+			matches:    false,
+		},
+		{
+			name:       "unset message field is not equal",
+			msg:        undelegate,
+			predicates: []*types.FieldPredicate{predicate("amount.denom", types.FieldPredicate_OPERATOR_NOT_EQUAL, "stake")},
+			matches:    true,
+		},
+		{
+			name:       "invalid field path",
+			msg:        send,
+			predicates: []*types.FieldPredicate{predicate("unknown", types.FieldPredicate_OPERATOR_EQUAL, "")},
+			expErr:     "unknown field unknown",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rule := types.FilterRule{Name: "rule", Predicates: tc.predicates}
+
+			matches, err := rule.Matches(tc.msg.ProtoReflect())
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.matches, matches)
+		})
+	}
+}
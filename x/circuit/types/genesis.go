@@ -20,6 +20,39 @@ func (gs *GenesisState) Validate() error {
 		}
 	}
 
+	disabled := make(map[string]bool, len(gs.DisabledTypeUrls))
+	for _, url := range gs.DisabledTypeUrls {
+		disabled[url] = true
+	}
+
+	for _, expiry := range gs.BreakExpiries {
+		if !disabled[expiry.MsgTypeUrl] {
+			return fmt.Errorf("break expiry set for msg type url that is not disabled: %s", expiry.MsgTypeUrl)
+		}
+		if expiry.Expiry == nil {
+			return fmt.Errorf("empty break expiry for msg type url: %s", expiry.MsgTypeUrl)
+		}
+		if err := expiry.Expiry.Validate(); err != nil {
+			return fmt.Errorf("invalid break expiry for msg type url %s: %w", expiry.MsgTypeUrl, err)
+		}
+	}
+
+	rules := make(map[[2]string]bool, len(gs.FilterRules))
+	for _, rule := range gs.FilterRules {
+		if rule == nil {
+			return fmt.Errorf("empty filter rule")
+		}
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+
+		key := [2]string{rule.MsgTypeUrl, rule.Name}
+		if rules[key] {
+			return fmt.Errorf("duplicate filter rule %s for msg type url %s", rule.Name, rule.MsgTypeUrl)
+		}
+		rules[key] = true
+	}
+
 	return nil
 }
 
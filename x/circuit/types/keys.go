@@ -17,4 +17,6 @@ const (
 var (
 	AccountPermissionPrefix = collections.NewPrefix(1)
 	DisableListPrefix       = collections.NewPrefix(2)
+	BreakExpiriesPrefix     = collections.NewPrefix(3)
+	FilterRulesPrefix       = collections.NewPrefix(4)
 )
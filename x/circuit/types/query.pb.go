@@ -301,6 +301,106 @@ func (m *DisabledListResponse) GetDisabledList() []string {
 	return nil
 }
 
+// QueryFilterRulesRequest is the request type for the Query/FilterRules RPC method.
+type QueryFilterRulesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFilterRulesRequest) Reset()         { *m = QueryFilterRulesRequest{} }
+func (m *QueryFilterRulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFilterRulesRequest) ProtoMessage()    {}
+func (*QueryFilterRulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{6}
+}
+func (m *QueryFilterRulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFilterRulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFilterRulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFilterRulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFilterRulesRequest.Merge(m, src)
+}
+func (m *QueryFilterRulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFilterRulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFilterRulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFilterRulesRequest proto.InternalMessageInfo
+
+func (m *QueryFilterRulesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// FilterRulesResponse is the response type for the Query/FilterRules RPC method.
+type FilterRulesResponse struct {
+	Rules []*FilterRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *FilterRulesResponse) Reset()         { *m = FilterRulesResponse{} }
+func (m *FilterRulesResponse) String() string { return proto.CompactTextString(m) }
+func (*FilterRulesResponse) ProtoMessage()    {}
+func (*FilterRulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{7}
+}
+func (m *FilterRulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FilterRulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FilterRulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FilterRulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilterRulesResponse.Merge(m, src)
+}
+func (m *FilterRulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FilterRulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilterRulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilterRulesResponse proto.InternalMessageInfo
+
+func (m *FilterRulesResponse) GetRules() []*FilterRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+func (m *FilterRulesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountRequest)(nil), "cosmos.circuit.v1.QueryAccountRequest")
 	proto.RegisterType((*AccountResponse)(nil), "cosmos.circuit.v1.AccountResponse")
@@ -308,45 +408,51 @@ func init() {
 	proto.RegisterType((*AccountsResponse)(nil), "cosmos.circuit.v1.AccountsResponse")
 	proto.RegisterType((*QueryDisabledListRequest)(nil), "cosmos.circuit.v1.QueryDisabledListRequest")
 	proto.RegisterType((*DisabledListResponse)(nil), "cosmos.circuit.v1.DisabledListResponse")
+	proto.RegisterType((*QueryFilterRulesRequest)(nil), "cosmos.circuit.v1.QueryFilterRulesRequest")
+	proto.RegisterType((*FilterRulesResponse)(nil), "cosmos.circuit.v1.FilterRulesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/circuit/v1/query.proto", fileDescriptor_87c65073a3d3c1e1) }
 
 var fileDescriptor_87c65073a3d3c1e1 = []byte{
-	// 516 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xc1, 0x6b, 0x13, 0x4f,
-	0x14, 0xc7, 0x33, 0x2d, 0xbf, 0x5f, 0xdb, 0xd7, 0x8a, 0x3a, 0xf6, 0x10, 0xb6, 0xed, 0x5a, 0xb7,
-	0xd8, 0x84, 0x5a, 0x76, 0x48, 0x04, 0x2f, 0x82, 0xa0, 0x88, 0xf5, 0xe0, 0xa1, 0xdd, 0xa3, 0x07,
-	0x65, 0xb2, 0x3b, 0x84, 0xc1, 0x74, 0x67, 0xbb, 0x6f, 0x13, 0x2c, 0xe2, 0xa5, 0x27, 0xbd, 0x89,
-	0xfe, 0x0d, 0x1e, 0x05, 0xff, 0x0c, 0x8f, 0x05, 0x2f, 0x1e, 0x25, 0x11, 0xfc, 0x37, 0xa4, 0xb3,
-	0x33, 0x9b, 0x8d, 0x9d, 0xda, 0xe3, 0xcc, 0xbc, 0xef, 0xcb, 0xe7, 0xfb, 0xbe, 0x2f, 0x0b, 0x1b,
-	0xb1, 0xc2, 0x43, 0x85, 0x2c, 0x96, 0x79, 0x3c, 0x94, 0x05, 0x1b, 0x75, 0xd8, 0xd1, 0x50, 0xe4,
-	0xc7, 0x61, 0x96, 0xab, 0x42, 0xd1, 0xeb, 0xe5, 0x73, 0x68, 0x9e, 0xc3, 0x51, 0xc7, 0xdb, 0x31,
-	0x8a, 0x1e, 0x47, 0x51, 0xd6, 0xb2, 0x51, 0xa7, 0x27, 0x0a, 0xde, 0x61, 0x19, 0xef, 0xcb, 0x94,
-	0x17, 0x52, 0xa5, 0xa5, 0xdc, 0x73, 0x74, 0x2f, 0x8e, 0x33, 0x81, 0xe6, 0x79, 0xbd, 0xaf, 0x54,
-	0x7f, 0x20, 0x18, 0xcf, 0x24, 0xe3, 0x69, 0xaa, 0x0a, 0xad, 0xb5, 0xaf, 0x6b, 0x46, 0x6c, 0x7f,
-	0xa3, 0x0e, 0x16, 0x30, 0xb8, 0x71, 0x70, 0x76, 0x7c, 0x18, 0xc7, 0x6a, 0x98, 0x16, 0x91, 0x38,
-	0x1a, 0x0a, 0x2c, 0x68, 0x13, 0x16, 0x78, 0x92, 0xe4, 0x02, 0xb1, 0x49, 0x36, 0x49, 0x7b, 0x29,
-	0xb2, 0xc7, 0xe0, 0x00, 0xae, 0x56, 0xb5, 0x98, 0xa9, 0x14, 0x05, 0x7d, 0x00, 0x90, 0x89, 0xfc,
-	0x50, 0x22, 0x4a, 0x95, 0xea, 0xfa, 0xe5, 0xae, 0x1f, 0x9e, 0x73, 0x1c, 0xee, 0x57, 0x45, 0x18,
-	0xd5, 0x14, 0xc1, 0x0b, 0x58, 0xad, 0x33, 0xa0, 0x85, 0x78, 0x02, 0x30, 0x9d, 0x84, 0xe9, 0xbb,
-	0x6d, 0xfb, 0x9e, 0x8d, 0x2d, 0x2c, 0x9d, 0x98, 0xb1, 0x85, 0xfb, 0xbc, 0x2f, 0x8c, 0x36, 0xaa,
-	0x29, 0x83, 0xcf, 0x04, 0xae, 0x4d, 0x7b, 0x1b, 0xe8, 0xa7, 0xb0, 0xc8, 0xcd, 0x5d, 0x93, 0x6c,
-	0xce, 0xb7, 0x97, 0xbb, 0xbb, 0x0e, 0xe4, 0x3d, 0x91, 0x0a, 0x94, 0x68, 0xd4, 0x75, 0x03, 0x95,
-	0x9a, 0xee, 0xcd, 0x60, 0xce, 0x69, 0xcc, 0xd6, 0xa5, 0x98, 0x25, 0xc6, 0x0c, 0xa7, 0x07, 0x4d,
-	0x3d, 0x87, 0xc7, 0x12, 0x79, 0x6f, 0x20, 0x92, 0x67, 0x12, 0x6d, 0x20, 0xc1, 0x7d, 0x58, 0x9d,
-	0xbd, 0x36, 0x36, 0xb6, 0xe0, 0x4a, 0x62, 0xee, 0x5f, 0x0e, 0x24, 0x16, 0xda, 0xcb, 0x52, 0xb4,
-	0x92, 0xd4, 0x8a, 0xbb, 0x5f, 0xe6, 0xe1, 0x3f, 0xdd, 0x99, 0xbe, 0x27, 0xb0, 0x60, 0xcc, 0xd0,
-	0x6d, 0x87, 0x5f, 0xc7, 0x2e, 0x78, 0x81, 0xa3, 0xee, 0xaf, 0x15, 0x08, 0xba, 0xef, 0x7e, 0x7f,
-	0xdd, 0x21, 0x27, 0xdf, 0x7f, 0x7d, 0x9a, 0x6b, 0xd1, 0xdb, 0xec, 0xfc, 0xba, 0xda, 0x69, 0xb1,
-	0x37, 0x66, 0x91, 0xde, 0xd2, 0x13, 0x02, 0x8b, 0x36, 0x16, 0xda, 0xba, 0x04, 0xc6, 0x2e, 0x85,
-	0xb7, 0x75, 0x31, 0x4d, 0x15, 0x6e, 0xd0, 0x9e, 0xe2, 0x6c, 0xd0, 0xb5, 0x7f, 0xe0, 0xd0, 0x8f,
-	0x04, 0x56, 0xea, 0x83, 0xa5, 0x77, 0x2e, 0x02, 0x71, 0xa4, 0xe2, 0xb9, 0xa8, 0x5d, 0x31, 0x05,
-	0xbb, 0x53, 0xa0, 0x5b, 0xf4, 0xa6, 0x03, 0xc8, 0xe4, 0xa5, 0x33, 0x7c, 0x74, 0xef, 0xdb, 0xd8,
-	0x27, 0xa7, 0x63, 0x9f, 0xfc, 0x1c, 0xfb, 0xe4, 0xc3, 0xc4, 0x6f, 0x9c, 0x4e, 0xfc, 0xc6, 0x8f,
-	0x89, 0xdf, 0x78, 0xbe, 0x5e, 0x2a, 0x31, 0x79, 0x15, 0x4a, 0xc5, 0x5e, 0x57, 0x1d, 0xf4, 0xd7,
-	0xa0, 0xf7, 0xbf, 0xfe, 0x4f, 0xdf, 0xfd, 0x13, 0x00, 0x00, 0xff, 0xff, 0xd7, 0xdd, 0xdb, 0x03,
-	0x8d, 0x04, 0x00, 0x00,
+	// 581 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0xeb, 0xc1, 0xd8, 0xf6, 0x3a, 0x04, 0x78, 0x93, 0xa8, 0xb2, 0x35, 0x8c, 0x4c, 0xb4,
+	0x55, 0x99, 0x62, 0xb5, 0x93, 0xb8, 0x20, 0x21, 0x81, 0xd0, 0xc6, 0x81, 0xc3, 0x96, 0x23, 0x07,
+	0x26, 0xb7, 0x31, 0x95, 0x45, 0x17, 0x67, 0x71, 0x5a, 0x31, 0x21, 0x2e, 0x3b, 0xc1, 0x09, 0xc4,
+	0x3e, 0x03, 0x77, 0xbe, 0x01, 0x57, 0x8e, 0x93, 0xb8, 0x70, 0x44, 0x2d, 0x12, 0x5f, 0x03, 0xd5,
+	0x71, 0x9a, 0x94, 0xba, 0x1b, 0x87, 0x1d, 0x63, 0xbf, 0xff, 0xcb, 0xef, 0xfd, 0xfd, 0xb7, 0xa1,
+	0xdc, 0x16, 0xf2, 0x50, 0x48, 0xd2, 0xe6, 0x51, 0xbb, 0xc7, 0x63, 0xd2, 0x6f, 0x90, 0xa3, 0x1e,
+	0x8b, 0x8e, 0xdd, 0x30, 0x12, 0xb1, 0xc0, 0xb7, 0x92, 0x6d, 0x57, 0x6f, 0xbb, 0xfd, 0x86, 0x55,
+	0xd7, 0x8a, 0x16, 0x95, 0x2c, 0xa9, 0x25, 0xfd, 0x46, 0x8b, 0xc5, 0xb4, 0x41, 0x42, 0xda, 0xe1,
+	0x01, 0x8d, 0xb9, 0x08, 0x12, 0xb9, 0x65, 0xe8, 0x1e, 0x1f, 0x87, 0x4c, 0xea, 0xed, 0xf5, 0x8e,
+	0x10, 0x9d, 0x2e, 0x23, 0x34, 0xe4, 0x84, 0x06, 0x81, 0x88, 0x95, 0x36, 0xdd, 0x5d, 0xd3, 0xe2,
+	0xf4, 0x1f, 0x79, 0x30, 0x87, 0xc0, 0xca, 0xfe, 0xe8, 0xf3, 0x71, 0xbb, 0x2d, 0x7a, 0x41, 0xec,
+	0xb1, 0xa3, 0x1e, 0x93, 0x31, 0x2e, 0xc1, 0x02, 0xf5, 0xfd, 0x88, 0x49, 0x59, 0x42, 0x1b, 0xa8,
+	0xb6, 0xe4, 0xa5, 0x9f, 0xce, 0x3e, 0xdc, 0x18, 0xd7, 0xca, 0x50, 0x04, 0x92, 0xe1, 0x47, 0x00,
+	0x21, 0x8b, 0x0e, 0xb9, 0x94, 0x5c, 0x04, 0xaa, 0xbe, 0xd8, 0xb4, 0xdd, 0xa9, 0x89, 0xdd, 0xbd,
+	0x71, 0x91, 0xf4, 0x72, 0x0a, 0xe7, 0x25, 0xac, 0xe6, 0x19, 0x64, 0x0a, 0xb1, 0x03, 0x90, 0x39,
+	0xa1, 0xfb, 0x56, 0xd2, 0xbe, 0x23, 0xdb, 0xdc, 0x64, 0x12, 0x6d, 0x9b, 0xbb, 0x47, 0x3b, 0x4c,
+	0x6b, 0xbd, 0x9c, 0xd2, 0xf9, 0x82, 0xe0, 0x66, 0xd6, 0x5b, 0x43, 0x3f, 0x83, 0x45, 0xaa, 0xd7,
+	0x4a, 0x68, 0xe3, 0x4a, 0xad, 0xd8, 0xdc, 0x32, 0x20, 0xef, 0xb2, 0x80, 0x49, 0x2e, 0xb5, 0x3a,
+	0x3f, 0xc0, 0x58, 0x8d, 0x77, 0x27, 0x30, 0xe7, 0x14, 0x66, 0xf5, 0x42, 0xcc, 0x04, 0x63, 0x82,
+	0xd3, 0x82, 0x92, 0xf2, 0xe1, 0x29, 0x97, 0xb4, 0xd5, 0x65, 0xfe, 0x73, 0x2e, 0xd3, 0x03, 0x71,
+	0x1e, 0xc2, 0xea, 0xe4, 0xb2, 0x1e, 0x63, 0x13, 0xae, 0xfb, 0x7a, 0xfd, 0xa0, 0xcb, 0x65, 0xac,
+	0x66, 0x59, 0xf2, 0x96, 0xfd, 0x5c, 0xb1, 0x43, 0xe1, 0xb6, 0x6a, 0xbc, 0xc3, 0xbb, 0x31, 0x8b,
+	0xbc, 0x5e, 0x97, 0x5d, 0xba, 0xc7, 0xa7, 0x08, 0x56, 0x26, 0xda, 0x6b, 0xbe, 0x6d, 0x98, 0x8f,
+	0x46, 0x0b, 0xda, 0xe3, 0xb2, 0xc1, 0xe3, 0x4c, 0xe6, 0x25, 0xb5, 0x97, 0xe6, 0x68, 0xf3, 0xdb,
+	0x55, 0x98, 0x57, 0x93, 0xe3, 0x0f, 0x08, 0x16, 0xf4, 0x29, 0xe2, 0x8a, 0x01, 0xc2, 0x70, 0x09,
+	0x2c, 0xc7, 0x50, 0xf7, 0x4f, 0xf6, 0x9d, 0xe6, 0xfb, 0x3f, 0x5f, 0xeb, 0xe8, 0xe4, 0xc7, 0xef,
+	0xd3, 0xb9, 0x2a, 0xbe, 0x47, 0xa6, 0xef, 0x69, 0x1a, 0x13, 0xf2, 0x56, 0xdf, 0xa0, 0x77, 0xf8,
+	0x04, 0xc1, 0x62, 0x9a, 0x47, 0x5c, 0xbd, 0x00, 0x26, 0x3d, 0x29, 0x6b, 0x73, 0x36, 0xcd, 0xd8,
+	0x6e, 0xa7, 0x96, 0xe1, 0x94, 0xf1, 0xda, 0x39, 0x38, 0xf8, 0x33, 0x82, 0xe5, 0x7c, 0xa2, 0xf0,
+	0xfd, 0x59, 0x20, 0x86, 0x38, 0x5a, 0x26, 0x6a, 0x53, 0x3e, 0x9d, 0xad, 0x0c, 0xe8, 0x2e, 0xbe,
+	0x63, 0x00, 0xd2, 0x41, 0x55, 0xe1, 0xc5, 0x1f, 0x11, 0x14, 0x73, 0x29, 0xc2, 0xf5, 0x59, 0x4c,
+	0xd3, 0x49, 0xb6, 0x2a, 0xe7, 0x46, 0x4b, 0xfe, 0x3f, 0xd1, 0x2b, 0x25, 0x3a, 0x50, 0x51, 0x7c,
+	0xf2, 0xe0, 0xfb, 0xc0, 0x46, 0x67, 0x03, 0x1b, 0xfd, 0x1a, 0xd8, 0xe8, 0xd3, 0xd0, 0x2e, 0x9c,
+	0x0d, 0xed, 0xc2, 0xcf, 0xa1, 0x5d, 0x78, 0xb1, 0x9e, 0x28, 0xa5, 0xff, 0xda, 0xe5, 0x82, 0xbc,
+	0x19, 0x77, 0x50, 0x0f, 0x73, 0xeb, 0x9a, 0x7a, 0x5e, 0xb7, 0xff, 0x0e, 0x00, 0x92, 0x60, 0x46,
+	0x7b, 0x18, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -367,6 +473,8 @@ type QueryClient interface {
 	Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
 	// DisabledList returns a list of disabled message urls
 	DisabledList(ctx context.Context, in *QueryDisabledListRequest, opts ...grpc.CallOption) (*DisabledListResponse, error)
+	// FilterRules returns the circuit breaker filter rules.
+	FilterRules(ctx context.Context, in *QueryFilterRulesRequest, opts ...grpc.CallOption) (*FilterRulesResponse, error)
 }
 
 type queryClient struct {
@@ -404,6 +512,15 @@ func (c *queryClient) DisabledList(ctx context.Context, in *QueryDisabledListReq
 	return out, nil
 }
 
+func (c *queryClient) FilterRules(ctx context.Context, in *QueryFilterRulesRequest, opts ...grpc.CallOption) (*FilterRulesResponse, error) {
+	out := new(FilterRulesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.circuit.v1.Query/FilterRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Account returns account permissions.
@@ -412,6 +529,8 @@ type QueryServer interface {
 	Accounts(context.Context, *QueryAccountsRequest) (*AccountsResponse, error)
 	// DisabledList returns a list of disabled message urls
 	DisabledList(context.Context, *QueryDisabledListRequest) (*DisabledListResponse, error)
+	// FilterRules returns the circuit breaker filter rules.
+	FilterRules(context.Context, *QueryFilterRulesRequest) (*FilterRulesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -427,6 +546,9 @@ func (*UnimplementedQueryServer) Accounts(ctx context.Context, req *QueryAccount
 func (*UnimplementedQueryServer) DisabledList(ctx context.Context, req *QueryDisabledListRequest) (*DisabledListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisabledList not implemented")
 }
+func (*UnimplementedQueryServer) FilterRules(ctx context.Context, req *QueryFilterRulesRequest) (*FilterRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FilterRules not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -486,6 +608,24 @@ func _Query_DisabledList_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FilterRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFilterRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FilterRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.circuit.v1.Query/FilterRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FilterRules(ctx, req.(*QueryFilterRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.circuit.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -502,6 +642,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DisabledList",
 			Handler:    _Query_DisabledList_Handler,
 		},
+		{
+			MethodName: "FilterRules",
+			Handler:    _Query_FilterRules_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/circuit/v1/query.proto",
@@ -711,6 +855,90 @@ func (m *DisabledListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryFilterRulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFilterRulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFilterRulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FilterRulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FilterRulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FilterRulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -804,6 +1032,38 @@ func (m *DisabledListResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFilterRulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *FilterRulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1316,6 +1576,212 @@ func (m *DisabledListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFilterRulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFilterRulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFilterRulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FilterRulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FilterRulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FilterRulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, &FilterRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
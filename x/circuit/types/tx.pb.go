@@ -7,20 +7,25 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -150,6 +155,15 @@ type MsgTripCircuitBreaker struct {
 	// authority does not have permissions to trip the specified msg type URLs
 	// (or all URLs), the operation will fail.
 	MsgTypeUrls []string `protobuf:"bytes,2,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// expiry_height optionally sets the block height at which the circuit
+	// breaker is automatically reset. It must be greater than the current block
+	// height. Zero means the break has no height bound.
+	ExpiryHeight int64 `protobuf:"varint,3,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// expiry_time optionally sets the block time at which the circuit breaker is
+	// automatically reset. It must be after the current block time.
+	// If both expiry_height and expiry_time are left unset, the circuit breaker
+	// stays tripped until it is reset with ResetCircuitBreaker.
+	ExpiryTime *time.Time `protobuf:"bytes,4,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty"`
 }
 
 func (m *MsgTripCircuitBreaker) Reset()         { *m = MsgTripCircuitBreaker{} }
@@ -199,6 +213,20 @@ func (m *MsgTripCircuitBreaker) GetMsgTypeUrls() []string {
 	return nil
 }
 
+func (m *MsgTripCircuitBreaker) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *MsgTripCircuitBreaker) GetExpiryTime() *time.Time {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return nil
+}
+
 // MsgTripCircuitBreakerResponse defines the Msg/TripCircuitBreaker response type.
 type MsgTripCircuitBreakerResponse struct {
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -346,6 +374,201 @@ func (m *MsgResetCircuitBreakerResponse) GetSuccess() bool {
 	return false
 }
 
+// MsgAddFilterRule defines the Msg/AddFilterRule request type.
+type MsgAddFilterRule struct {
+	// authority is the account authorized to trip the circuit breaker for the
+	// rule's msg type URL.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// rule is the filter rule to add. It must not replace an existing rule.
+	Rule *FilterRule `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+}
+
+func (m *MsgAddFilterRule) Reset()         { *m = MsgAddFilterRule{} }
+func (m *MsgAddFilterRule) String() string { return proto.CompactTextString(m) }
+func (*MsgAddFilterRule) ProtoMessage()    {}
+func (*MsgAddFilterRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a02145e57a6fbb1d, []int{6}
+}
+func (m *MsgAddFilterRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddFilterRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddFilterRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddFilterRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddFilterRule.Merge(m, src)
+}
+func (m *MsgAddFilterRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddFilterRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddFilterRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddFilterRule proto.InternalMessageInfo
+
+func (m *MsgAddFilterRule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgAddFilterRule) GetRule() *FilterRule {
+	if m != nil {
+		return m.Rule
+	}
+	return nil
+}
+
+// MsgAddFilterRuleResponse defines the Msg/AddFilterRule response type.
+type MsgAddFilterRuleResponse struct {
+}
+
+func (m *MsgAddFilterRuleResponse) Reset()         { *m = MsgAddFilterRuleResponse{} }
+func (m *MsgAddFilterRuleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddFilterRuleResponse) ProtoMessage()    {}
+func (*MsgAddFilterRuleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a02145e57a6fbb1d, []int{7}
+}
+func (m *MsgAddFilterRuleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddFilterRuleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddFilterRuleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddFilterRuleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddFilterRuleResponse.Merge(m, src)
+}
+func (m *MsgAddFilterRuleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddFilterRuleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddFilterRuleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddFilterRuleResponse proto.InternalMessageInfo
+
+// MsgRemoveFilterRule defines the Msg/RemoveFilterRule request type.
+type MsgRemoveFilterRule struct {
+	// authority is the account authorized to reset the circuit breaker for the
+	// rule's msg type URL.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// msg_type_url is the msg type URL of the rule to remove.
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// name is the name of the rule to remove.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *MsgRemoveFilterRule) Reset()         { *m = MsgRemoveFilterRule{} }
+func (m *MsgRemoveFilterRule) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFilterRule) ProtoMessage()    {}
+func (*MsgRemoveFilterRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a02145e57a6fbb1d, []int{8}
+}
+func (m *MsgRemoveFilterRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFilterRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFilterRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveFilterRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFilterRule.Merge(m, src)
+}
+func (m *MsgRemoveFilterRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFilterRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFilterRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveFilterRule proto.InternalMessageInfo
+
+func (m *MsgRemoveFilterRule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveFilterRule) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgRemoveFilterRule) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// MsgRemoveFilterRuleResponse defines the Msg/RemoveFilterRule response type.
+type MsgRemoveFilterRuleResponse struct {
+}
+
+func (m *MsgRemoveFilterRuleResponse) Reset()         { *m = MsgRemoveFilterRuleResponse{} }
+func (m *MsgRemoveFilterRuleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFilterRuleResponse) ProtoMessage()    {}
+func (*MsgRemoveFilterRuleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a02145e57a6fbb1d, []int{9}
+}
+func (m *MsgRemoveFilterRuleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFilterRuleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFilterRuleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveFilterRuleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFilterRuleResponse.Merge(m, src)
+}
+func (m *MsgRemoveFilterRuleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFilterRuleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFilterRuleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveFilterRuleResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAuthorizeCircuitBreaker)(nil), "cosmos.circuit.v1.MsgAuthorizeCircuitBreaker")
 	proto.RegisterType((*MsgAuthorizeCircuitBreakerResponse)(nil), "cosmos.circuit.v1.MsgAuthorizeCircuitBreakerResponse")
@@ -353,39 +576,56 @@ func init() {
 	proto.RegisterType((*MsgTripCircuitBreakerResponse)(nil), "cosmos.circuit.v1.MsgTripCircuitBreakerResponse")
 	proto.RegisterType((*MsgResetCircuitBreaker)(nil), "cosmos.circuit.v1.MsgResetCircuitBreaker")
 	proto.RegisterType((*MsgResetCircuitBreakerResponse)(nil), "cosmos.circuit.v1.MsgResetCircuitBreakerResponse")
+	proto.RegisterType((*MsgAddFilterRule)(nil), "cosmos.circuit.v1.MsgAddFilterRule")
+	proto.RegisterType((*MsgAddFilterRuleResponse)(nil), "cosmos.circuit.v1.MsgAddFilterRuleResponse")
+	proto.RegisterType((*MsgRemoveFilterRule)(nil), "cosmos.circuit.v1.MsgRemoveFilterRule")
+	proto.RegisterType((*MsgRemoveFilterRuleResponse)(nil), "cosmos.circuit.v1.MsgRemoveFilterRuleResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/circuit/v1/tx.proto", fileDescriptor_a02145e57a6fbb1d) }
 
 var fileDescriptor_a02145e57a6fbb1d = []byte{
-	// 429 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xcf, 0xae, 0xd2, 0x40,
-	0x14, 0x87, 0x19, 0x1a, 0xff, 0x30, 0xa8, 0x89, 0x35, 0x7a, 0x9b, 0xc9, 0xbd, 0x13, 0xd2, 0x55,
-	0xbd, 0x89, 0xad, 0x60, 0x34, 0x91, 0x85, 0x51, 0x5c, 0x37, 0x31, 0x0d, 0x6e, 0xdc, 0x10, 0xac,
-	0x93, 0x71, 0x84, 0x32, 0xcd, 0x9c, 0x96, 0x80, 0x1b, 0x8d, 0x4f, 0xe0, 0x23, 0xf8, 0x08, 0x3c,
-	0x86, 0x4b, 0x96, 0x2e, 0x0d, 0x2c, 0x78, 0x01, 0x1f, 0xc0, 0x94, 0x52, 0x4a, 0x64, 0x08, 0x98,
-	0xbb, 0x1c, 0xce, 0x37, 0xe7, 0xf7, 0x0d, 0xa7, 0x07, 0x93, 0x50, 0x42, 0x24, 0xc1, 0x0b, 0x85,
-	0x0a, 0x53, 0x91, 0x78, 0xe3, 0xa6, 0x97, 0x4c, 0xdc, 0x58, 0xc9, 0x44, 0x9a, 0x77, 0xf3, 0x9a,
-	0xbb, 0xa9, 0xb9, 0xe3, 0x26, 0x39, 0xdb, 0xe0, 0x11, 0xf0, 0x0c, 0x8d, 0x80, 0xe7, 0x2c, 0xb9,
-	0xd0, 0xf4, 0x99, 0xc6, 0x0c, 0xf2, 0xb2, 0xfd, 0x03, 0x61, 0xe2, 0x03, 0x7f, 0x95, 0x26, 0x1f,
-	0xa5, 0x12, 0x9f, 0xd9, 0xeb, 0x1c, 0xeb, 0x28, 0xd6, 0x1f, 0x30, 0x65, 0x5a, 0xf8, 0x06, 0x57,
-	0xfd, 0x51, 0xc2, 0x94, 0x85, 0x1a, 0xc8, 0xa9, 0x05, 0xc5, 0xb1, 0xac, 0x30, 0xab, 0xba, 0x5b,
-	0x61, 0xe6, 0x4b, 0x5c, 0x8f, 0x99, 0x8a, 0x04, 0x80, 0x90, 0x23, 0xb0, 0x8c, 0x06, 0x72, 0xea,
-	0x2d, 0xea, 0xee, 0x39, 0xbb, 0x6f, 0x4a, 0x2a, 0xd8, 0xbd, 0xd2, 0xbe, 0xf5, 0x6d, 0x35, 0xbb,
-	0x2c, 0x92, 0xec, 0x17, 0xd8, 0x3e, 0x6c, 0x18, 0x30, 0x88, 0xe5, 0x08, 0x58, 0xe6, 0x03, 0x69,
-	0x18, 0x32, 0x80, 0xb5, 0xe9, 0xcd, 0xa0, 0x38, 0xda, 0x02, 0xdf, 0xf7, 0x81, 0x77, 0x95, 0x88,
-	0xff, 0x79, 0xdc, 0x39, 0xae, 0xf5, 0xf3, 0xae, 0xc9, 0x74, 0xf3, 0xbc, 0xf2, 0x07, 0xd3, 0xc6,
-	0xb7, 0x23, 0xe0, 0xbd, 0xec, 0xcf, 0xea, 0xa5, 0x6a, 0x08, 0x56, 0xb5, 0x61, 0x38, 0xb5, 0xa0,
-	0x1e, 0x01, 0xef, 0x4e, 0x63, 0xf6, 0x56, 0x0d, 0xa1, 0x7d, 0x27, 0x13, 0x2d, 0xef, 0xd8, 0xcf,
-	0xf1, 0x85, 0x36, 0xea, 0x04, 0xcb, 0x4f, 0xf8, 0x81, 0x0f, 0x3c, 0x60, 0xc0, 0x92, 0xab, 0x69,
-	0x1a, 0xc7, 0x35, 0xdb, 0x98, 0xea, 0xb3, 0x8e, 0x7b, 0xb6, 0xfe, 0x54, 0xb1, 0xe1, 0x03, 0x37,
-	0xbf, 0xe0, 0xb3, 0x43, 0x1f, 0xcd, 0x23, 0xcd, 0xac, 0x0f, 0x4f, 0x90, 0x3c, 0xfd, 0x2f, 0x7c,
-	0xab, 0x18, 0x63, 0x53, 0x33, 0x53, 0x47, 0xdf, 0x6c, 0x9f, 0x24, 0x8f, 0x4f, 0x25, 0xb7, 0x89,
-	0x80, 0xef, 0xe9, 0xe6, 0xf3, 0x50, 0xdf, 0x48, 0x83, 0x92, 0xe6, 0xc9, 0x68, 0x11, 0x4a, 0xae,
-	0x7d, 0x5d, 0xcd, 0x2e, 0x51, 0xe7, 0xd9, 0xcf, 0x05, 0x45, 0xf3, 0x05, 0x45, 0xbf, 0x17, 0x14,
-	0x7d, 0x5f, 0xd2, 0xca, 0x7c, 0x49, 0x2b, 0xbf, 0x96, 0xb4, 0xf2, 0xee, 0x3c, 0x6f, 0x09, 0x1f,
-	0x06, 0xae, 0x90, 0xde, 0x64, 0xbb, 0xe8, 0xeb, 0x2d, 0x7f, 0x7f, 0x7d, 0xbd, 0xe6, 0x4f, 0xfe,
-	0x06, 0x00, 0x00, 0xff, 0xff, 0x89, 0x07, 0x74, 0x02, 0x4f, 0x04, 0x00, 0x00,
+	// 636 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xae, 0x9b, 0xfc, 0x3f, 0x64, 0xd2, 0xa2, 0xe2, 0x02, 0xb5, 0x96, 0xc6, 0x8d, 0x5c, 0x09,
+	0x85, 0x22, 0x6c, 0x52, 0x04, 0x12, 0x3d, 0x20, 0x5a, 0x24, 0xc4, 0x25, 0x12, 0xb2, 0xca, 0x85,
+	0x4b, 0xe5, 0xa6, 0xcb, 0xd6, 0xad, 0x9d, 0xb5, 0x76, 0xec, 0xaa, 0xe1, 0x02, 0xe2, 0x09, 0xfa,
+	0x08, 0x3c, 0x42, 0xdf, 0x81, 0x0b, 0xc7, 0x72, 0xe3, 0x06, 0x6a, 0x0e, 0x7d, 0x0d, 0xe4, 0xb5,
+	0x1d, 0xa7, 0xb5, 0x23, 0x8c, 0xb8, 0xed, 0xce, 0x7c, 0x99, 0xef, 0x9b, 0x6f, 0x66, 0x63, 0x20,
+	0x7d, 0x8e, 0x3e, 0x47, 0xab, 0xef, 0x8a, 0x7e, 0xe4, 0x86, 0xd6, 0x51, 0xd7, 0x0a, 0x8f, 0xcd,
+	0x40, 0xf0, 0x90, 0xab, 0x37, 0x93, 0x9c, 0x99, 0xe6, 0xcc, 0xa3, 0x2e, 0x59, 0x4a, 0xe1, 0x3e,
+	0xb2, 0x18, 0xea, 0x23, 0x4b, 0xb0, 0xe4, 0x16, 0xe3, 0x8c, 0xcb, 0xa3, 0x15, 0x9f, 0xd2, 0xe8,
+	0x0a, 0xe3, 0x9c, 0x79, 0xd4, 0x92, 0xb7, 0xdd, 0xe8, 0xbd, 0x15, 0xba, 0x3e, 0xc5, 0xd0, 0xf1,
+	0x83, 0x14, 0xd0, 0x2a, 0xa1, 0x1f, 0x06, 0x14, 0x93, 0xb4, 0xf1, 0x45, 0x01, 0xd2, 0x43, 0xb6,
+	0x19, 0x85, 0xfb, 0x5c, 0xb8, 0x1f, 0xe8, 0xcb, 0x04, 0xb6, 0x25, 0xa8, 0x73, 0x48, 0x85, 0xaa,
+	0xc1, 0x35, 0x26, 0x9c, 0x41, 0x48, 0x85, 0xa6, 0xb4, 0x95, 0x4e, 0xc3, 0xce, 0xae, 0x79, 0x86,
+	0x6a, 0xb3, 0x93, 0x19, 0xaa, 0xbe, 0x80, 0x66, 0x40, 0x85, 0xef, 0x22, 0xba, 0x7c, 0x80, 0x5a,
+	0xad, 0xad, 0x74, 0x9a, 0xeb, 0xba, 0x59, 0x68, 0xd5, 0x7c, 0x93, 0xa3, 0xec, 0xc9, 0x9f, 0x6c,
+	0xcc, 0x7d, 0xbe, 0x38, 0x5d, 0xcb, 0x98, 0x8c, 0xe7, 0x60, 0x4c, 0x57, 0x68, 0x53, 0x0c, 0xf8,
+	0x00, 0x69, 0xac, 0x07, 0xa3, 0x7e, 0x9f, 0x22, 0x4a, 0xa5, 0xd7, 0xed, 0xec, 0x6a, 0x7c, 0x57,
+	0xe0, 0x76, 0x0f, 0xd9, 0xb6, 0x70, 0x83, 0x2b, 0xdd, 0x2d, 0x43, 0xc3, 0x49, 0xca, 0x86, 0xc3,
+	0xb4, 0xbf, 0x3c, 0xa0, 0x1a, 0x30, 0xef, 0x23, 0xdb, 0x89, 0xdd, 0xda, 0x89, 0x84, 0x87, 0xda,
+	0x6c, 0xbb, 0xd6, 0x69, 0xd8, 0x4d, 0x1f, 0xd9, 0xf6, 0x30, 0xa0, 0x6f, 0x85, 0x87, 0xea, 0x2a,
+	0xcc, 0xd3, 0xe3, 0xc0, 0x15, 0xc3, 0x9d, 0x7d, 0xea, 0xb2, 0xfd, 0x50, 0x76, 0x5b, 0xb3, 0xe7,
+	0x92, 0xe0, 0x6b, 0x19, 0x53, 0x37, 0xa1, 0x99, 0x82, 0xe2, 0xe1, 0x68, 0x75, 0x69, 0x08, 0x31,
+	0x93, 0xc9, 0x99, 0xd9, 0xe4, 0xcc, 0xed, 0x6c, 0x72, 0x5b, 0xf5, 0x93, 0x9f, 0x2b, 0x8a, 0x0d,
+	0xc9, 0x8f, 0xe2, 0xf0, 0xc6, 0x8d, 0xd8, 0x91, 0x5c, 0x9b, 0xf1, 0x0c, 0x5a, 0xa5, 0x2d, 0x55,
+	0xb0, 0xe3, 0x00, 0xee, 0xf4, 0x90, 0xd9, 0x14, 0x69, 0xf8, 0x6f, 0x76, 0xd4, 0x0a, 0x76, 0x14,
+	0x64, 0x6e, 0x80, 0x5e, 0xce, 0x55, 0x41, 0x27, 0xc2, 0x42, 0x3c, 0xf6, 0xbd, 0xbd, 0x57, 0xae,
+	0x17, 0x52, 0x61, 0x47, 0x1e, 0xfd, 0x83, 0xc2, 0x2e, 0xd4, 0x45, 0xe4, 0x25, 0xfb, 0xd8, 0x5c,
+	0x6f, 0x95, 0x6c, 0x5c, 0x5e, 0xca, 0x96, 0xd0, 0x82, 0x60, 0x02, 0xda, 0x55, 0xd2, 0x4c, 0xaa,
+	0x31, 0x84, 0x45, 0xd9, 0x8c, 0xcf, 0x8f, 0x68, 0x65, 0x4d, 0x6d, 0x98, 0x9b, 0x74, 0x2d, 0x7d,
+	0x2b, 0x90, 0x9b, 0xa6, 0xaa, 0x50, 0x1f, 0x38, 0x3e, 0x95, 0x9b, 0xd3, 0xb0, 0xe5, 0xb9, 0x20,
+	0xab, 0x05, 0x77, 0x4b, 0xa8, 0x33, 0x65, 0xeb, 0x5f, 0xeb, 0x50, 0xeb, 0x21, 0x53, 0x3f, 0xc2,
+	0xd2, 0xb4, 0x87, 0xfc, 0xb0, 0xc4, 0x8d, 0xe9, 0xaf, 0x8a, 0x3c, 0xf9, 0x2b, 0xf8, 0x78, 0x9a,
+	0x01, 0xa8, 0x25, 0xcf, 0xac, 0x53, 0x5e, 0xac, 0x88, 0x24, 0x8f, 0xaa, 0x22, 0xc7, 0x8c, 0x08,
+	0x8b, 0x65, 0xab, 0x7c, 0xbf, 0xbc, 0x50, 0x09, 0x94, 0x74, 0x2b, 0x43, 0xc7, 0xa4, 0x0e, 0xcc,
+	0x5f, 0xde, 0xcb, 0xd5, 0x29, 0x76, 0x4d, 0x82, 0xc8, 0x83, 0x0a, 0xa0, 0x31, 0xc5, 0x01, 0x2c,
+	0x14, 0x36, 0xed, 0xde, 0x34, 0xa5, 0x97, 0x71, 0xc4, 0xac, 0x86, 0xcb, 0xb8, 0xc8, 0x7f, 0x9f,
+	0x2e, 0x4e, 0xd7, 0x94, 0xad, 0xa7, 0xdf, 0xce, 0x75, 0xe5, 0xec, 0x5c, 0x57, 0x7e, 0x9d, 0xeb,
+	0xca, 0xc9, 0x48, 0x9f, 0x39, 0x1b, 0xe9, 0x33, 0x3f, 0x46, 0xfa, 0xcc, 0xbb, 0xe5, 0xa4, 0x1e,
+	0xee, 0x1d, 0x9a, 0x2e, 0xb7, 0x8e, 0xc7, 0xdf, 0x12, 0xf9, 0x21, 0xd9, 0xfd, 0x5f, 0xfe, 0x83,
+	0x3d, 0xfe, 0x3d, 0x00, 0x12, 0x25, 0xd3, 0xf6, 0xe9, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -408,6 +648,12 @@ type MsgClient interface {
 	// ResetCircuitBreaker resumes processing of Msg's in the state machine that
 	// have been been paused using TripCircuitBreaker.
 	ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error)
+	// AddFilterRule pauses processing of the Msg's of a type URL whose fields
+	// match the rule's predicates.
+	AddFilterRule(ctx context.Context, in *MsgAddFilterRule, opts ...grpc.CallOption) (*MsgAddFilterRuleResponse, error)
+	// RemoveFilterRule resumes processing of the Msg's that have been paused
+	// using AddFilterRule.
+	RemoveFilterRule(ctx context.Context, in *MsgRemoveFilterRule, opts ...grpc.CallOption) (*MsgRemoveFilterRuleResponse, error)
 }
 
 type msgClient struct {
@@ -445,6 +691,24 @@ func (c *msgClient) ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuit
 	return out, nil
 }
 
+func (c *msgClient) AddFilterRule(ctx context.Context, in *MsgAddFilterRule, opts ...grpc.CallOption) (*MsgAddFilterRuleResponse, error) {
+	out := new(MsgAddFilterRuleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.circuit.v1.Msg/AddFilterRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFilterRule(ctx context.Context, in *MsgRemoveFilterRule, opts ...grpc.CallOption) (*MsgRemoveFilterRuleResponse, error) {
+	out := new(MsgRemoveFilterRuleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.circuit.v1.Msg/RemoveFilterRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AuthorizeCircuitBreaker allows a super-admin to grant (or revoke) another
@@ -455,6 +719,12 @@ type MsgServer interface {
 	// ResetCircuitBreaker resumes processing of Msg's in the state machine that
 	// have been been paused using TripCircuitBreaker.
 	ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error)
+	// AddFilterRule pauses processing of the Msg's of a type URL whose fields
+	// match the rule's predicates.
+	AddFilterRule(context.Context, *MsgAddFilterRule) (*MsgAddFilterRuleResponse, error)
+	// RemoveFilterRule resumes processing of the Msg's that have been paused
+	// using AddFilterRule.
+	RemoveFilterRule(context.Context, *MsgRemoveFilterRule) (*MsgRemoveFilterRuleResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -470,6 +740,12 @@ func (*UnimplementedMsgServer) TripCircuitBreaker(ctx context.Context, req *MsgT
 func (*UnimplementedMsgServer) ResetCircuitBreaker(ctx context.Context, req *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetCircuitBreaker not implemented")
 }
+func (*UnimplementedMsgServer) AddFilterRule(ctx context.Context, req *MsgAddFilterRule) (*MsgAddFilterRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFilterRule not implemented")
+}
+func (*UnimplementedMsgServer) RemoveFilterRule(ctx context.Context, req *MsgRemoveFilterRule) (*MsgRemoveFilterRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFilterRule not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -529,6 +805,42 @@ func _Msg_ResetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddFilterRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddFilterRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddFilterRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.circuit.v1.Msg/AddFilterRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddFilterRule(ctx, req.(*MsgAddFilterRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFilterRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFilterRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFilterRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.circuit.v1.Msg/RemoveFilterRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFilterRule(ctx, req.(*MsgRemoveFilterRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.circuit.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -545,6 +857,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ResetCircuitBreaker",
 			Handler:    _Msg_ResetCircuitBreaker_Handler,
 		},
+		{
+			MethodName: "AddFilterRule",
+			Handler:    _Msg_AddFilterRule_Handler,
+		},
+		{
+			MethodName: "RemoveFilterRule",
+			Handler:    _Msg_RemoveFilterRule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/circuit/v1/tx.proto",
@@ -652,6 +972,21 @@ func (m *MsgTripCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiryTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTx(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.MsgTypeUrls) > 0 {
 		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.MsgTypeUrls[iNdEx])
@@ -776,56 +1111,188 @@ func (m *MsgResetCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgAddFilterRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgAuthorizeCircuitBreaker) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgAddFilterRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddFilterRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Rule != nil {
+		{
+			size, err := m.Rule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Permissions != nil {
-		l = m.Permissions.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAuthorizeCircuitBreakerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddFilterRuleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddFilterRuleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddFilterRuleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Success {
-		n += 2
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgTripCircuitBreaker) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveFilterRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFilterRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFilterRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFilterRuleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFilterRuleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFilterRuleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAuthorizeCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Permissions != nil {
+		l = m.Permissions.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAuthorizeCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgTripCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.Authority)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
@@ -836,6 +1303,13 @@ func (m *MsgTripCircuitBreaker) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -882,6 +1356,62 @@ func (m *MsgResetCircuitBreakerResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAddFilterRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Rule != nil {
+		l = m.Rule.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddFilterRuleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveFilterRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveFilterRuleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1201,6 +1731,61 @@ func (m *MsgTripCircuitBreaker) Unmarshal(dAtA []byte) error {
 			}
 			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1476,6 +2061,370 @@ func (m *MsgResetCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgAddFilterRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddFilterRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddFilterRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rule == nil {
+				m.Rule = &FilterRule{}
+			}
+			if err := m.Rule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddFilterRuleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddFilterRuleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddFilterRuleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveFilterRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveFilterRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveFilterRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveFilterRuleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveFilterRuleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveFilterRuleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
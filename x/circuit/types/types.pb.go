@@ -5,16 +5,21 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -64,6 +69,43 @@ func (Permissions_Level) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_1f5fe523f8a09dbc, []int{0, 0}
 }
 
+// Operator is a field comparison operator.
+type FieldPredicate_Operator int32
+
+const (
+	// OPERATOR_UNSPECIFIED is an invalid operator.
+	FieldPredicate_OPERATOR_UNSPECIFIED FieldPredicate_Operator = 0
+	// OPERATOR_EQUAL matches when a field value equals value.
+	FieldPredicate_OPERATOR_EQUAL FieldPredicate_Operator = 1
+	// OPERATOR_NOT_EQUAL matches when no field value equals value.
+	FieldPredicate_OPERATOR_NOT_EQUAL FieldPredicate_Operator = 2
+	// OPERATOR_CONTAINS matches when a field value contains value as a
+	// substring.
+	FieldPredicate_OPERATOR_CONTAINS FieldPredicate_Operator = 3
+)
+
+var FieldPredicate_Operator_name = map[int32]string{
+	0: "OPERATOR_UNSPECIFIED",
+	1: "OPERATOR_EQUAL",
+	2: "OPERATOR_NOT_EQUAL",
+	3: "OPERATOR_CONTAINS",
+}
+
+var FieldPredicate_Operator_value = map[string]int32{
+	"OPERATOR_UNSPECIFIED": 0,
+	"OPERATOR_EQUAL":       1,
+	"OPERATOR_NOT_EQUAL":   2,
+	"OPERATOR_CONTAINS":    3,
+}
+
+func (x FieldPredicate_Operator) String() string {
+	return proto.EnumName(FieldPredicate_Operator_name, int32(x))
+}
+
+func (FieldPredicate_Operator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1f5fe523f8a09dbc, []int{6, 0}
+}
+
 // Permissions are the permissions that an account has to trip
 // or reset the circuit breaker.
 type Permissions struct {
@@ -179,6 +221,8 @@ func (m *GenesisAccountPermissions) GetPermissions() *Permissions {
 type GenesisState struct {
 	AccountPermissions []*GenesisAccountPermissions `protobuf:"bytes,1,rep,name=account_permissions,json=accountPermissions,proto3" json:"account_permissions,omitempty"`
 	DisabledTypeUrls   []string                     `protobuf:"bytes,2,rep,name=disabled_type_urls,json=disabledTypeUrls,proto3" json:"disabled_type_urls,omitempty"`
+	BreakExpiries      []*GenesisBreakExpiry        `protobuf:"bytes,3,rep,name=break_expiries,json=breakExpiries,proto3" json:"break_expiries,omitempty"`
+	FilterRules        []*FilterRule                `protobuf:"bytes,4,rep,name=filter_rules,json=filterRules,proto3" json:"filter_rules,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -228,42 +272,329 @@ func (m *GenesisState) GetDisabledTypeUrls() []string {
 	return nil
 }
 
+func (m *GenesisState) GetBreakExpiries() []*GenesisBreakExpiry {
+	if m != nil {
+		return m.BreakExpiries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFilterRules() []*FilterRule {
+	if m != nil {
+		return m.FilterRules
+	}
+	return nil
+}
+
+// BreakExpiry defines when a tripped circuit breaker is automatically reset.
+// The break expires as soon as either of the set bounds is reached.
+type BreakExpiry struct {
+	// height is the block height at which the break expires. Zero means no
+	// height bound.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the break expires. Nil means no time
+	// bound.
+	Time *time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+}
+
+func (m *BreakExpiry) Reset()         { *m = BreakExpiry{} }
+func (m *BreakExpiry) String() string { return proto.CompactTextString(m) }
+func (*BreakExpiry) ProtoMessage()    {}
+func (*BreakExpiry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5fe523f8a09dbc, []int{3}
+}
+func (m *BreakExpiry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BreakExpiry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BreakExpiry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BreakExpiry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BreakExpiry.Merge(m, src)
+}
+func (m *BreakExpiry) XXX_Size() int {
+	return m.Size()
+}
+func (m *BreakExpiry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BreakExpiry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BreakExpiry proto.InternalMessageInfo
+
+func (m *BreakExpiry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BreakExpiry) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+// GenesisBreakExpiry is the expiry of a disabled msg type URL in genesis.
+type GenesisBreakExpiry struct {
+	MsgTypeUrl string       `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	Expiry     *BreakExpiry `protobuf:"bytes,2,opt,name=expiry,proto3" json:"expiry,omitempty"`
+}
+
+func (m *GenesisBreakExpiry) Reset()         { *m = GenesisBreakExpiry{} }
+func (m *GenesisBreakExpiry) String() string { return proto.CompactTextString(m) }
+func (*GenesisBreakExpiry) ProtoMessage()    {}
+func (*GenesisBreakExpiry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5fe523f8a09dbc, []int{4}
+}
+func (m *GenesisBreakExpiry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisBreakExpiry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisBreakExpiry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisBreakExpiry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisBreakExpiry.Merge(m, src)
+}
+func (m *GenesisBreakExpiry) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisBreakExpiry) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisBreakExpiry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisBreakExpiry proto.InternalMessageInfo
+
+func (m *GenesisBreakExpiry) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *GenesisBreakExpiry) GetExpiry() *BreakExpiry {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
+// FilterRule disables the execution of the Msg's of a given type URL whose
+// fields match all of the rule's predicates.
+type FilterRule struct {
+	// name identifies the rule among the rules of the same msg type URL.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// msg_type_url is the type URL of the Msg's the rule applies to, e.g.
+	// "/cosmos.bank.v1beta1.MsgSend".
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// predicates are the conditions a Msg must all match to be disabled. A rule
+	// must have at least one predicate; use TripCircuitBreaker to disable every
+	// Msg of a type URL.
+	Predicates []*FieldPredicate `protobuf:"bytes,3,rep,name=predicates,proto3" json:"predicates,omitempty"`
+}
+
+func (m *FilterRule) Reset()         { *m = FilterRule{} }
+func (m *FilterRule) String() string { return proto.CompactTextString(m) }
+func (*FilterRule) ProtoMessage()    {}
+func (*FilterRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5fe523f8a09dbc, []int{5}
+}
+func (m *FilterRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FilterRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FilterRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FilterRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilterRule.Merge(m, src)
+}
+func (m *FilterRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *FilterRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilterRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilterRule proto.InternalMessageInfo
+
+func (m *FilterRule) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FilterRule) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *FilterRule) GetPredicates() []*FieldPredicate {
+	if m != nil {
+		return m.Predicates
+	}
+	return nil
+}
+
+// FieldPredicate is a condition on the value of a Msg field.
+type FieldPredicate struct {
+	// field_path is the dot-separated path of proto field names leading from
+	// the Msg to a scalar field, e.g. "amount.denom". When the path goes through
+	// a repeated field, the predicate is evaluated against every element. Unset
+	// message fields along the path have no value.
+	FieldPath string `protobuf:"bytes,1,opt,name=field_path,json=fieldPath,proto3" json:"field_path,omitempty"`
+	// operator is the comparison applied to the field value.
+	Operator FieldPredicate_Operator `protobuf:"varint,2,opt,name=operator,proto3,enum=cosmos.circuit.v1.FieldPredicate_Operator" json:"operator,omitempty"`
+	// value is the value the field is compared to. Integers are formatted in
+	// base 10, booleans as "true" or "false", enums by value name and bytes as
+	// standard base64.
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *FieldPredicate) Reset()         { *m = FieldPredicate{} }
+func (m *FieldPredicate) String() string { return proto.CompactTextString(m) }
+func (*FieldPredicate) ProtoMessage()    {}
+func (*FieldPredicate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5fe523f8a09dbc, []int{6}
+}
+func (m *FieldPredicate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FieldPredicate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FieldPredicate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FieldPredicate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldPredicate.Merge(m, src)
+}
+func (m *FieldPredicate) XXX_Size() int {
+	return m.Size()
+}
+func (m *FieldPredicate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldPredicate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldPredicate proto.InternalMessageInfo
+
+func (m *FieldPredicate) GetFieldPath() string {
+	if m != nil {
+		return m.FieldPath
+	}
+	return ""
+}
+
+func (m *FieldPredicate) GetOperator() FieldPredicate_Operator {
+	if m != nil {
+		return m.Operator
+	}
+	return FieldPredicate_OPERATOR_UNSPECIFIED
+}
+
+func (m *FieldPredicate) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.circuit.v1.Permissions_Level", Permissions_Level_name, Permissions_Level_value)
+	proto.RegisterEnum("cosmos.circuit.v1.FieldPredicate_Operator", FieldPredicate_Operator_name, FieldPredicate_Operator_value)
 	proto.RegisterType((*Permissions)(nil), "cosmos.circuit.v1.Permissions")
 	proto.RegisterType((*GenesisAccountPermissions)(nil), "cosmos.circuit.v1.GenesisAccountPermissions")
 	proto.RegisterType((*GenesisState)(nil), "cosmos.circuit.v1.GenesisState")
+	proto.RegisterType((*BreakExpiry)(nil), "cosmos.circuit.v1.BreakExpiry")
+	proto.RegisterType((*GenesisBreakExpiry)(nil), "cosmos.circuit.v1.GenesisBreakExpiry")
+	proto.RegisterType((*FilterRule)(nil), "cosmos.circuit.v1.FilterRule")
+	proto.RegisterType((*FieldPredicate)(nil), "cosmos.circuit.v1.FieldPredicate")
 }
 
 func init() { proto.RegisterFile("cosmos/circuit/v1/types.proto", fileDescriptor_1f5fe523f8a09dbc) }
 
 var fileDescriptor_1f5fe523f8a09dbc = []byte{
-	// 388 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x41, 0x4f, 0xea, 0x40,
-	0x18, 0xec, 0x42, 0x78, 0x2f, 0x6c, 0xdf, 0x83, 0xb2, 0x44, 0x53, 0x8d, 0x36, 0x4d, 0x63, 0x4c,
-	0x0f, 0xa4, 0x0d, 0x98, 0x78, 0xf0, 0x24, 0x4a, 0x25, 0x24, 0xa5, 0x90, 0x56, 0x3c, 0x98, 0x98,
-	0xa6, 0xb4, 0x7b, 0xd8, 0x58, 0x68, 0xd3, 0x2d, 0x28, 0xff, 0xc2, 0xbb, 0x7f, 0xc8, 0x23, 0x27,
-	0xe3, 0xd1, 0xc0, 0x1f, 0x31, 0xb4, 0xa0, 0x4d, 0x50, 0x8f, 0xfb, 0xcd, 0xcc, 0x7e, 0xdf, 0x4c,
-	0x06, 0x1e, 0xba, 0x01, 0x1d, 0x05, 0x54, 0x75, 0x49, 0xe4, 0x4e, 0x48, 0xac, 0x4e, 0xeb, 0x6a,
-	0x3c, 0x0b, 0x31, 0x55, 0xc2, 0x28, 0x88, 0x03, 0x54, 0x49, 0x61, 0x65, 0x0d, 0x2b, 0xd3, 0xba,
-	0xf4, 0x0a, 0x20, 0xdb, 0xc7, 0xd1, 0x88, 0x50, 0x4a, 0x82, 0x31, 0x45, 0x67, 0xb0, 0xe0, 0xe3,
-	0x29, 0xf6, 0x79, 0x20, 0x02, 0xb9, 0xd4, 0x38, 0x52, 0xb6, 0x24, 0x4a, 0x86, 0xae, 0xe8, 0x2b,
-	0xae, 0x99, 0x4a, 0xd0, 0x31, 0x2c, 0xfb, 0x64, 0x44, 0x62, 0x7b, 0xb5, 0xd3, 0x9e, 0x44, 0x3e,
-	0xe5, 0x73, 0x62, 0x5e, 0x2e, 0x9a, 0xff, 0x93, 0xf1, 0xf5, 0x2c, 0xc4, 0x83, 0xc8, 0xa7, 0x92,
-	0x0b, 0x0b, 0x89, 0x0e, 0xed, 0xc3, 0x5d, 0x5d, 0xbb, 0xd1, 0x74, 0xdb, 0xe8, 0x19, 0x9a, 0x3d,
-	0x30, 0xac, 0xbe, 0x76, 0xd9, 0xb9, 0xea, 0x68, 0x2d, 0x8e, 0x41, 0x55, 0x58, 0x4e, 0x31, 0xab,
-	0xd7, 0xd5, 0xec, 0xae, 0xd5, 0xb6, 0x38, 0x80, 0x10, 0x2c, 0xa5, 0xc3, 0xa6, 0xae, 0xa7, 0xb3,
-	0x1c, 0xda, 0x81, 0x95, 0x35, 0x71, 0xd0, 0xd7, 0x4c, 0xbb, 0xd9, 0xea, 0x76, 0x0c, 0x2e, 0x2f,
-	0x3d, 0xc0, 0xbd, 0x36, 0x1e, 0x63, 0x4a, 0x68, 0xd3, 0x75, 0x83, 0xc9, 0x38, 0xce, 0xba, 0xe4,
-	0xe1, 0x5f, 0xc7, 0xf3, 0x22, 0x4c, 0x69, 0xe2, 0xb3, 0x68, 0x6e, 0x9e, 0xe8, 0x1c, 0xb2, 0xe1,
-	0x17, 0x91, 0xcf, 0x89, 0x40, 0x66, 0x1b, 0xc2, 0xef, 0x29, 0x98, 0x59, 0x89, 0xf4, 0x0c, 0xe0,
-	0xbf, 0xf5, 0x66, 0x2b, 0x76, 0x62, 0x8c, 0xee, 0x60, 0xd5, 0x49, 0x4f, 0xb0, 0xb3, 0x5f, 0x03,
-	0x31, 0x2f, 0xb3, 0x8d, 0xda, 0x37, 0x5f, 0xff, 0x78, 0xb7, 0x89, 0x9c, 0x6d, 0x2f, 0x35, 0x88,
-	0x3c, 0x42, 0x9d, 0xa1, 0x8f, 0xbd, 0xad, 0xe0, 0xb9, 0x0d, 0xb2, 0xc9, 0xfe, 0xe2, 0xf4, 0x65,
-	0x21, 0x80, 0xf9, 0x42, 0x00, 0xef, 0x0b, 0x01, 0x3c, 0x2d, 0x05, 0x66, 0xbe, 0x14, 0x98, 0xb7,
-	0xa5, 0xc0, 0xdc, 0x1e, 0xa4, 0x87, 0x50, 0xef, 0x5e, 0x21, 0x81, 0xfa, 0xf8, 0xd9, 0xa1, 0xa4,
-	0x40, 0xc3, 0x3f, 0x49, 0x83, 0x4e, 0x3e, 0x02, 0x00, 0x00, 0xff, 0xff, 0x3a, 0xff, 0xdc, 0xa4,
-	0x62, 0x02, 0x00, 0x00,
+	// 716 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcd, 0x6e, 0xda, 0x4a,
+	0x14, 0xc6, 0x40, 0x72, 0x93, 0x43, 0x42, 0xc8, 0x24, 0x37, 0xf2, 0x45, 0x37, 0x84, 0x6b, 0xdd,
+	0x56, 0xa8, 0x8a, 0x8c, 0x42, 0xab, 0x2c, 0xba, 0x2a, 0x49, 0x9c, 0x08, 0x89, 0x00, 0x1d, 0xa0,
+	0x8b, 0x56, 0x95, 0x65, 0x60, 0x30, 0xa3, 0xd8, 0xd8, 0xf2, 0x0c, 0x34, 0x3c, 0x40, 0xf7, 0x79,
+	0x8f, 0xbe, 0x48, 0x97, 0x59, 0x55, 0xdd, 0xb5, 0x4a, 0xde, 0xa1, 0xeb, 0xca, 0x63, 0xe3, 0xb8,
+	0x25, 0x49, 0x77, 0x73, 0xbe, 0xf3, 0x9d, 0xbf, 0xef, 0x1c, 0x1b, 0x76, 0xfb, 0x0e, 0xb3, 0x1d,
+	0x56, 0xee, 0x53, 0xaf, 0x3f, 0xa1, 0xbc, 0x3c, 0x3d, 0x28, 0xf3, 0x99, 0x4b, 0x98, 0xea, 0x7a,
+	0x0e, 0x77, 0xd0, 0x66, 0xe0, 0x56, 0x43, 0xb7, 0x3a, 0x3d, 0xc8, 0x6f, 0x9b, 0x8e, 0xe9, 0x08,
+	0x6f, 0xd9, 0x7f, 0x05, 0xc4, 0xfc, 0x9e, 0xe9, 0x38, 0xa6, 0x45, 0xca, 0xc2, 0xea, 0x4d, 0x86,
+	0x65, 0x4e, 0x6d, 0xc2, 0xb8, 0x61, 0xbb, 0x01, 0x41, 0xf9, 0x22, 0x41, 0xa6, 0x45, 0x3c, 0x9b,
+	0x32, 0x46, 0x9d, 0x31, 0x43, 0x2f, 0x61, 0xc9, 0x22, 0x53, 0x62, 0xc9, 0x52, 0x51, 0x2a, 0x65,
+	0x2b, 0xff, 0xab, 0x0b, 0x95, 0xd4, 0x18, 0x5d, 0xad, 0xfb, 0x5c, 0x1c, 0x84, 0xa0, 0xa7, 0xb0,
+	0x61, 0x51, 0x9b, 0x72, 0xdd, 0x6f, 0x55, 0x9f, 0x78, 0x16, 0x93, 0x93, 0xc5, 0x54, 0x69, 0x15,
+	0xaf, 0x0b, 0xb8, 0x33, 0x73, 0x49, 0xd7, 0xb3, 0x98, 0xd2, 0x87, 0x25, 0x11, 0x87, 0xf2, 0xb0,
+	0x53, 0xd7, 0xde, 0x68, 0x75, 0xbd, 0xd1, 0x6c, 0x68, 0x7a, 0xb7, 0xd1, 0x6e, 0x69, 0xc7, 0xb5,
+	0xd3, 0x9a, 0x76, 0x92, 0x4b, 0xa0, 0x2d, 0xd8, 0x08, 0x7c, 0xed, 0xe6, 0xb9, 0xa6, 0x9f, 0xb7,
+	0xcf, 0xda, 0x39, 0x09, 0x21, 0xc8, 0x06, 0x60, 0xb5, 0x5e, 0x0f, 0xb0, 0x24, 0xfa, 0x1b, 0x36,
+	0x43, 0x62, 0xb7, 0xa5, 0x61, 0xbd, 0x7a, 0x72, 0x5e, 0x6b, 0xe4, 0x52, 0xca, 0x07, 0xf8, 0xe7,
+	0x8c, 0x8c, 0x09, 0xa3, 0xac, 0xda, 0xef, 0x3b, 0x93, 0x31, 0x8f, 0x4f, 0x29, 0xc3, 0x5f, 0xc6,
+	0x60, 0xe0, 0x11, 0xc6, 0xc4, 0x9c, 0xab, 0x78, 0x6e, 0xa2, 0x57, 0x90, 0x71, 0xef, 0x88, 0x72,
+	0xb2, 0x28, 0x95, 0x32, 0x95, 0xc2, 0xe3, 0x2a, 0xe0, 0x78, 0x88, 0xf2, 0x29, 0x09, 0x6b, 0x61,
+	0xe5, 0x36, 0x37, 0x38, 0x41, 0xef, 0x61, 0xcb, 0x08, 0x5a, 0xd0, 0xe3, 0xa9, 0xa5, 0x62, 0xaa,
+	0x94, 0xa9, 0xec, 0xdf, 0x93, 0xfa, 0xc1, 0xbe, 0x31, 0x32, 0x16, 0x67, 0xd9, 0x07, 0x34, 0xa0,
+	0xcc, 0xe8, 0x59, 0x64, 0xb0, 0x20, 0x7c, 0x6e, 0xee, 0x99, 0x6b, 0x8f, 0xea, 0x90, 0xed, 0x79,
+	0xc4, 0xb8, 0xd0, 0xc9, 0xa5, 0x4b, 0x3d, 0x4a, 0x98, 0x9c, 0x12, 0x7d, 0x3c, 0x79, 0xb8, 0x8f,
+	0x23, 0x9f, 0xaf, 0xf9, 0xf4, 0x19, 0x5e, 0xef, 0x45, 0x06, 0x25, 0xbe, 0x5a, 0x6b, 0x43, 0x6a,
+	0x71, 0xe2, 0xe9, 0xde, 0xc4, 0x22, 0x4c, 0x4e, 0x8b, 0x5c, 0xbb, 0xf7, 0xe4, 0x3a, 0x15, 0x34,
+	0x3c, 0xb1, 0x08, 0xce, 0x0c, 0xa3, 0x37, 0x53, 0xde, 0x41, 0x26, 0x96, 0x1f, 0xed, 0xc0, 0xf2,
+	0x88, 0x50, 0x73, 0xc4, 0xc5, 0x5e, 0x52, 0x38, 0xb4, 0xd0, 0x0b, 0x48, 0xfb, 0x97, 0x1b, 0xee,
+	0x23, 0xaf, 0x06, 0x67, 0xad, 0xce, 0xcf, 0x5a, 0xed, 0xcc, 0xcf, 0xfa, 0x28, 0x7d, 0xf5, 0x6d,
+	0x4f, 0xc2, 0x82, 0xad, 0x8c, 0x01, 0x2d, 0xce, 0x80, 0x8a, 0xb0, 0x66, 0x33, 0x33, 0xd2, 0x2a,
+	0xbc, 0x00, 0xb0, 0x99, 0x19, 0xaa, 0x84, 0x0e, 0x61, 0x59, 0xc8, 0x33, 0x7b, 0x64, 0xff, 0x71,
+	0x55, 0x42, 0xb6, 0xf2, 0x51, 0x02, 0xb8, 0x1b, 0x14, 0x21, 0x48, 0x8f, 0x0d, 0x9b, 0x84, 0x05,
+	0xc4, 0x7b, 0xa1, 0x78, 0x72, 0xa1, 0x78, 0x15, 0xc0, 0xf5, 0xc8, 0x80, 0xf6, 0x0d, 0x1e, 0x6d,
+	0xe7, 0xbf, 0x7b, 0x15, 0x25, 0xd6, 0xa0, 0x35, 0x67, 0xe2, 0x58, 0x90, 0xf2, 0x43, 0x82, 0xec,
+	0xaf, 0x6e, 0xb4, 0x0b, 0x30, 0xf4, 0x11, 0xdd, 0x35, 0xf8, 0x28, 0xec, 0x68, 0x55, 0x20, 0x2d,
+	0x83, 0x8f, 0xd0, 0x29, 0xac, 0x38, 0x2e, 0xf1, 0x0c, 0xee, 0x78, 0xa2, 0xa5, 0x6c, 0xe5, 0xd9,
+	0x1f, 0x4b, 0xaa, 0xcd, 0x30, 0x02, 0x47, 0xb1, 0x68, 0x1b, 0x96, 0xa6, 0x86, 0x35, 0x21, 0x72,
+	0x4a, 0x54, 0x08, 0x0c, 0xc5, 0x84, 0x95, 0x39, 0x17, 0xc9, 0xb0, 0xdd, 0x6c, 0x69, 0xb8, 0xda,
+	0x69, 0xe2, 0xdf, 0xbe, 0x78, 0x04, 0xd9, 0xc8, 0xa3, 0xbd, 0xee, 0x56, 0xeb, 0x39, 0x09, 0xed,
+	0x00, 0x8a, 0xb0, 0x46, 0xb3, 0x13, 0xe2, 0xe2, 0xa3, 0x8f, 0xf0, 0xe3, 0x66, 0xa3, 0x53, 0xad,
+	0x35, 0xda, 0xb9, 0xd4, 0xd1, 0xe1, 0xe7, 0x9b, 0x82, 0x74, 0x7d, 0x53, 0x90, 0xbe, 0xdf, 0x14,
+	0xa4, 0xab, 0xdb, 0x42, 0xe2, 0xfa, 0xb6, 0x90, 0xf8, 0x7a, 0x5b, 0x48, 0xbc, 0xfd, 0x37, 0x98,
+	0x86, 0x0d, 0x2e, 0x54, 0xea, 0x94, 0x2f, 0xa3, 0x1f, 0xab, 0xf8, 0xab, 0xf6, 0x96, 0xc5, 0x21,
+	0x3d, 0xff, 0x39, 0x00, 0x87, 0x11, 0x99, 0x62, 0x77, 0x05, 0x00, 0x00,
 }
 
 func (m *Permissions) Marshal() (dAtA []byte, err error) {
@@ -365,6 +696,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FilterRules) > 0 {
+		for iNdEx := len(m.FilterRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FilterRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BreakExpiries) > 0 {
+		for iNdEx := len(m.BreakExpiries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BreakExpiries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.DisabledTypeUrls) > 0 {
 		for iNdEx := len(m.DisabledTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.DisabledTypeUrls[iNdEx])
@@ -391,62 +750,235 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BreakExpiry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Permissions) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Level != 0 {
-		n += 1 + sovTypes(uint64(m.Level))
-	}
-	if len(m.LimitTypeUrls) > 0 {
-		for _, s := range m.LimitTypeUrls {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
-		}
-	}
-	return n
+
+func (m *BreakExpiry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisAccountPermissions) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BreakExpiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Time != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTypes(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Permissions != nil {
-		l = m.Permissions.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GenesisBreakExpiry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisBreakExpiry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisBreakExpiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.AccountPermissions) > 0 {
-		for _, e := range m.AccountPermissions {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	if m.Expiry != nil {
+		{
+			size, err := m.Expiry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FilterRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FilterRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FilterRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Predicates) > 0 {
+		for iNdEx := len(m.Predicates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Predicates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FieldPredicate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldPredicate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FieldPredicate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Operator != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Operator))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FieldPath) > 0 {
+		i -= len(m.FieldPath)
+		copy(dAtA[i:], m.FieldPath)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.FieldPath)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Permissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Level != 0 {
+		n += 1 + sovTypes(uint64(m.Level))
+	}
+	if len(m.LimitTypeUrls) > 0 {
+		for _, s := range m.LimitTypeUrls {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisAccountPermissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Permissions != nil {
+		l = m.Permissions.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccountPermissions) > 0 {
+		for _, e := range m.AccountPermissions {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
 	if len(m.DisabledTypeUrls) > 0 {
@@ -455,6 +987,94 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	if len(m.BreakExpiries) > 0 {
+		for _, e := range m.BreakExpiries {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.FilterRules) > 0 {
+		for _, e := range m.FilterRules {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BreakExpiry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Time != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisBreakExpiry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Expiry != nil {
+		l = m.Expiry.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *FilterRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Predicates) > 0 {
+		for _, e := range m.Predicates {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FieldPredicate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FieldPath)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Operator != 0 {
+		n += 1 + sovTypes(uint64(m.Operator))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -778,6 +1398,578 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.DisabledTypeUrls = append(m.DisabledTypeUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BreakExpiries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BreakExpiries = append(m.BreakExpiries, &GenesisBreakExpiry{})
+			if err := m.BreakExpiries[len(m.BreakExpiries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilterRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilterRules = append(m.FilterRules, &FilterRule{})
+			if err := m.FilterRules[len(m.FilterRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BreakExpiry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BreakExpiry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BreakExpiry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisBreakExpiry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisBreakExpiry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisBreakExpiry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = &BreakExpiry{}
+			}
+			if err := m.Expiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FilterRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FilterRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FilterRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predicates = append(m.Predicates, &FieldPredicate{})
+			if err := m.Predicates[len(m.Predicates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FieldPredicate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldPredicate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldPredicate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FieldPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			m.Operator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operator |= FieldPredicate_Operator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -26,6 +26,10 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) error {
 		if err := k.AllocateTokens(ctx, previousTotalPower, ctx.CometInfo().LastCommit.Votes); err != nil {
 			return err
 		}
+
+		if err := k.SweepCommunityPool(ctx); err != nil {
+			return err
+		}
 	}
 
 	// record the proposer for when we payout on the next block
@@ -82,6 +82,29 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 	return k.FeePool.Set(ctx, feePool)
 }
 
+// SweepCommunityPool sends the whole coins of the community pool, which the
+// community tax and the remainders of the rewards flow into, to the pool keeper,
+// so that the protocol pool and its continuous funds receive them. The decimal
+// change is left in the community pool.
+func (k Keeper) SweepCommunityPool(ctx context.Context) error {
+	feePool, err := k.FeePool.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	amount, change := feePool.CommunityPool.TruncateDecimal()
+	if amount.IsZero() {
+		return nil
+	}
+
+	feePool.CommunityPool = change
+	if err := k.FeePool.Set(ctx, feePool); err != nil {
+		return err
+	}
+
+	return k.poolKeeper.FundCommunityPool(ctx, amount, k.authKeeper.GetModuleAddress(types.ModuleName))
+}
+
 // AllocateTokensToValidator allocate tokens to a particular validator,
 // splitting according to commission.
 func (k Keeper) AllocateTokensToValidator(ctx context.Context, val stakingtypes.ValidatorI, tokens sdk.DecCoins) error {
@@ -21,6 +21,13 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query the amount of coins in the community pool",
 					Example:   fmt.Sprintf(`$ %s query pool community-pool`, version.AppName),
 				},
+				{
+					RpcMethod:      "UnclaimedBudget",
+					Use:            "unclaimed-budget [recipient-address]",
+					Short:          "Query the remaining budget of a recipient",
+					Example:        fmt.Sprintf(`$ %s query pool unclaimed-budget cosmos1...`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -33,6 +40,25 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`$ %s tx pool fund-community-pool 100uatom --from mykey`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
 				},
+				{
+					RpcMethod: "SubmitBudgetProposal",
+					Use:       "submit-budget-proposal [recipient-address] [budget-per-tranche] [tranches] [period]",
+					Short:     "Pays a recipient a budget per tranche every period, for a number of tranches, from the community pool",
+					Long:      "Pays a recipient a budget per tranche every period, for a number of tranches, from the community pool. The first tranche can be claimed from the start time, which defaults to the block time of the execution. It must be signed by the module authority, the governance module by default.",
+					Example:   fmt.Sprintf(`$ %s tx pool submit-budget-proposal cosmos1... 100uatom 12 720h --start-time 2024-01-01T00:00:00Z --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "recipient_address"},
+						{ProtoField: "budget_per_tranche"},
+						{ProtoField: "tranches"},
+						{ProtoField: "period"},
+					},
+				},
+				{
+					RpcMethod: "ClaimBudget",
+					Use:       "claim-budget",
+					Short:     "Claims the budget tranches due to the recipient",
+					Example:   fmt.Sprintf(`$ %s tx pool claim-budget --from mykey`, version.AppName),
+				},
 			},
 		},
 	}
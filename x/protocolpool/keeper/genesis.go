@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the budgets and the continuous funds of the
+// protocolpool module from a *previously validated* genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	for _, budget := range data.Budget {
+		recipient, err := k.authKeeper.AddressCodec().StringToBytes(budget.RecipientAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := k.BudgetProposal.Set(ctx, recipient, *budget); err != nil {
+			return err
+		}
+	}
+
+	for _, fund := range data.ContinuousFund {
+		recipient, err := k.authKeeper.AddressCodec().StringToBytes(fund.Recipient)
+		if err != nil {
+			return err
+		}
+
+		if err := k.ContinuousFund.Set(ctx, recipient, *fund); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the budgets and the continuous funds of the
+// protocolpool module as a genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	var budgets []*types.Budget
+	err := k.BudgetProposal.Walk(ctx, nil, func(_ sdk.AccAddress, budget types.Budget) (stop bool, err error) {
+		budgets = append(budgets, &budget)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var funds []*types.ContinuousFund
+	err = k.ContinuousFund.Walk(ctx, nil, func(_ sdk.AccAddress, fund types.ContinuousFund) (stop bool, err error) {
+		funds = append(funds, &fund)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(budgets, funds), nil
+}
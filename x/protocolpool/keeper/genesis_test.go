@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestInitExportGenesis() {
+	recipient := sdk.AccAddress([]byte("recipient___________")).String()
+	startTime := s.ctx.HeaderInfo().Time
+	nextClaimFrom := startTime.Add(time.Hour)
+	period := time.Hour
+	perTranche := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	claimed := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	expiry := startTime.Add(24 * time.Hour)
+
+	gs := types.NewGenesisState(
+		[]*types.Budget{{
+			RecipientAddress: recipient,
+			BudgetPerTranche: &perTranche,
+			ClaimedAmount:    &claimed,
+			StartTime:        &startTime,
+			NextClaimFrom:    &nextClaimFrom,
+			Tranches:         3,
+			TranchesLeft:     2,
+			Period:           &period,
+		}},
+		[]*types.ContinuousFund{{
+			Recipient:  recipient,
+			Percentage: math.LegacyNewDecWithPrec(3, 1),
+			Expiry:     &expiry,
+		}},
+	)
+	s.Require().NoError(types.ValidateGenesis(gs))
+	s.Require().NoError(s.poolKeeper.InitGenesis(s.ctx, gs))
+
+	// the budget claims resume where they were
+	res, err := s.queryServer.UnclaimedBudget(s.ctx, &types.QueryUnclaimedBudgetRequest{Address: recipient})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), res.TranchesLeft)
+	s.Require().Equal(claimed, *res.ClaimedAmount)
+
+	exported, err := s.poolKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(exported.Budget, 1)
+	s.Require().Len(exported.ContinuousFund, 1)
+	s.Require().Equal(gs.Budget[0].String(), exported.Budget[0].String())
+	s.Require().Equal(gs.ContinuousFund[0].String(), exported.ContinuousFund[0].String())
+}
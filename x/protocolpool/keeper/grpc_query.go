@@ -2,7 +2,13 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/protocolpool/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -27,3 +33,41 @@ func (k Querier) CommunityPool(ctx context.Context, req *types.QueryCommunityPoo
 	decCoins := sdk.NewDecCoinsFromCoins(amount...)
 	return &types.QueryCommunityPoolResponse{Pool: decCoins}, nil
 }
+
+// UnclaimedBudget queries the remaining budget of a recipient
+func (k Querier) UnclaimedBudget(ctx context.Context, req *types.QueryUnclaimedBudgetRequest) (*types.QueryUnclaimedBudgetResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	address, err := k.authKeeper.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	budget, err := k.Keeper.BudgetProposal.Get(ctx, address)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no budget found for address: %s", req.Address)
+		}
+		return nil, err
+	}
+
+	denom := budget.BudgetPerTranche.Denom
+	perTranche := budget.BudgetPerTranche.Amount
+	blockTime := sdk.UnwrapSDKContext(ctx).HeaderInfo().Time
+
+	totalBudget := sdk.NewCoin(denom, perTranche.Mul(math.NewIntFromUint64(budget.Tranches)))
+	unclaimed := sdk.NewCoin(denom, perTranche.Mul(math.NewIntFromUint64(budget.TranchesLeft)))
+	claimable := sdk.NewCoin(denom, perTranche.Mul(math.NewIntFromUint64(claimableTranches(budget, blockTime))))
+
+	return &types.QueryUnclaimedBudgetResponse{
+		TotalBudget:     &totalBudget,
+		ClaimedAmount:   budget.ClaimedAmount,
+		UnclaimedAmount: &unclaimed,
+		ClaimableAmount: &claimable,
+		NextClaimFrom:   budget.NextClaimFrom,
+		Period:          budget.Period,
+		TranchesLeft:    budget.TranchesLeft,
+	}, nil
+}
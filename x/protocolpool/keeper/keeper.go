@@ -2,11 +2,15 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"cosmossdk.io/collections"
 	storetypes "cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/protocolpool/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -20,6 +24,13 @@ type Keeper struct {
 	bankKeeper   types.BankKeeper
 
 	authority string
+
+	// State
+	Schema collections.Schema
+	// BudgetProposal contains the budgets indexed by recipient address
+	BudgetProposal collections.Map[sdk.AccAddress, types.Budget]
+	// ContinuousFund contains the continuous funds indexed by recipient address
+	ContinuousFund collections.Map[sdk.AccAddress, types.ContinuousFund]
 }
 
 func NewKeeper(cdc codec.BinaryCodec, storeService storetypes.KVStoreService,
@@ -29,12 +40,25 @@ func NewKeeper(cdc codec.BinaryCodec, storeService storetypes.KVStoreService,
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
-	return Keeper{
-		storeService: storeService,
-		authKeeper:   ak,
-		bankKeeper:   bk,
-		authority:    authority,
+
+	sb := collections.NewSchemaBuilder(storeService)
+
+	keeper := Keeper{
+		storeService:   storeService,
+		authKeeper:     ak,
+		bankKeeper:     bk,
+		authority:      authority,
+		BudgetProposal: collections.NewMap(sb, types.BudgetKey, "budget", sdk.AccAddressKey, codec.CollValue[types.Budget](cdc)),
+		ContinuousFund: collections.NewMap(sb, types.ContinuousFundKey, "continuous_fund", sdk.AccAddressKey, codec.CollValue[types.ContinuousFund](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
 	}
+	keeper.Schema = schema
+
+	return keeper
 }
 
 // GetAuthority returns the x/protocolpool module's authority.
@@ -49,8 +73,13 @@ func (k Keeper) Logger(ctx context.Context) log.Logger {
 }
 
 // FundCommunityPool allows an account to directly fund the community fund pool.
+// The continuous funds receive their share of the amount.
 func (k Keeper) FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
-	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	return k.distributeToContinuousFunds(ctx, amount)
 }
 
 // DistributeFromFeePool distributes funds from the protocolpool module account to
@@ -67,3 +96,182 @@ func (k Keeper) GetCommunityPool(ctx context.Context) (sdk.Coins, error) {
 	}
 	return k.bankKeeper.GetAllBalances(ctx, moduleAccount.GetAddress()), nil
 }
+
+// claimBudget pays the recipient the budget tranches that are due at the
+// current block time, and returns the paid amount.
+func (k Keeper) claimBudget(ctx context.Context, recipient sdk.AccAddress) (amount sdk.Coin, err error) {
+	budget, err := k.BudgetProposal.Get(ctx, recipient)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdk.Coin{}, errorsmod.Wrapf(types.ErrNoBudgetFound, "recipient %s", recipient)
+		}
+		return sdk.Coin{}, err
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).HeaderInfo().Time
+	tranches := claimableTranches(budget, blockTime)
+	if tranches == 0 {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrNothingToClaim, "next tranche can be claimed from %s", budget.NextClaimFrom)
+	}
+
+	amount = sdk.NewCoin(budget.BudgetPerTranche.Denom, budget.BudgetPerTranche.Amount.Mul(math.NewIntFromUint64(tranches)))
+	if err := k.DistributeFromFeePool(ctx, sdk.NewCoins(amount), recipient); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the budget is removed once all its tranches have been claimed
+	budget.TranchesLeft -= tranches
+	if budget.TranchesLeft == 0 {
+		return amount, k.BudgetProposal.Remove(ctx, recipient)
+	}
+
+	claimed := budget.ClaimedAmount.Add(amount)
+	// the total duration of the tranches is checked not to overflow when the
+	// budget is created
+	nextClaimFrom := budget.NextClaimFrom.Add(time.Duration(tranches) * *budget.Period)
+	budget.ClaimedAmount = &claimed
+	budget.NextClaimFrom = &nextClaimFrom
+
+	return amount, k.BudgetProposal.Set(ctx, recipient, budget)
+}
+
+// claimableTranches returns the number of budget tranches that can be claimed
+// at the given time.
+func claimableTranches(budget types.Budget, now time.Time) uint64 {
+	if budget.TranchesLeft == 0 || now.Before(*budget.NextClaimFrom) {
+		return 0
+	}
+
+	tranches := uint64(now.Sub(*budget.NextClaimFrom) / *budget.Period) + 1
+	if tranches > budget.TranchesLeft {
+		return budget.TranchesLeft
+	}
+
+	return tranches
+}
+
+// validateAndCreateBudget validates a budget proposal and returns the budget
+// to store.
+func (k Keeper) validateAndCreateBudget(ctx context.Context, msg *types.MsgSubmitBudgetProposal) (*types.Budget, error) {
+	if msg.BudgetPerTranche == nil || msg.BudgetPerTranche.IsNil() || !msg.BudgetPerTranche.IsValid() || msg.BudgetPerTranche.IsZero() {
+		return nil, errorsmod.Wrap(types.ErrInvalidBudget, "budget per tranche must be a positive valid coin")
+	}
+
+	if msg.Tranches == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidBudget, "tranches must be greater than zero")
+	}
+
+	if msg.Period == nil || *msg.Period <= 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidBudget, "period must be positive")
+	}
+
+	if err := types.ValidateBudgetTranches(msg.BudgetPerTranche.Amount, msg.Tranches, *msg.Period); err != nil {
+		return nil, err
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).HeaderInfo().Time
+	startTime := blockTime
+	if msg.StartTime != nil {
+		if msg.StartTime.Before(blockTime) {
+			return nil, errorsmod.Wrap(types.ErrInvalidBudget, "start time cannot be in the past")
+		}
+		startTime = *msg.StartTime
+	}
+
+	claimed := sdk.NewCoin(msg.BudgetPerTranche.Denom, math.ZeroInt())
+	return &types.Budget{
+		RecipientAddress: msg.RecipientAddress,
+		BudgetPerTranche: msg.BudgetPerTranche,
+		ClaimedAmount:    &claimed,
+		StartTime:        &startTime,
+		NextClaimFrom:    &startTime,
+		Tranches:         msg.Tranches,
+		TranchesLeft:     msg.Tranches,
+		Period:           msg.Period,
+	}, nil
+}
+
+// distributeToContinuousFunds pays every active continuous fund its percentage
+// of the amount flowing into the community pool. Expired funds are removed.
+func (k Keeper) distributeToContinuousFunds(ctx context.Context, amount sdk.Coins) error {
+	blockTime := sdk.UnwrapSDKContext(ctx).HeaderInfo().Time
+	inflow := sdk.NewDecCoinsFromCoins(amount...)
+
+	type payment struct {
+		recipient sdk.AccAddress
+		amount    sdk.Coins
+	}
+
+	var (
+		expired  []sdk.AccAddress
+		payments []payment
+	)
+	err := k.ContinuousFund.Walk(ctx, nil, func(recipient sdk.AccAddress, fund types.ContinuousFund) (stop bool, err error) {
+		if isExpired(fund, blockTime) {
+			expired = append(expired, recipient)
+			return false, nil
+		}
+
+		share, _ := inflow.MulDecTruncate(fund.Percentage).TruncateDecimal()
+		if !share.IsZero() {
+			payments = append(payments, payment{recipient: recipient, amount: share})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, recipient := range expired {
+		if err := k.ContinuousFund.Remove(ctx, recipient); err != nil {
+			return err
+		}
+	}
+
+	for _, p := range payments {
+		if err := k.DistributeFromFeePool(ctx, p.amount, p.recipient); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateContinuousFund validates a continuous fund proposal. The sum of the
+// percentages of the active continuous funds, including the new one, cannot
+// exceed 100%.
+func (k Keeper) validateContinuousFund(ctx context.Context, msg *types.MsgCreateContinuousFund) error {
+	if msg.Percentage.IsNil() || !msg.Percentage.IsPositive() {
+		return errorsmod.Wrap(types.ErrInvalidContinuousFund, "percentage must be positive")
+	}
+	if msg.Percentage.GT(math.LegacyOneDec()) {
+		return errorsmod.Wrap(types.ErrInvalidContinuousFund, "percentage cannot be greater than one")
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).HeaderInfo().Time
+	if msg.Expiry != nil && !msg.Expiry.After(blockTime) {
+		return errorsmod.Wrap(types.ErrInvalidContinuousFund, "expiry time must be in the future")
+	}
+
+	total := msg.Percentage
+	err := k.ContinuousFund.Walk(ctx, nil, func(_ sdk.AccAddress, fund types.ContinuousFund) (stop bool, err error) {
+		if !isExpired(fund, blockTime) {
+			total = total.Add(fund.Percentage)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if total.GT(math.LegacyOneDec()) {
+		return errorsmod.Wrapf(types.ErrInvalidContinuousFund, "total percentage of continuous funds cannot exceed 100%%, got %s", total)
+	}
+
+	return nil
+}
+
+// isExpired returns true when the continuous fund expired at the given time.
+func isExpired(fund types.ContinuousFund, now time.Time) bool {
+	return fund.Expiry != nil && !now.Before(*fund.Expiry)
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/protocolpool"
+	poolkeeper "cosmossdk.io/x/protocolpool/keeper"
+	pooltestutil "cosmossdk.io/x/protocolpool/testutil"
+	"cosmossdk.io/x/protocolpool/types"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var poolAcc = authtypes.NewEmptyModuleAccount(types.ModuleName)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	poolKeeper  poolkeeper.Keeper
+	authKeeper  *pooltestutil.MockAccountKeeper
+	bankKeeper  *pooltestutil.MockBankKeeper
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+	encCfg := moduletestutil.MakeTestEncodingConfig(protocolpool.AppModuleBasic{})
+
+	ctrl := gomock.NewController(s.T())
+	accountKeeper := pooltestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(poolAcc.GetAddress())
+	accountKeeper.EXPECT().AddressCodec().Return(addresscodec.NewBech32Codec("cosmos")).AnyTimes()
+	s.authKeeper = accountKeeper
+
+	bankKeeper := pooltestutil.NewMockBankKeeper(ctrl)
+	s.bankKeeper = bankKeeper
+
+	authority := authtypes.NewModuleAddress(types.GovModuleName)
+
+	poolKeeper := poolkeeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		authority.String(),
+	)
+	s.ctx = ctx
+	s.poolKeeper = poolKeeper
+
+	s.msgServer = poolkeeper.NewMsgServerImpl(poolKeeper)
+	s.queryServer = poolkeeper.NewQuerier(poolKeeper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -65,6 +65,109 @@ func (k MsgServer) CommunityPoolSpend(ctx context.Context, msg *types.MsgCommuni
 	return &types.MsgCommunityPoolSpendResponse{}, nil
 }
 
+func (k MsgServer) SubmitBudgetProposal(ctx context.Context, msg *types.MsgSubmitBudgetProposal) (*types.MsgSubmitBudgetProposalResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(msg.RecipientAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	has, err := k.BudgetProposal.Has(ctx, recipient)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, errors.Wrapf(types.ErrInvalidBudget, "budget already exists for recipient %s", msg.RecipientAddress)
+	}
+
+	budget, err := k.validateAndCreateBudget(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.BudgetProposal.Set(ctx, recipient, *budget); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitBudgetProposalResponse{}, nil
+}
+
+func (k MsgServer) ClaimBudget(ctx context.Context, msg *types.MsgClaimBudget) (*types.MsgClaimBudgetResponse, error) {
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(msg.RecipientAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	amount, err := k.claimBudget(ctx, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimBudgetResponse{Amount: amount}, nil
+}
+
+func (k MsgServer) CreateContinuousFund(ctx context.Context, msg *types.MsgCreateContinuousFund) (*types.MsgCreateContinuousFundResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	has, err := k.ContinuousFund.Has(ctx, recipient)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, errors.Wrapf(types.ErrInvalidContinuousFund, "continuous fund already exists for recipient %s", msg.Recipient)
+	}
+
+	if err := k.validateContinuousFund(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	fund := types.ContinuousFund{
+		Recipient:  msg.Recipient,
+		Percentage: msg.Percentage,
+		Expiry:     msg.Expiry,
+	}
+	if err := k.ContinuousFund.Set(ctx, recipient, fund); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateContinuousFundResponse{}, nil
+}
+
+func (k MsgServer) CancelContinuousFund(ctx context.Context, msg *types.MsgCancelContinuousFund) (*types.MsgCancelContinuousFundResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	has, err := k.ContinuousFund.Has(ctx, recipient)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errors.Wrapf(types.ErrNoContinuousFundFound, "recipient %s", msg.Recipient)
+	}
+
+	if err := k.ContinuousFund.Remove(ctx, recipient); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelContinuousFundResponse{}, nil
+}
+
 func (k *Keeper) validateAuthority(authority string) error {
 	if _, err := k.authKeeper.AddressCodec().StringToBytes(authority); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
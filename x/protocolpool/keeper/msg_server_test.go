@@ -0,0 +1,383 @@
+package keeper_test
+
+import (
+	stdmath "math"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var recipientAddr = sdk.AccAddress([]byte("to1__________________"))
+
+func (s *KeeperTestSuite) TestMsgSubmitBudgetProposal() {
+	authority := s.poolKeeper.GetAuthority()
+	budgetPerTranche := sdk.NewInt64Coin("foo", 100)
+	startTime := s.ctx.HeaderInfo().Time.Add(10 * time.Second)
+	invalidStartTime := s.ctx.HeaderInfo().Time.Add(-10 * time.Second)
+	period := time.Duration(60) * time.Second
+	zeroPeriod := time.Duration(0)
+
+	testCases := map[string]struct {
+		preRun    func()
+		input     *types.MsgSubmitBudgetProposal
+		expErr    bool
+		expErrMsg string
+	}{
+		"empty recipient address": {
+			input:     types.NewMsgSubmitBudgetProposal(authority, "", budgetPerTranche, &startTime, 2, period),
+			expErr:    true,
+			expErrMsg: "invalid recipient address",
+		},
+		"invalid authority": {
+			input:     types.NewMsgSubmitBudgetProposal(recipientAddr.String(), recipientAddr.String(), budgetPerTranche, &startTime, 2, period),
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		"invalid budget per tranche": {
+			input:     types.NewMsgSubmitBudgetProposal(authority, recipientAddr.String(), sdk.NewInt64Coin("foo", 0), &startTime, 2, period),
+			expErr:    true,
+			expErrMsg: "budget per tranche must be a positive valid coin",
+		},
+		"invalid start time": {
+			input:     types.NewMsgSubmitBudgetProposal(authority, recipientAddr.String(), budgetPerTranche, &invalidStartTime, 2, period),
+			expErr:    true,
+			expErrMsg: "start time cannot be in the past",
+		},
+		"zero tranches": {
+			input:     types.NewMsgSubmitBudgetProposal(authority, recipientAddr.String(), budgetPerTranche, &startTime, 0, period),
+			expErr:    true,
+			expErrMsg: "tranches must be greater than zero",
+		},
+		"zero period": {
+			input:     types.NewMsgSubmitBudgetProposal(authority, recipientAddr.String(), budgetPerTranche, &startTime, 2, zeroPeriod),
+			expErr:    true,
+			expErrMsg: "period must be positive",
+		},
+		"tranches overflowing the duration": {
+			input:     types.NewMsgSubmitBudgetProposal(authority, recipientAddr.String(), budgetPerTranche, &startTime, stdmath.MaxUint64, period),
+			expErr:    true,
+			expErrMsg: "overflow the budget duration",
+		},
+		"tranches overflowing the amount": {
+			input:     types.NewMsgSubmitBudgetProposal(authority, recipientAddr.String(), sdk.NewCoin("foo", math.NewIntWithDecimal(1, 76)), &startTime, 1000, period),
+			expErr:    true,
+			expErrMsg: "overflow the budget amount",
+		},
+		"budget already exists": {
+			preRun: func() {
+				msg := types.NewMsgSubmitBudgetProposal(authority, recipientAddr.String(), budgetPerTranche, &startTime, 2, period)
+				_, err := s.msgServer.SubmitBudgetProposal(s.ctx, msg)
+				s.Require().NoError(err)
+			},
+			input:     types.NewMsgSubmitBudgetProposal(authority, recipientAddr.String(), budgetPerTranche, &startTime, 2, period),
+			expErr:    true,
+			expErrMsg: "budget already exists",
+		},
+		"all good": {
+			input:  types.NewMsgSubmitBudgetProposal(authority, recipientAddr.String(), budgetPerTranche, &startTime, 2, period),
+			expErr: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			s.SetupTest()
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+
+			_, err := s.msgServer.SubmitBudgetProposal(s.ctx, tc.input)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			budget, err := s.poolKeeper.BudgetProposal.Get(s.ctx, recipientAddr)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.input.Tranches, budget.TranchesLeft)
+			s.Require().Equal(startTime, *budget.NextClaimFrom)
+			s.Require().Equal(sdk.NewInt64Coin("foo", 0), *budget.ClaimedAmount)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgClaimBudget() {
+	authority := s.poolKeeper.GetAuthority()
+	budgetPerTranche := sdk.NewInt64Coin("foo", 100)
+	period := time.Duration(60) * time.Second
+
+	submitBudget := func(tranches uint64) time.Time {
+		startTime := s.ctx.HeaderInfo().Time.Add(10 * time.Second)
+		msg := types.NewMsgSubmitBudgetProposal(authority, recipientAddr.String(), budgetPerTranche, &startTime, tranches, period)
+		_, err := s.msgServer.SubmitBudgetProposal(s.ctx, msg)
+		s.Require().NoError(err)
+		return startTime
+	}
+
+	expectPayment := func(amount int64) {
+		s.bankKeeper.EXPECT().
+			SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipientAddr, sdk.NewCoins(sdk.NewInt64Coin("foo", amount))).
+			Return(nil)
+	}
+
+	testCases := map[string]struct {
+		preRun    func()
+		expErr    bool
+		expErrMsg string
+		expAmount sdk.Coin
+		postRun   func()
+	}{
+		"no budget found": {
+			expErr:    true,
+			expErrMsg: "no budget found for recipient",
+		},
+		"claiming before start time": {
+			preRun: func() {
+				submitBudget(2)
+			},
+			expErr:    true,
+			expErrMsg: "no budget tranche to claim",
+		},
+		"claim first tranche at start time": {
+			preRun: func() {
+				startTime := submitBudget(2)
+				s.ctx = s.ctx.WithHeaderInfo(header.Info{Time: startTime})
+				expectPayment(100)
+			},
+			expAmount: budgetPerTranche,
+			postRun: func() {
+				budget, err := s.poolKeeper.BudgetProposal.Get(s.ctx, recipientAddr)
+				s.Require().NoError(err)
+				s.Require().Equal(uint64(1), budget.TranchesLeft)
+				s.Require().Equal(budgetPerTranche, *budget.ClaimedAmount)
+				s.Require().Equal(budget.StartTime.Add(period), *budget.NextClaimFrom)
+
+				// the next tranche is not due yet
+				_, err = s.msgServer.ClaimBudget(s.ctx, types.NewMsgClaimBudget(recipientAddr.String()))
+				s.Require().ErrorIs(err, types.ErrNothingToClaim)
+			},
+		},
+		"claim several due tranches at once": {
+			preRun: func() {
+				startTime := submitBudget(5)
+				s.ctx = s.ctx.WithHeaderInfo(header.Info{Time: startTime.Add(2*period + time.Second)})
+				expectPayment(300)
+			},
+			expAmount: sdk.NewInt64Coin("foo", 300),
+			postRun: func() {
+				budget, err := s.poolKeeper.BudgetProposal.Get(s.ctx, recipientAddr)
+				s.Require().NoError(err)
+				s.Require().Equal(uint64(2), budget.TranchesLeft)
+				s.Require().Equal(budget.StartTime.Add(3*period), *budget.NextClaimFrom)
+			},
+		},
+		"claim all tranches removes the budget": {
+			preRun: func() {
+				startTime := submitBudget(2)
+				s.ctx = s.ctx.WithHeaderInfo(header.Info{Time: startTime.Add(10 * period)})
+				expectPayment(200)
+			},
+			expAmount: sdk.NewInt64Coin("foo", 200),
+			postRun: func() {
+				has, err := s.poolKeeper.BudgetProposal.Has(s.ctx, recipientAddr)
+				s.Require().NoError(err)
+				s.Require().False(has)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			s.SetupTest()
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+
+			res, err := s.msgServer.ClaimBudget(s.ctx, types.NewMsgClaimBudget(recipientAddr.String()))
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expAmount, res.Amount)
+
+			if tc.postRun != nil {
+				tc.postRun()
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUnclaimedBudget() {
+	budgetPerTranche := sdk.NewInt64Coin("foo", 100)
+	startTime := s.ctx.HeaderInfo().Time.Add(10 * time.Second)
+	period := time.Duration(60) * time.Second
+
+	_, err := s.queryServer.UnclaimedBudget(s.ctx, &types.QueryUnclaimedBudgetRequest{Address: recipientAddr.String()})
+	s.Require().ErrorContains(err, "no budget found for address")
+
+	msg := types.NewMsgSubmitBudgetProposal(authtypes.NewModuleAddress(types.GovModuleName).String(), recipientAddr.String(), budgetPerTranche, &startTime, 3, period)
+	_, err = s.msgServer.SubmitBudgetProposal(s.ctx, msg)
+	s.Require().NoError(err)
+
+	res, err := s.queryServer.UnclaimedBudget(s.ctx, &types.QueryUnclaimedBudgetRequest{Address: recipientAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin("foo", 300), *res.TotalBudget)
+	s.Require().Equal(sdk.NewInt64Coin("foo", 0), *res.ClaimedAmount)
+	s.Require().Equal(sdk.NewInt64Coin("foo", 300), *res.UnclaimedAmount)
+	s.Require().True(res.ClaimableAmount.Amount.IsZero())
+	s.Require().Equal(uint64(3), res.TranchesLeft)
+
+	// two tranches are due after the first period
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Time: startTime.Add(period)})
+	res, err = s.queryServer.UnclaimedBudget(s.ctx, &types.QueryUnclaimedBudgetRequest{Address: recipientAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(200), res.ClaimableAmount.Amount)
+}
+
+func (s *KeeperTestSuite) TestMsgCreateContinuousFund() {
+	authority := s.poolKeeper.GetAuthority()
+	recipient2 := sdk.AccAddress([]byte("to2__________________"))
+	expiry := s.ctx.HeaderInfo().Time.Add(time.Hour)
+	pastExpiry := s.ctx.HeaderInfo().Time.Add(-time.Hour)
+
+	testCases := map[string]struct {
+		preRun    func()
+		input     *types.MsgCreateContinuousFund
+		expErrMsg string
+	}{
+		"invalid authority": {
+			input:     types.NewMsgCreateContinuousFund(recipientAddr.String(), recipientAddr.String(), math.LegacyNewDecWithPrec(2, 1), &expiry),
+			expErrMsg: "invalid authority",
+		},
+		"invalid recipient address": {
+			input:     types.NewMsgCreateContinuousFund(authority, "invalid", math.LegacyNewDecWithPrec(2, 1), &expiry),
+			expErrMsg: "invalid recipient address",
+		},
+		"zero percentage": {
+			input:     types.NewMsgCreateContinuousFund(authority, recipientAddr.String(), math.LegacyZeroDec(), &expiry),
+			expErrMsg: "percentage must be positive",
+		},
+		"percentage greater than one": {
+			input:     types.NewMsgCreateContinuousFund(authority, recipientAddr.String(), math.LegacyNewDecWithPrec(11, 1), &expiry),
+			expErrMsg: "percentage cannot be greater than one",
+		},
+		"expiry in the past": {
+			input:     types.NewMsgCreateContinuousFund(authority, recipientAddr.String(), math.LegacyNewDecWithPrec(2, 1), &pastExpiry),
+			expErrMsg: "expiry time must be in the future",
+		},
+		"fund already exists": {
+			preRun: func() {
+				_, err := s.msgServer.CreateContinuousFund(s.ctx, types.NewMsgCreateContinuousFund(authority, recipientAddr.String(), math.LegacyNewDecWithPrec(2, 1), nil))
+				s.Require().NoError(err)
+			},
+			input:     types.NewMsgCreateContinuousFund(authority, recipientAddr.String(), math.LegacyNewDecWithPrec(2, 1), &expiry),
+			expErrMsg: "continuous fund already exists",
+		},
+		"total percentage exceeds 100%": {
+			preRun: func() {
+				_, err := s.msgServer.CreateContinuousFund(s.ctx, types.NewMsgCreateContinuousFund(authority, recipient2.String(), math.LegacyNewDecWithPrec(7, 1), nil))
+				s.Require().NoError(err)
+			},
+			input:     types.NewMsgCreateContinuousFund(authority, recipientAddr.String(), math.LegacyNewDecWithPrec(4, 1), &expiry),
+			expErrMsg: "total percentage of continuous funds cannot exceed 100%",
+		},
+		"expired funds do not count towards the total percentage": {
+			preRun: func() {
+				_, err := s.msgServer.CreateContinuousFund(s.ctx, types.NewMsgCreateContinuousFund(authority, recipient2.String(), math.LegacyNewDecWithPrec(7, 1), &expiry))
+				s.Require().NoError(err)
+				s.ctx = s.ctx.WithHeaderInfo(header.Info{Time: expiry})
+			},
+			input: types.NewMsgCreateContinuousFund(authority, recipientAddr.String(), math.LegacyNewDecWithPrec(4, 1), nil),
+		},
+		"all good": {
+			input: types.NewMsgCreateContinuousFund(authority, recipientAddr.String(), math.LegacyOneDec(), &expiry),
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			s.SetupTest()
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+
+			_, err := s.msgServer.CreateContinuousFund(s.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			fund, err := s.poolKeeper.ContinuousFund.Get(s.ctx, recipientAddr)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.input.Percentage, fund.Percentage)
+			s.Require().Equal(tc.input.Expiry, fund.Expiry)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgCancelContinuousFund() {
+	authority := s.poolKeeper.GetAuthority()
+
+	_, err := s.msgServer.CancelContinuousFund(s.ctx, types.NewMsgCancelContinuousFund(authority, recipientAddr.String()))
+	s.Require().ErrorIs(err, types.ErrNoContinuousFundFound)
+
+	_, err = s.msgServer.CreateContinuousFund(s.ctx, types.NewMsgCreateContinuousFund(authority, recipientAddr.String(), math.LegacyNewDecWithPrec(2, 1), nil))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.CancelContinuousFund(s.ctx, types.NewMsgCancelContinuousFund(recipientAddr.String(), recipientAddr.String()))
+	s.Require().ErrorContains(err, "invalid authority")
+
+	_, err = s.msgServer.CancelContinuousFund(s.ctx, types.NewMsgCancelContinuousFund(authority, recipientAddr.String()))
+	s.Require().NoError(err)
+
+	has, err := s.poolKeeper.ContinuousFund.Has(s.ctx, recipientAddr)
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
+
+func (s *KeeperTestSuite) TestFundCommunityPoolWithContinuousFunds() {
+	authority := s.poolKeeper.GetAuthority()
+	depositor := sdk.AccAddress([]byte("depositor____________"))
+	recipient2 := sdk.AccAddress([]byte("to2__________________"))
+	recipient3 := sdk.AccAddress([]byte("to3__________________"))
+	expiry := s.ctx.HeaderInfo().Time.Add(time.Hour)
+
+	_, err := s.msgServer.CreateContinuousFund(s.ctx, types.NewMsgCreateContinuousFund(authority, recipientAddr.String(), math.LegacyNewDecWithPrec(2, 1), nil))
+	s.Require().NoError(err)
+	_, err = s.msgServer.CreateContinuousFund(s.ctx, types.NewMsgCreateContinuousFund(authority, recipient2.String(), math.LegacyNewDecWithPrec(333, 3), &expiry))
+	s.Require().NoError(err)
+	_, err = s.msgServer.CreateContinuousFund(s.ctx, types.NewMsgCreateContinuousFund(authority, recipient3.String(), math.LegacyNewDecWithPrec(1, 3), nil))
+	s.Require().NoError(err)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("foo", 1000), sdk.NewInt64Coin("bar", 10))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), depositor, types.ModuleName, amount).Return(nil).Times(2)
+
+	// shares are truncated, recipient3 gets 1 foo and no bar
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipientAddr, sdk.NewCoins(sdk.NewInt64Coin("foo", 200), sdk.NewInt64Coin("bar", 2))).Return(nil).Times(2)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipient2, sdk.NewCoins(sdk.NewInt64Coin("foo", 333), sdk.NewInt64Coin("bar", 3))).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipient3, sdk.NewCoins(sdk.NewInt64Coin("foo", 1))).Return(nil).Times(2)
+
+	_, err = s.msgServer.FundCommunityPool(s.ctx, types.NewMsgFundCommunityPool(amount, depositor.String()))
+	s.Require().NoError(err)
+
+	// the fund of recipient2 expired and is removed
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Time: expiry})
+	_, err = s.msgServer.FundCommunityPool(s.ctx, types.NewMsgFundCommunityPool(amount, depositor.String()))
+	s.Require().NoError(err)
+
+	has, err := s.poolKeeper.ContinuousFund.Has(s.ctx, recipient2)
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
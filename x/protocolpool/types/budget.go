@@ -0,0 +1,25 @@
+package types
+
+import (
+	stdmath "math"
+	"math/big"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// ValidateBudgetTranches checks that the total amount and the total duration of
+// the tranches of a budget don't overflow, the period being positive.
+func ValidateBudgetTranches(budgetPerTranche math.Int, tranches uint64, period time.Duration) error {
+	if tranches > uint64(stdmath.MaxInt64/int64(period)) {
+		return errorsmod.Wrapf(ErrInvalidBudget, "%d tranches of %s overflow the budget duration", tranches, period)
+	}
+
+	total := new(big.Int).Mul(budgetPerTranche.BigInt(), new(big.Int).SetUint64(tranches))
+	if total.BitLen() > math.MaxBitLen {
+		return errorsmod.Wrapf(ErrInvalidBudget, "%d tranches of %s overflow the budget amount", tranches, budgetPerTranche)
+	}
+
+	return nil
+}
@@ -11,6 +11,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgFundCommunityPool{},
 		&MsgCommunityPoolSpend{},
+		&MsgSubmitBudgetProposal{},
+		&MsgClaimBudget{},
+		&MsgCreateContinuousFund{},
+		&MsgCancelContinuousFund{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -2,4 +2,11 @@ package types
 
 import "cosmossdk.io/errors"
 
-var ErrInvalidSigner = errors.Register(ModuleName, 2, "expected authority account as only signer for community pool spend message")
+var (
+	ErrInvalidSigner         = errors.Register(ModuleName, 2, "expected authority account as only signer for community pool spend message")
+	ErrNoBudgetFound         = errors.Register(ModuleName, 3, "no budget found for recipient")
+	ErrInvalidBudget         = errors.Register(ModuleName, 4, "invalid budget proposal")
+	ErrNothingToClaim        = errors.Register(ModuleName, 5, "no budget tranche to claim")
+	ErrInvalidContinuousFund = errors.Register(ModuleName, 6, "invalid continuous fund")
+	ErrNoContinuousFundFound = errors.Register(ModuleName, 7, "no continuous fund found for recipient")
+)
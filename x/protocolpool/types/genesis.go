@@ -0,0 +1,112 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// NewGenesisState creates a new genesis state of the protocolpool module.
+func NewGenesisState(budget []*Budget, continuousFund []*ContinuousFund) *GenesisState {
+	return &GenesisState{
+		Budget:         budget,
+		ContinuousFund: continuousFund,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the protocolpool
+// module, without any budget or continuous fund.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// ValidateGenesis validates the budgets and the continuous funds of the genesis
+// state. A recipient can have a single budget and a single continuous fund, and
+// the percentages of the continuous funds cannot exceed 100% in total.
+func ValidateGenesis(gs *GenesisState) error {
+	budgets := make(map[string]bool, len(gs.Budget))
+	for _, budget := range gs.Budget {
+		if budget == nil {
+			return errorsmod.Wrap(ErrInvalidBudget, "empty budget")
+		}
+		if err := validateBudget(*budget); err != nil {
+			return err
+		}
+		if budgets[budget.RecipientAddress] {
+			return errorsmod.Wrapf(ErrInvalidBudget, "duplicate budget for recipient %s", budget.RecipientAddress)
+		}
+		budgets[budget.RecipientAddress] = true
+	}
+
+	total := math.LegacyZeroDec()
+	funds := make(map[string]bool, len(gs.ContinuousFund))
+	for _, fund := range gs.ContinuousFund {
+		if fund == nil {
+			return errorsmod.Wrap(ErrInvalidContinuousFund, "empty continuous fund")
+		}
+		if err := validateContinuousFund(*fund); err != nil {
+			return err
+		}
+		if funds[fund.Recipient] {
+			return errorsmod.Wrapf(ErrInvalidContinuousFund, "duplicate continuous fund for recipient %s", fund.Recipient)
+		}
+		funds[fund.Recipient] = true
+		total = total.Add(fund.Percentage)
+	}
+
+	if total.GT(math.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidContinuousFund, "total percentage of continuous funds cannot exceed 100%%, got %s", total)
+	}
+
+	return nil
+}
+
+// validateBudget validates a budget, as stored by the keeper: a budget whose
+// tranches have all been claimed is removed.
+func validateBudget(budget Budget) error {
+	if budget.RecipientAddress == "" {
+		return errorsmod.Wrap(ErrInvalidBudget, "empty recipient address")
+	}
+
+	if budget.BudgetPerTranche == nil || budget.BudgetPerTranche.IsNil() || !budget.BudgetPerTranche.IsValid() || budget.BudgetPerTranche.IsZero() {
+		return errorsmod.Wrapf(ErrInvalidBudget, "budget per tranche must be a positive valid coin for recipient %s", budget.RecipientAddress)
+	}
+
+	if budget.ClaimedAmount == nil || budget.ClaimedAmount.IsNil() || !budget.ClaimedAmount.IsValid() || budget.ClaimedAmount.Denom != budget.BudgetPerTranche.Denom {
+		return errorsmod.Wrapf(ErrInvalidBudget, "claimed amount must be a valid coin of denom %s for recipient %s", budget.BudgetPerTranche.Denom, budget.RecipientAddress)
+	}
+
+	if budget.StartTime == nil || budget.NextClaimFrom == nil {
+		return errorsmod.Wrapf(ErrInvalidBudget, "start time and next claim time must be set for recipient %s", budget.RecipientAddress)
+	}
+	if budget.NextClaimFrom.Before(*budget.StartTime) {
+		return errorsmod.Wrapf(ErrInvalidBudget, "next claim time cannot be before the start time for recipient %s", budget.RecipientAddress)
+	}
+
+	if budget.Period == nil || *budget.Period <= 0 {
+		return errorsmod.Wrapf(ErrInvalidBudget, "period must be positive for recipient %s", budget.RecipientAddress)
+	}
+
+	if budget.TranchesLeft == 0 || budget.TranchesLeft > budget.Tranches {
+		return errorsmod.Wrapf(ErrInvalidBudget, "tranches left must be between 1 and %d for recipient %s", budget.Tranches, budget.RecipientAddress)
+	}
+
+	if err := ValidateBudgetTranches(budget.BudgetPerTranche.Amount, budget.Tranches, *budget.Period); err != nil {
+		return errorsmod.Wrapf(err, "recipient %s", budget.RecipientAddress)
+	}
+
+	return nil
+}
+
+// validateContinuousFund validates the recipient and the percentage of a
+// continuous fund.
+func validateContinuousFund(fund ContinuousFund) error {
+	if fund.Recipient == "" {
+		return errorsmod.Wrap(ErrInvalidContinuousFund, "empty recipient address")
+	}
+
+	if fund.Percentage.IsNil() || !fund.Percentage.IsPositive() || fund.Percentage.GT(math.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidContinuousFund, "percentage must be positive and not greater than one for recipient %s", fund.Recipient)
+	}
+
+	return nil
+}
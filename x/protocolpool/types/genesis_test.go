@@ -0,0 +1,110 @@
+package types_test
+
+import (
+	stdmath "math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	now := time.Now()
+	period := time.Hour
+	perTranche := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	claimed := sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)
+
+	budget := func(recipient string, tranchesLeft uint64) *types.Budget {
+		return &types.Budget{
+			RecipientAddress: recipient,
+			BudgetPerTranche: &perTranche,
+			ClaimedAmount:    &claimed,
+			StartTime:        &now,
+			NextClaimFrom:    &now,
+			Tranches:         2,
+			TranchesLeft:     tranchesLeft,
+			Period:           &period,
+		}
+	}
+	fund := func(recipient string, percentage int64) *types.ContinuousFund {
+		return &types.ContinuousFund{
+			Recipient:  recipient,
+			Percentage: math.LegacyNewDecWithPrec(percentage, 1),
+		}
+	}
+
+	testCases := []struct {
+		name   string
+		gs     *types.GenesisState
+		expErr error
+	}{
+		{
+			name: "default genesis",
+			gs:   types.DefaultGenesisState(),
+		},
+		{
+			name: "valid genesis",
+			gs:   types.NewGenesisState([]*types.Budget{budget("a", 2), budget("b", 1)}, []*types.ContinuousFund{fund("a", 4), fund("b", 6)}),
+		},
+		{
+			name:   "empty budget recipient",
+			gs:     types.NewGenesisState([]*types.Budget{budget("", 2)}, nil),
+			expErr: types.ErrInvalidBudget,
+		},
+		{
+			name:   "no tranches left",
+			gs:     types.NewGenesisState([]*types.Budget{budget("a", 0)}, nil),
+			expErr: types.ErrInvalidBudget,
+		},
+		{
+			name:   "more tranches left than tranches",
+			gs:     types.NewGenesisState([]*types.Budget{budget("a", 3)}, nil),
+			expErr: types.ErrInvalidBudget,
+		},
+		{
+			name: "tranches overflowing the duration",
+			gs: func() *types.GenesisState {
+				b := budget("a", 2)
+				b.Tranches, b.TranchesLeft = stdmath.MaxInt64/uint64(period)+1, 1
+				return types.NewGenesisState([]*types.Budget{b}, nil)
+			}(),
+			expErr: types.ErrInvalidBudget,
+		},
+		{
+			name:   "duplicate budget",
+			gs:     types.NewGenesisState([]*types.Budget{budget("a", 2), budget("a", 1)}, nil),
+			expErr: types.ErrInvalidBudget,
+		},
+		{
+			name:   "zero percentage",
+			gs:     types.NewGenesisState(nil, []*types.ContinuousFund{fund("a", 0)}),
+			expErr: types.ErrInvalidContinuousFund,
+		},
+		{
+			name:   "duplicate continuous fund",
+			gs:     types.NewGenesisState(nil, []*types.ContinuousFund{fund("a", 1), fund("a", 2)}),
+			expErr: types.ErrInvalidContinuousFund,
+		},
+		{
+			name:   "total percentage over 100%",
+			gs:     types.NewGenesisState(nil, []*types.ContinuousFund{fund("a", 5), fund("b", 6)}),
+			expErr: types.ErrInvalidContinuousFund,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateGenesis(tc.gs)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package types
 
+import "cosmossdk.io/collections"
+
 const (
 	// ModuleName is the module name constant used in many places
 	ModuleName = "protocolpool"
@@ -13,3 +15,11 @@ const (
 	// GovModuleName is the name of the gov module
 	GovModuleName = "gov"
 )
+
+var (
+	// BudgetKey is the prefix of the budgets, indexed by recipient address.
+	BudgetKey = collections.NewPrefix(1)
+	// ContinuousFundKey is the prefix of the continuous funds, indexed by
+	// recipient address.
+	ContinuousFundKey = collections.NewPrefix(2)
+)
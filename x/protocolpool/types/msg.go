@@ -1,12 +1,20 @@
 package types
 
 import (
+	"time"
+
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
 	_ sdk.Msg = (*MsgFundCommunityPool)(nil)
 	_ sdk.Msg = (*MsgCommunityPoolSpend)(nil)
+	_ sdk.Msg = (*MsgSubmitBudgetProposal)(nil)
+	_ sdk.Msg = (*MsgClaimBudget)(nil)
+	_ sdk.Msg = (*MsgCreateContinuousFund)(nil)
+	_ sdk.Msg = (*MsgCancelContinuousFund)(nil)
 )
 
 // NewMsgFundCommunityPool returns a new MsgFundCommunityPool with a sender and
@@ -27,3 +35,44 @@ func NewCommunityPoolSpend(amount sdk.Coins, authority, recipient string) *MsgCo
 		Amount:    amount,
 	}
 }
+
+// NewMsgSubmitBudgetProposal returns a new MsgSubmitBudgetProposal paying
+// budgetPerTranche to the recipient every period, for the given number of
+// tranches starting at startTime.
+func NewMsgSubmitBudgetProposal(authority, recipient string, budgetPerTranche sdk.Coin, startTime *time.Time, tranches uint64, period time.Duration) *MsgSubmitBudgetProposal {
+	return &MsgSubmitBudgetProposal{
+		Authority:        authority,
+		RecipientAddress: recipient,
+		BudgetPerTranche: &budgetPerTranche,
+		StartTime:        startTime,
+		Tranches:         tranches,
+		Period:           &period,
+	}
+}
+
+// NewMsgClaimBudget returns a new MsgClaimBudget for the given recipient.
+func NewMsgClaimBudget(recipient string) *MsgClaimBudget {
+	return &MsgClaimBudget{
+		RecipientAddress: recipient,
+	}
+}
+
+// NewMsgCreateContinuousFund returns a new MsgCreateContinuousFund directing
+// percentage of the community pool inflows to the recipient until expiry.
+func NewMsgCreateContinuousFund(authority, recipient string, percentage math.LegacyDec, expiry *time.Time) *MsgCreateContinuousFund {
+	return &MsgCreateContinuousFund{
+		Authority:  authority,
+		Recipient:  recipient,
+		Percentage: percentage,
+		Expiry:     expiry,
+	}
+}
+
+// NewMsgCancelContinuousFund returns a new MsgCancelContinuousFund for the
+// given recipient.
+func NewMsgCancelContinuousFund(authority, recipient string) *MsgCancelContinuousFund {
+	return &MsgCancelContinuousFund{
+		Authority: authority,
+		Recipient: recipient,
+	}
+}
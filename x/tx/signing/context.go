@@ -3,6 +3,7 @@ package signing
 import (
 	"errors"
 	"fmt"
+	"sync"
 
 	cosmos_proto "github.com/cosmos/cosmos-proto"
 	"google.golang.org/protobuf/proto"
@@ -28,8 +29,12 @@ type Context struct {
 	typeResolver          protoregistry.MessageTypeResolver
 	addressCodec          address.Codec
 	validatorAddressCodec address.Codec
-	getSignersFuncs       map[protoreflect.FullName]GetSignersFunc
 	customGetSignerFuncs  map[protoreflect.FullName]GetSignersFunc
+
+	// getSignersFuncs caches the get signers functions, which can be looked up
+	// concurrently, for example when transactions are executed in parallel.
+	getSignersMtx   sync.RWMutex
+	getSignersFuncs map[protoreflect.FullName]GetSignersFunc
 }
 
 // Options are options for creating Context which will be used for signing operations.
@@ -300,7 +305,11 @@ func (c *Context) makeGetSignersFunc(descriptor protoreflect.MessageDescriptor)
 	}
 
 	return func(message proto.Message) ([][]byte, error) {
-		var signers [][]byte
+		// err must be local, as the returned function can be called concurrently
+		var (
+			signers [][]byte
+			err     error
+		)
 		for _, getter := range fieldGetters {
 			signers, err = getter(message, signers)
 			if err != nil {
@@ -328,14 +337,18 @@ func (c *Context) getGetSignersFn(messageDescriptor protoreflect.MessageDescript
 	if ok {
 		return f, nil
 	}
+	c.getSignersMtx.RLock()
 	f, ok = c.getSignersFuncs[messageDescriptor.FullName()]
+	c.getSignersMtx.RUnlock()
 	if !ok {
 		var err error
 		f, err = c.makeGetSignersFunc(messageDescriptor)
 		if err != nil {
 			return nil, err
 		}
+		c.getSignersMtx.Lock()
 		c.getSignersFuncs[messageDescriptor.FullName()] = f
+		c.getSignersMtx.Unlock()
 	}
 
 	return f, nil
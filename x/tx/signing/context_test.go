@@ -3,6 +3,7 @@ package signing
 import (
 	"encoding/hex"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -145,6 +146,37 @@ func TestGetSigners(t *testing.T) {
 	}
 }
 
+func TestGetSignersConcurrently(t *testing.T) {
+	ctx, err := NewContext(Options{
+		AddressCodec:          dummyAddressCodec{},
+		ValidatorAddressCodec: dummyValidatorAddressCodec{},
+	})
+	require.NoError(t, err)
+
+	// valid and invalid messages of the same type must not share an error
+	valid := &bankv1beta1.MsgSend{FromAddress: hex.EncodeToString([]byte("foo"))}
+	invalid := &bankv1beta1.MsgSend{FromAddress: "invalid"}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if (i+j)%2 == 0 {
+					signers, err := ctx.GetSigners(valid)
+					require.NoError(t, err)
+					require.Equal(t, [][]byte{[]byte("foo")}, signers)
+				} else {
+					_, err := ctx.GetSigners(invalid)
+					require.Error(t, err)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
 func TestDefineCustomGetSigners(t *testing.T) {
 	customMsg := &testpb.Ballot{}
 	signers := [][]byte{[]byte("foo")}